@@ -14,17 +14,18 @@ type Analyzer interface {
 	Analyze(*game.Game) (*url.URL, error)
 }
 
-// Handler is an http handler that will redirect the user to an analysis of their game
+// Handler is an http handler that will redirect the user to an analysis of their game.
+// The provider query parameter picks the analyzer tried first.
 type Handler struct {
 	gameStorage game.GameStorage
-	analyzer    Analyzer
+	registry    *Registry
 }
 
 // NewHTTPHandler returns an instance of an analysis endpoint handler
-func NewHTTPHandler(store game.GameStorage, analyzer Analyzer) *Handler {
+func NewHTTPHandler(store game.GameStorage, registry *Registry) *Handler {
 	return &Handler{
 		gameStorage: store,
-		analyzer:    analyzer,
+		registry:    registry,
 	}
 }
 
@@ -38,7 +39,11 @@ func (a Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusNotFound)
 		return
 	}
-	analysisURL, err := a.analyzer.Analyze(gm)
+	analysisURL, err := a.registry.AnalyzeWith(r.URL.Query().Get("provider"), gm)
+	if err == ErrUnknownProvider {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
 	if err != nil {
 		log.Println(err)
 		w.WriteHeader(http.StatusInternalServerError)
@@ -3,8 +3,8 @@ package analysis
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"io/ioutil"
-	"log"
 	"net/http"
 	"net/url"
 
@@ -13,9 +13,14 @@ import (
 
 const analysisHost = "https://lichess.org"
 
-// LichessAnalyzer provides a way to setup an analysis of a game
+// LichessAnalyzer provides a way to setup an analysis of a game by importing it to lichess
 type LichessAnalyzer struct{}
 
+// NewLichessAnalyzer returns an analyzer for lichess
+func NewLichessAnalyzer() *LichessAnalyzer {
+	return &LichessAnalyzer{}
+}
+
 // Analyze a game and return a URL to that analysis
 func (l LichessAnalyzer) Analyze(gm *game.Game) (*url.URL, error) {
 	data := url.Values{}
@@ -39,8 +44,7 @@ func (l LichessAnalyzer) Analyze(gm *game.Game) (*url.URL, error) {
 		return nil, err
 	}
 	defer resp.Body.Close()
-	body, _ := ioutil.ReadAll(resp.Body)
-	log.Println(string(body))
+	io.Copy(ioutil.Discard, resp.Body)
 	if resp.StatusCode != http.StatusSeeOther {
 		return nil, fmt.Errorf("server responded with an unexpected status code to our request: %v", resp.StatusCode)
 	}
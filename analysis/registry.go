@@ -0,0 +1,161 @@
+package analysis
+
+import (
+	"container/list"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/cjsaylor/chessbot/game"
+)
+
+// ErrUnknownProvider is returned when analysis is requested from a provider that isn't registered
+var ErrUnknownProvider = errors.New("unknown analysis provider")
+
+// Registry holds analyzers in order of priority.
+// Games are analyzed by the first analyzer that succeeds, falling back to the next on failure.
+// Successful analyses are kept in a bounded least recently used cache, so asking again for an unchanged game reuses its analysis.
+type Registry struct {
+	names     []string
+	analyzers map[string]Analyzer
+	capacity  int
+	cache     map[cacheKey]*list.Element
+	order     *list.List
+	mu        sync.Mutex
+}
+
+type cacheKey struct {
+	gameID   string
+	provider string
+}
+
+// cachedAnalysis is a successful analysis along with the exported game it was made of,
+// so analyses of games that were played on, taken back or annotated since are not reused.
+type cachedAnalysis struct {
+	key cacheKey
+	pgn string
+	url *url.URL
+}
+
+// NewRegistry returns an empty registry caching up to capacity analyses
+func NewRegistry(capacity int) *Registry {
+	return &Registry{
+		analyzers: make(map[string]Analyzer),
+		capacity:  capacity,
+		cache:     make(map[cacheKey]*list.Element),
+		order:     list.New(),
+	}
+}
+
+// Register adds an analyzer with a lower priority than the ones already registered.
+// Registering a name again replaces its analyzer, keeping its priority.
+func (r *Registry) Register(name string, analyzer Analyzer) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.analyzers[name]; !ok {
+		r.names = append(r.names, name)
+	}
+	r.analyzers[name] = analyzer
+	for key, element := range r.cache {
+		if key.provider == name {
+			r.order.Remove(element)
+			delete(r.cache, key)
+		}
+	}
+}
+
+// Names lists the registered providers in order of priority
+func (r *Registry) Names() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string{}, r.names...)
+}
+
+// Analyze a game with the registered analyzers in order of priority and return a URL to the first successful analysis
+func (r *Registry) Analyze(gm *game.Game) (*url.URL, error) {
+	return r.AnalyzeWith("", gm)
+}
+
+// AnalyzeWith analyzes a game with the chosen provider first, falling back to the others in order of priority.
+// An empty provider uses the order of priority alone.
+func (r *Registry) AnalyzeWith(provider string, gm *game.Game) (*url.URL, error) {
+	names := r.Names()
+	if provider != "" {
+		if !contains(names, provider) {
+			return nil, ErrUnknownProvider
+		}
+		names = append([]string{provider}, remove(names, provider)...)
+	}
+	if len(names) == 0 {
+		return nil, errors.New("no analysis providers are registered")
+	}
+	failures := []string{}
+	for _, name := range names {
+		analysisURL, err := r.analyze(name, gm)
+		if err == nil {
+			return analysisURL, nil
+		}
+		failures = append(failures, fmt.Sprintf("%v: %v", name, err))
+	}
+	return nil, fmt.Errorf("all analysis providers failed (%v)", strings.Join(failures, "; "))
+}
+
+// analyze a game with a single provider, reusing a previous analysis of the same game
+func (r *Registry) analyze(name string, gm *game.Game) (*url.URL, error) {
+	key := cacheKey{gameID: gm.ID, provider: name}
+	pgn := gm.Export()
+	r.mu.Lock()
+	analyzer := r.analyzers[name]
+	if element, ok := r.cache[key]; ok && element.Value.(*cachedAnalysis).pgn == pgn {
+		r.order.MoveToFront(element)
+		r.mu.Unlock()
+		return element.Value.(*cachedAnalysis).url, nil
+	}
+	r.mu.Unlock()
+	analysisURL, err := analyzer.Analyze(gm)
+	if err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	r.add(&cachedAnalysis{key: key, pgn: pgn, url: analysisURL})
+	r.mu.Unlock()
+	return analysisURL, nil
+}
+
+// add caches an analysis, replacing the previous analysis of the game and evicting the least recently used analysis when the cache is full
+func (r *Registry) add(analysis *cachedAnalysis) {
+	if r.capacity <= 0 {
+		return
+	}
+	if element, ok := r.cache[analysis.key]; ok {
+		element.Value = analysis
+		r.order.MoveToFront(element)
+		return
+	}
+	r.cache[analysis.key] = r.order.PushFront(analysis)
+	for r.order.Len() > r.capacity {
+		oldest := r.order.Remove(r.order.Back()).(*cachedAnalysis)
+		delete(r.cache, oldest.key)
+	}
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+func remove(names []string, name string) []string {
+	remaining := []string{}
+	for _, n := range names {
+		if n != name {
+			remaining = append(remaining, n)
+		}
+	}
+	return remaining
+}
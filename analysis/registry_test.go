@@ -0,0 +1,149 @@
+package analysis_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/cjsaylor/chessbot/analysis"
+	"github.com/cjsaylor/chessbot/game"
+)
+
+// fakeAnalyzer links to a fixed URL, or fails when it has none, counting how many times it was asked
+type fakeAnalyzer struct {
+	link  string
+	calls int
+}
+
+func (f *fakeAnalyzer) Analyze(gm *game.Game) (*url.URL, error) {
+	f.calls++
+	if f.link == "" {
+		return nil, errors.New("unavailable")
+	}
+	return url.Parse(f.link)
+}
+
+func TestRegistryFallback(t *testing.T) {
+	failing := &fakeAnalyzer{}
+	working := &fakeAnalyzer{link: "https://example.com/working"}
+	registry := analysis.NewRegistry(10)
+	registry.Register("failing", failing)
+	registry.Register("working", working)
+	analysisURL, err := registry.Analyze(playGame(t, "e2e4"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if analysisURL.String() != working.link {
+		t.Errorf("expected to fall back to %v, got %v", working.link, analysisURL)
+	}
+	if failing.calls != 1 {
+		t.Errorf("expected the failing analyzer to be tried first, got %v calls", failing.calls)
+	}
+}
+
+func TestRegistryProvider(t *testing.T) {
+	first := &fakeAnalyzer{link: "https://example.com/first"}
+	second := &fakeAnalyzer{link: "https://example.com/second"}
+	registry := analysis.NewRegistry(10)
+	registry.Register("first", first)
+	registry.Register("second", second)
+	if names := registry.Names(); len(names) != 2 || names[0] != "first" || names[1] != "second" {
+		t.Errorf("expected providers in order of registration, got %v", names)
+	}
+	analysisURL, err := registry.AnalyzeWith("second", playGame(t, "e2e4"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if analysisURL.String() != second.link {
+		t.Errorf("expected the chosen provider's analysis %v, got %v", second.link, analysisURL)
+	}
+	if first.calls != 0 {
+		t.Error("expected the chosen provider to be tried first")
+	}
+	if _, err := registry.AnalyzeWith("unknown", playGame(t, "e2e4")); err != analysis.ErrUnknownProvider {
+		t.Errorf("expected ErrUnknownProvider, got %v", err)
+	}
+}
+
+func TestRegistryCache(t *testing.T) {
+	analyzer := &fakeAnalyzer{link: "https://example.com/import"}
+	registry := analysis.NewRegistry(10)
+	registry.Register("import", analyzer)
+	gm := playGame(t, "e2e4")
+	for i := 0; i < 3; i++ {
+		if _, err := registry.Analyze(gm); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if analyzer.calls != 1 {
+		t.Errorf("expected the analysis to be reused, got %v calls", analyzer.calls)
+	}
+	if _, err := gm.Move("e7e5"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := registry.Analyze(gm); err != nil {
+		t.Fatal(err)
+	}
+	if analyzer.calls != 2 {
+		t.Errorf("expected the game to be analyzed again after a move, got %v calls", analyzer.calls)
+	}
+	if err := gm.AnnotateMove(2, game.MoveAnnotation{Comment: "symmetrical"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := registry.Analyze(gm); err != nil {
+		t.Fatal(err)
+	}
+	if analyzer.calls != 3 {
+		t.Errorf("expected the game to be analyzed again after an annotation, got %v calls", analyzer.calls)
+	}
+}
+
+func TestRegistryCacheEviction(t *testing.T) {
+	analyzer := &fakeAnalyzer{link: "https://example.com/import"}
+	registry := analysis.NewRegistry(1)
+	registry.Register("import", analyzer)
+	first := playGame(t, "e2e4")
+	second := game.NewGame("5678", game.Player{ID: "white"}, game.Player{ID: "black"})
+	for _, gm := range []*game.Game{first, second, first} {
+		if _, err := registry.Analyze(gm); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if analyzer.calls != 3 {
+		t.Errorf("expected the least recently used analysis to be evicted, got %v calls", analyzer.calls)
+	}
+}
+
+func TestHandlerProvider(t *testing.T) {
+	store := game.NewMemoryStore()
+	gm := playGame(t, "e2e4")
+	if err := store.StoreGame(gm.ID, gm); err != nil {
+		t.Fatal(err)
+	}
+	registry := analysis.NewRegistry(10)
+	registry.Register("first", &fakeAnalyzer{link: "https://example.com/first"})
+	registry.Register("second", &fakeAnalyzer{link: "https://example.com/second"})
+	handler := analysis.NewHTTPHandler(store, registry)
+	cases := []struct {
+		query    string
+		status   int
+		location string
+	}{
+		{"game_id=1234", http.StatusTemporaryRedirect, "https://example.com/first"},
+		{"game_id=1234&provider=second", http.StatusTemporaryRedirect, "https://example.com/second"},
+		{"game_id=1234&provider=unknown", http.StatusBadRequest, ""},
+		{"game_id=missing", http.StatusNotFound, ""},
+	}
+	for _, c := range cases {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/analyze?"+c.query, nil))
+		if recorder.Code != c.status {
+			t.Errorf("%v: expected status %v, got %v", c.query, c.status, recorder.Code)
+		}
+		if location := recorder.Header().Get("Location"); location != c.location {
+			t.Errorf("%v: expected a redirect to %q, got %q", c.query, c.location, location)
+		}
+	}
+}
@@ -0,0 +1,217 @@
+package analysis
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/cjsaylor/chessbot/engine"
+	"github.com/cjsaylor/chessbot/game"
+	"github.com/cjsaylor/chessbot/openings"
+	"github.com/notnil/chess"
+)
+
+const (
+	// maxMistakes is the number of mistakes listed in a review
+	maxMistakes = 3
+	// Moves losing at least these win chances (in percent) are inaccuracies, mistakes and blunders
+	inaccuracyThreshold = 10
+	mistakeThreshold    = 20
+	blunderThreshold    = 30
+)
+
+// Reviewer evaluates every move of a completed game
+type Reviewer interface {
+	Review(*game.Game) (*Review, error)
+}
+
+// Review summarizes how well each player played a game
+type Review struct {
+	Opening    openings.Opening
+	HasOpening bool
+	// Accuracy of each player from 0 to 100, based on the win chances lost by their moves
+	Accuracy map[game.Color]float64
+	// Mistakes are the moves that lost the most win chances, worst first
+	Mistakes []Mistake
+}
+
+// Critical is the worst mistake of the game, which decided its course
+func (r *Review) Critical() (Mistake, bool) {
+	if len(r.Mistakes) == 0 {
+		return Mistake{}, false
+	}
+	return r.Mistakes[0], true
+}
+
+// Mistake is a move that lost win chances compared to the engine's best move
+type Mistake struct {
+	// Ply is the number of the move within the game, starting at 1
+	Ply   int
+	Color game.Color
+	// FEN is the position the move was played in
+	FEN    string
+	Played *chess.Move
+	Best   *chess.Move
+	// PlayedSAN and BestSAN are the moves in algebraic notation
+	PlayedSAN string
+	BestSAN   string
+	// Before and After are the evaluations around the move from White's point of view
+	Before engine.Score
+	After  engine.Score
+	// Loss is how many win chances (in percent) the move lost
+	Loss float64
+}
+
+// Glyph is the annotation of the mistake: ?! for an inaccuracy, ? for a mistake and ?? for a blunder
+func (m Mistake) Glyph() string {
+	switch {
+	case m.Loss >= blunderThreshold:
+		return "??"
+	case m.Loss >= mistakeThreshold:
+		return "?"
+	}
+	return "?!"
+}
+
+// MoveNumber prefixes the move with its number, such as "12. " for White and "12... " for Black.
+// The number is read from the position the move was played in, so games from a set up position are numbered correctly.
+func (m Mistake) MoveNumber() string {
+	number := "1"
+	if fields := strings.Fields(m.FEN); len(fields) == 6 {
+		number = fields[5]
+	}
+	if m.Color == game.Black {
+		return fmt.Sprintf("%v... ", number)
+	}
+	return fmt.Sprintf("%v. ", number)
+}
+
+// EngineReviewer reviews games with a chess engine, evaluating every position of the game
+type EngineReviewer struct {
+	evaluator engine.Evaluator
+}
+
+// NewEngineReviewer returns a reviewer evaluating positions with the evaluator
+func NewEngineReviewer(evaluator engine.Evaluator) *EngineReviewer {
+	return &EngineReviewer{
+		evaluator: evaluator,
+	}
+}
+
+// Review evaluates the position before and after every move of a game
+func (e EngineReviewer) Review(gm *game.Game) (*Review, error) {
+	review := &Review{
+		Accuracy: make(map[game.Color]float64),
+	}
+	review.Opening, review.HasOpening = gm.Opening()
+	option, err := chess.FEN(gm.StartingFEN())
+	if err != nil {
+		return nil, err
+	}
+	position := chess.NewGame(option).Position()
+	best, err := e.evaluate(position)
+	if err != nil {
+		return nil, err
+	}
+	accuracies := map[game.Color][]float64{}
+	mistakes := []Mistake{}
+	for _, ply := range gm.Plies() {
+		color := game.White
+		if position.Turn() == chess.Black {
+			color = game.Black
+		}
+		next := position.Update(ply.Move)
+		reply, err := e.evaluate(next)
+		if err != nil {
+			return nil, err
+		}
+		before, after := best.Score, reply.Score.Negate()
+		loss := math.Max(0, winChance(before)-winChance(after))
+		accuracies[color] = append(accuracies[color], moveAccuracy(loss))
+		if loss >= inaccuracyThreshold && len(best.Moves) > 0 {
+			if bestMove, err := (chess.LongAlgebraicNotation{}).Decode(position, best.Moves[0]); err == nil {
+				bestMove = validMove(position, bestMove)
+				mistakes = append(mistakes, Mistake{
+					Ply:       ply.Number,
+					Color:     color,
+					FEN:       position.String(),
+					Played:    ply.Move,
+					Best:      bestMove,
+					PlayedSAN: chess.AlgebraicNotation{}.Encode(position, ply.Move),
+					BestSAN:   chess.AlgebraicNotation{}.Encode(position, bestMove),
+					Before:    whitePerspective(before, color),
+					After:     whitePerspective(after, color),
+					Loss:      loss,
+				})
+			}
+		}
+		position, best = next, reply
+	}
+	for color, moves := range accuracies {
+		review.Accuracy[color] = mean(moves)
+	}
+	sort.SliceStable(mistakes, func(i, j int) bool {
+		return mistakes[i].Loss > mistakes[j].Loss
+	})
+	if len(mistakes) > maxMistakes {
+		mistakes = mistakes[:maxMistakes]
+	}
+	review.Mistakes = mistakes
+	return review, nil
+}
+
+// evaluate finds the best line of a position, scoring finished games without the engine
+func (e EngineReviewer) evaluate(position *chess.Position) (engine.Line, error) {
+	switch position.Status() {
+	case chess.Checkmate:
+		return engine.Line{Score: engine.Score{Mate: -1}}, nil
+	case chess.NoMethod:
+	default:
+		return engine.Line{}, nil
+	}
+	lines, err := e.evaluator.Evaluate(position.String(), 1)
+	if err != nil || len(lines) == 0 {
+		return engine.Line{}, err
+	}
+	return lines[0], nil
+}
+
+// validMove finds the legal move matching a decoded move, which carries the tags (check, capture) needed for algebraic notation
+func validMove(position *chess.Position, move *chess.Move) *chess.Move {
+	for _, valid := range position.ValidMoves() {
+		if valid.S1() == move.S1() && valid.S2() == move.S2() && valid.Promo() == move.Promo() {
+			return valid
+		}
+	}
+	return move
+}
+
+// winChance converts an evaluation to the chance (in percent) of winning, as popularized by Lichess
+func winChance(score engine.Score) float64 {
+	centipawns := math.Max(-1000, math.Min(1000, float64(score.Value())))
+	return 50 + 50*(2/(1+math.Exp(-0.00368208*centipawns))-1)
+}
+
+// moveAccuracy converts the win chances lost by a move to an accuracy between 0 and 100
+func moveAccuracy(loss float64) float64 {
+	return math.Max(0, math.Min(100, 103.1668*math.Exp(-0.04354*loss)-3.1669))
+}
+
+func whitePerspective(score engine.Score, color game.Color) engine.Score {
+	if color == game.Black {
+		return score.Negate()
+	}
+	return score
+}
+
+func mean(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	total := 0.0
+	for _, value := range values {
+		total += value
+	}
+	return total / float64(len(values))
+}
@@ -0,0 +1,124 @@
+package analysis_test
+
+import (
+	"testing"
+
+	"github.com/cjsaylor/chessbot/analysis"
+	"github.com/cjsaylor/chessbot/engine"
+	"github.com/cjsaylor/chessbot/game"
+	"github.com/notnil/chess"
+)
+
+// fakeEvaluator returns the line of known positions, and an equal line with the first legal move for any other position
+type fakeEvaluator map[string]engine.Line
+
+func (f fakeEvaluator) Evaluate(fen string, lines int) ([]engine.Line, error) {
+	if known, ok := f[fen]; ok {
+		return []engine.Line{known}, nil
+	}
+	option, err := chess.FEN(fen)
+	if err != nil {
+		return nil, err
+	}
+	position := chess.NewGame(option).Position()
+	move := position.ValidMoves()[0]
+	return []engine.Line{{Moves: []string{chess.LongAlgebraicNotation{}.Encode(position, move)}}}, nil
+}
+
+func playGame(t *testing.T, moves ...string) *game.Game {
+	gm := game.NewGame("1234", game.Player{ID: "white"}, game.Player{ID: "black"})
+	for _, move := range moves {
+		if _, err := gm.Move(move); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return gm
+}
+
+func TestEngineReview(t *testing.T) {
+	beforeBlunder := playGame(t, "e2e4", "e7e5", "f1c4", "b8c6", "d1h5")
+	afterBlunder := playGame(t, "e2e4", "e7e5", "f1c4", "b8c6", "d1h5", "g8f6")
+	evaluator := fakeEvaluator{
+		beforeBlunder.FEN(): {Moves: []string{"g7g6"}},
+		afterBlunder.FEN():  {Score: engine.Score{Mate: 1}, Moves: []string{"h5f7"}},
+	}
+	gm := playGame(t, "e2e4", "e7e5", "f1c4", "b8c6", "d1h5", "g8f6", "h5f7")
+	review, err := analysis.NewEngineReviewer(evaluator).Review(gm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !review.HasOpening {
+		t.Error("expected the opening to be classified")
+	}
+	if review.Accuracy[game.White] < 99.9 {
+		t.Errorf("expected White to play accurately, got %v", review.Accuracy[game.White])
+	}
+	if review.Accuracy[game.Black] > 80 {
+		t.Errorf("expected Black's blunder to lower their accuracy, got %v", review.Accuracy[game.Black])
+	}
+	if len(review.Mistakes) != 1 {
+		t.Fatalf("expected 1 mistake, got %v", len(review.Mistakes))
+	}
+	critical, ok := review.Critical()
+	if !ok {
+		t.Fatal("expected a critical moment")
+	}
+	if critical.Ply != 6 || critical.Color != game.Black {
+		t.Errorf("expected Black's 6th ply to be critical, got ply %v of %v", critical.Ply, critical.Color)
+	}
+	if critical.PlayedSAN != "Nf6" || critical.BestSAN != "g6" {
+		t.Errorf("expected Nf6 instead of g6, got %v instead of %v", critical.PlayedSAN, critical.BestSAN)
+	}
+	if critical.MoveNumber() != "3... " {
+		t.Errorf("expected Black's 3rd move, got %q", critical.MoveNumber())
+	}
+	if critical.Glyph() != "??" {
+		t.Errorf("expected a blunder, got %v", critical.Glyph())
+	}
+	if critical.FEN != beforeBlunder.FEN() {
+		t.Errorf("expected the position before the blunder, got %v", critical.FEN)
+	}
+	if critical.Before.String() != "+0.00" || critical.After.String() != "#1" {
+		t.Errorf("expected the evaluation to go from +0.00 to #1, got %v to %v", critical.Before, critical.After)
+	}
+}
+
+func TestEngineReviewWithoutMistakes(t *testing.T) {
+	review, err := analysis.NewEngineReviewer(fakeEvaluator{}).Review(playGame(t, "d2d4", "d7d5", "c2c4"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(review.Mistakes) != 0 {
+		t.Errorf("expected no mistakes, got %v", review.Mistakes)
+	}
+	if _, ok := review.Critical(); ok {
+		t.Error("expected no critical moment")
+	}
+}
+
+func TestReviewFromPosition(t *testing.T) {
+	// Black to move on move 20 misses the back rank mate
+	gm, err := game.NewGameFromFEN("1234", "r5k1/5ppp/8/8/8/8/5PPP/6K1 b - - 0 20", game.Player{ID: "white"}, game.Player{ID: "black"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	evaluator := fakeEvaluator{
+		gm.FEN(): {Score: engine.Score{Mate: 1}, Moves: []string{"a8a1"}},
+	}
+	for _, move := range []string{"a8a7", "g1f1"} {
+		if _, err := gm.Move(move); err != nil {
+			t.Fatal(err)
+		}
+	}
+	review, err := analysis.NewEngineReviewer(evaluator).Review(gm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	critical, ok := review.Critical()
+	if !ok {
+		t.Fatal("expected a critical moment")
+	}
+	if critical.Ply != 1 || critical.MoveNumber() != "20... " {
+		t.Errorf("expected Black's 20th move to be critical, got ply %v numbered %q", critical.Ply, critical.MoveNumber())
+	}
+}
@@ -0,0 +1,78 @@
+package archive
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/cjsaylor/chessbot/game"
+	"github.com/cjsaylor/chessbot/rendering"
+)
+
+// ExportLink is a simple struct for creating valid external archive export URLs
+type ExportLink struct {
+	hostName string
+	signer   rendering.URLSigner
+}
+
+// NewExportLink creates a new ExportLink struct instance
+func NewExportLink(hostname string, signingKey string, previousKeys ...string) ExportLink {
+	return ExportLink{
+		hostName: hostname,
+		signer:   rendering.NewURLSigner("export", signingKey, previousKeys...),
+	}
+}
+
+// WithTTL returns a copy of the export link creator whose links expire after the duration
+func (e ExportLink) WithTTL(ttl time.Duration) ExportLink {
+	e.signer = e.signer.WithTTL(ttl)
+	return e
+}
+
+// CreateLink returns an externally accessible URL exporting all archived games matching the filter
+func (e ExportLink) CreateLink(filter game.ArchiveFilter) (*url.URL, error) {
+	u, err := url.Parse(fmt.Sprintf("%v/export.pgn", e.hostName))
+	if err != nil {
+		return nil, err
+	}
+	q := u.Query()
+	q.Add("team_id", filter.TeamID)
+	if filter.PlayerID != "" {
+		q.Add("player", filter.PlayerID)
+	}
+	if !filter.From.IsZero() {
+		q.Add("from", filter.From.UTC().Format(time.RFC3339))
+	}
+	if !filter.To.IsZero() {
+		q.Add("to", filter.To.UTC().Format(time.RFC3339))
+	}
+	e.signer.Sign(q)
+	u.RawQuery = q.Encode()
+	return u, nil
+}
+
+// ValidateLink ensures that every parameter of the link is signed properly with the app signing key
+func (e ExportLink) ValidateLink(u url.URL) bool {
+	return e.signer.Verify(u.Query()) == nil
+}
+
+// FilterFromLink parses the archive filter encoded in an export link
+func FilterFromLink(u url.URL) (game.ArchiveFilter, error) {
+	q := u.Query()
+	filter := game.ArchiveFilter{
+		TeamID:   q.Get("team_id"),
+		PlayerID: q.Get("player"),
+	}
+	var err error
+	if from := q.Get("from"); from != "" {
+		if filter.From, err = time.Parse(time.RFC3339, from); err != nil {
+			return filter, err
+		}
+	}
+	if to := q.Get("to"); to != "" {
+		if filter.To, err = time.Parse(time.RFC3339, to); err != nil {
+			return filter, err
+		}
+	}
+	return filter, nil
+}
@@ -0,0 +1,60 @@
+// Package archive allows completed games to be exported as a multi-game PGN database
+package archive
+
+import (
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/cjsaylor/chessbot/game"
+)
+
+// Handler is an http handler that streams archived games as a single PGN file
+type Handler struct {
+	archiveStorage game.ArchiveStorage
+	exportLink     ExportLink
+}
+
+// NewHTTPHandler returns an instance of an archive export endpoint handler
+func NewHTTPHandler(store game.ArchiveStorage, exportLink ExportLink) *Handler {
+	return &Handler{
+		archiveStorage: store,
+		exportLink:     exportLink,
+	}
+}
+
+func (h Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if !h.exportLink.ValidateLink(*r.URL) {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	filter, err := FilterFromLink(*r.URL)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", "application/x-chess-pgn")
+	w.Header().Set("Content-Disposition", "attachment; filename=\"chessbot.pgn\"")
+	flusher, _ := w.(http.Flusher)
+	written := false
+	err = h.archiveStorage.RetrieveArchivedGames(filter, func(archived *game.ArchivedGame) error {
+		written = true
+		if _, err := io.WriteString(w, archived.PGN+"\n\n"); err != nil {
+			return err
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		return nil
+	})
+	if err != nil {
+		log.Println(err)
+		if !written {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}
+}
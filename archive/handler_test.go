@@ -0,0 +1,99 @@
+package archive_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cjsaylor/chessbot/archive"
+	"github.com/cjsaylor/chessbot/game"
+)
+
+func archivedGame(ID string, teamID string) *game.ArchivedGame {
+	gm := game.NewGame(ID, game.Player{ID: "a"}, game.Player{ID: "b"})
+	gm.Move("d2d4")
+	gm.Resign(gm.Players[game.White])
+	archived, _ := gm.Archive(teamID)
+	return archived
+}
+
+func TestExportLinkValidation(t *testing.T) {
+	exportLink := archive.NewExportLink("http://localhost", "secret")
+	link, _ := exportLink.CreateLink(game.ArchiveFilter{
+		TeamID: "team",
+		From:   time.Date(2019, time.March, 1, 0, 0, 0, 0, time.UTC),
+	})
+	if !exportLink.ValidateLink(*link) {
+		t.Error("expected the created link to be valid")
+	}
+	q := link.Query()
+	q.Set("team_id", "other")
+	link.RawQuery = q.Encode()
+	if exportLink.ValidateLink(*link) {
+		t.Error("expected a tampered link to be invalid")
+	}
+	link, _ = exportLink.WithTTL(time.Hour).CreateLink(game.ArchiveFilter{TeamID: "team"})
+	if !archive.NewExportLink("http://localhost", "new", "secret").ValidateLink(*link) {
+		t.Error("expected a link signed with a previous key to be valid")
+	}
+	link, _ = exportLink.WithTTL(-time.Minute).CreateLink(game.ArchiveFilter{TeamID: "team"})
+	if exportLink.ValidateLink(*link) {
+		t.Error("expected an expired link to be invalid")
+	}
+}
+
+func TestExportStreamsMatchingGames(t *testing.T) {
+	store := game.NewMemoryStore()
+	store.StoreArchivedGame(archivedGame("1", "team"))
+	store.StoreArchivedGame(archivedGame("2", "other"))
+	exportLink := archive.NewExportLink("", "secret")
+	link, _ := exportLink.CreateLink(game.ArchiveFilter{TeamID: "team"})
+
+	recorder := httptest.NewRecorder()
+	archive.NewHTTPHandler(store, exportLink).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, link.String(), nil))
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected status %v, got %v", http.StatusOK, recorder.Code)
+	}
+	if contentType := recorder.Header().Get("Content-Type"); contentType != "application/x-chess-pgn" {
+		t.Errorf("expected a PGN content type, got %v", contentType)
+	}
+	if games := strings.Count(recorder.Body.String(), "[Site "); games != 1 {
+		t.Errorf("expected 1 exported game, got %v", games)
+	}
+}
+
+func TestExportRejectsUnsignedLinks(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	handler := archive.NewHTTPHandler(game.NewMemoryStore(), archive.NewExportLink("", "secret"))
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/export.pgn?team_id=team", nil))
+	if recorder.Code != http.StatusForbidden {
+		t.Errorf("expected status %v, got %v", http.StatusForbidden, recorder.Code)
+	}
+}
+
+func TestExportIncludesAnnotationsOfCompletedGames(t *testing.T) {
+	store := game.NewMemoryStore()
+	gm := game.NewGame("1", game.Player{ID: "a"}, game.Player{ID: "b"})
+	gm.Move("d2d4")
+	gm.Resign(gm.Players[game.White])
+	archived, _ := gm.ArchiveAt("team", time.Date(2019, time.March, 1, 0, 0, 0, 0, time.UTC))
+	store.StoreArchivedGame(archived)
+	gm.AnnotateMove(1, game.MoveAnnotation{NAG: 3, Comment: "the queen's pawn"})
+	if err := game.UpdateArchive(store, "team", gm); err != nil {
+		t.Fatal(err)
+	}
+	exportLink := archive.NewExportLink("", "secret")
+	link, _ := exportLink.CreateLink(game.ArchiveFilter{TeamID: "team"})
+
+	recorder := httptest.NewRecorder()
+	archive.NewHTTPHandler(store, exportLink).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, link.String(), nil))
+	exported := recorder.Body.String()
+	if !strings.Contains(exported, "1.d4 $3 {the queen's pawn}") {
+		t.Errorf("expected the annotation in the export, got %v", exported)
+	}
+	if strings.Count(exported, "[Site ") != 1 || !strings.Contains(exported, `[Date "2019.03.01"]`) {
+		t.Errorf("expected the archived game to be replaced and keep the date it ended, got %v", exported)
+	}
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cjsaylor/chessbot/game"
+	"github.com/cjsaylor/chessbot/importer"
+	"github.com/cjsaylor/chessbot/puzzles"
+	bolt "go.etcd.io/bbolt"
+)
+
+func main() {
+	dbPath := flag.String("db", "./chessbot.db", "Path to the sqlite3 database file")
+	boltPath := flag.String("bolt", "", "Path to a bbolt database file, used instead of the sqlite3 database")
+	teamID := flag.String("team", "", "Slack team (workspace) ID the games belong to")
+	aliasPath := flag.String("aliases", "", "CSV file of player name and Slack user ID pairs used to map [White] and [Black] tags")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %v -team T123 [-db path] [-aliases file.csv] games.pgn... puzzles.csv...\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if *teamID == "" || flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	var imp *importer.Importer
+	var puzzleStore puzzles.PuzzleStorage
+	if *boltPath != "" {
+		db, err := bolt.Open(*boltPath, 0600, &bolt.Options{Timeout: time.Second})
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer db.Close()
+		store, err := game.NewBoltStore(db)
+		if err != nil {
+			log.Fatal(err)
+		}
+		imp = importer.NewImporter(store, store)
+		if puzzleStore, err = puzzles.NewBoltStore(db); err != nil {
+			log.Fatal(err)
+		}
+	} else {
+		store, err := game.NewSqliteStore(*dbPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		imp = importer.NewImporter(store, store)
+		if puzzleStore, err = puzzles.NewSqliteStore(*dbPath); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if *aliasPath != "" {
+		if err := importAliases(imp, *teamID, *aliasPath); err != nil {
+			log.Fatal(err)
+		}
+	}
+	for _, path := range flag.Args() {
+		file, err := os.Open(path)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if strings.HasSuffix(strings.ToLower(path), ".csv") {
+			importPuzzles(puzzleStore, *teamID, path, file)
+			continue
+		}
+		report, err := imp.Import(*teamID, file)
+		file.Close()
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("%v: imported %v games, skipped %v\n", path, report.Imported, len(report.Skipped))
+		for _, skipped := range report.Skipped {
+			fmt.Printf("  %v\n", skipped)
+		}
+	}
+}
+
+// importPuzzles imports a CSV file of puzzles in the format of the Lichess puzzle database
+func importPuzzles(store puzzles.PuzzleStorage, teamID string, path string, file *os.File) {
+	defer file.Close()
+	report, err := puzzles.ImportCSV(store, teamID, file)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("%v: imported %v puzzles, skipped %v\n", path, report.Imported, len(report.Skipped))
+	for _, skipped := range report.Skipped {
+		fmt.Printf("  %v\n", skipped)
+	}
+}
+
+func importAliases(imp *importer.Importer, teamID string, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = 2
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := imp.MapPlayer(teamID, record[0], record[1]); err != nil {
+			return err
+		}
+	}
+}
@@ -8,10 +8,17 @@ import (
 	"time"
 
 	"github.com/cjsaylor/chessbot/analysis"
+	"github.com/cjsaylor/chessbot/archive"
 	"github.com/cjsaylor/chessbot/config"
+	"github.com/cjsaylor/chessbot/engine"
+	"github.com/cjsaylor/chessbot/explorer"
 	"github.com/cjsaylor/chessbot/game"
 	"github.com/cjsaylor/chessbot/integration"
+	"github.com/cjsaylor/chessbot/puzzles"
 	"github.com/cjsaylor/chessbot/rendering"
+	"github.com/cjsaylor/chessbot/sandbox"
+	"github.com/cjsaylor/chessbot/tablebase"
+	bolt "go.etcd.io/bbolt"
 )
 
 func init() {
@@ -26,8 +33,47 @@ func main() {
 	var gameStorage game.GameStorage
 	var challengeStorage game.ChallengeStorage
 	var takebackStorage game.TakebackStorage
+	var archiveStorage game.ArchiveStorage
+	var aliasStorage game.PlayerAliasStorage
 	var authStorage integration.AuthStorage
-	if config.SqlitePath != "" {
+	var preferenceStorage integration.PreferenceStorage
+	var puzzleStorage puzzles.PuzzleStorage
+	var boardStorage sandbox.BoardStorage
+	if config.SqlitePath != "" && config.BoltPath != "" {
+		log.Fatal("Only one of SQLITEPATH and BOLTPATH may be configured")
+	}
+	if config.BoltPath != "" {
+		db, err := bolt.Open(config.BoltPath, 0600, &bolt.Options{Timeout: time.Second})
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer db.Close()
+		gameBoltStore, err := game.NewBoltStore(db)
+		if err != nil {
+			log.Fatal(err)
+		}
+		authBoltStore, err := integration.NewBoltStore(db)
+		if err != nil {
+			log.Fatal(err)
+		}
+		puzzleBoltStore, err := puzzles.NewBoltStore(db)
+		if err != nil {
+			log.Fatal(err)
+		}
+		boardBoltStore, err := sandbox.NewBoltStore(db)
+		if err != nil {
+			log.Fatal(err)
+		}
+		gameStorage = gameBoltStore
+		challengeStorage = gameBoltStore
+		takebackStorage = gameBoltStore
+		archiveStorage = gameBoltStore
+		aliasStorage = gameBoltStore
+		authStorage = authBoltStore
+		preferenceStorage = authBoltStore
+		puzzleStorage = puzzleBoltStore
+		boardStorage = boardBoltStore
+	} else if config.SqlitePath != "" {
 		gameSQLStore, err := game.NewSqliteStore(config.SqlitePath)
 		if err != nil {
 			log.Fatal(err)
@@ -36,42 +82,172 @@ func main() {
 		if err != nil {
 			log.Fatal(err)
 		}
+		puzzleSQLStore, err := puzzles.NewSqliteStore(config.SqlitePath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		boardSQLStore, err := sandbox.NewSqliteStore(config.SqlitePath)
+		if err != nil {
+			log.Fatal(err)
+		}
 		gameStorage = gameSQLStore
 		challengeStorage = gameSQLStore
 		takebackStorage = gameSQLStore
+		archiveStorage = gameSQLStore
+		aliasStorage = gameSQLStore
 		authStorage = authSQLStore
+		preferenceStorage = authSQLStore
+		puzzleStorage = puzzleSQLStore
+		boardStorage = boardSQLStore
 	} else {
 		memoryStore := game.NewMemoryStore()
 		gameStorage = memoryStore
 		challengeStorage = memoryStore
 		takebackStorage = memoryStore
-		authStorage = integration.NewMemoryStore()
+		archiveStorage = memoryStore
+		aliasStorage = memoryStore
+		integrationMemoryStore := integration.NewMemoryStore()
+		authStorage = integrationMemoryStore
+		preferenceStorage = integrationMemoryStore
+		puzzleStorage = puzzles.NewMemoryStore()
+		boardStorage = sandbox.NewMemoryStore()
 	}
-	renderLink := rendering.NewRenderLink(config.Hostname, config.SigningKey)
-	http.Handle("/board", rendering.BoardRenderHandler{
-		LinkRenderer: renderLink,
-	})
-	http.Handle("/board.png", rendering.BoardRenderHandler{
+	if config.TokenKey != "" {
+		tokenCipher, err := integration.NewTokenCipher(config.TokenKey, config.TokenPreviousKeys...)
+		if err != nil {
+			log.Fatal(err)
+		}
+		encryptedStore := integration.NewEncryptedAuthStore(authStorage, tokenCipher)
+		rotated, err := encryptedStore.RotateKeys()
+		if err != nil {
+			log.Fatal(err)
+		}
+		if rotated > 0 {
+			log.Printf("Re-encrypted %v oauth tokens with the current token key\n", rotated)
+		}
+		authStorage = encryptedStore
+	}
+	var legacyLinksUntil time.Time
+	if config.LegacyLinksUntil != "" {
+		if legacyLinksUntil, err = time.Parse("2006-01-02", config.LegacyLinksUntil); err != nil {
+			log.Fatal(err)
+		}
+	}
+	renderLink := rendering.NewRenderLink(config.Hostname, config.SigningKey, config.SigningPreviousKeys...).
+		WithTTL(config.LinkTTL).
+		WithLegacyDeadline(legacyLinksUntil)
+	positionLink := rendering.NewPositionLink(config.Hostname, config.SigningKey, config.SigningPreviousKeys...).
+		WithTTL(config.LinkTTL)
+	scoresheetLink := rendering.NewScoresheetLink(config.Hostname, config.SigningKey, config.SigningPreviousKeys...).
+		WithTTL(config.LinkTTL)
+	exportLink := archive.NewExportLink(config.Hostname, config.SigningKey, config.SigningPreviousKeys...).
+		WithTTL(config.LinkTTL)
+	exploreLink := explorer.NewExploreLink(config.Hostname, config.SigningKey, config.SigningPreviousKeys...).
+		WithTTL(config.LinkTTL)
+	openingIndex := explorer.NewIndex()
+	go func() {
+		if err := openingIndex.Rebuild(archiveStorage, ""); err != nil {
+			log.Printf("Failed to build the opening explorer index: %v\n", err)
+		}
+	}()
+	analyzers, err := analyzerRegistry(config)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var puzzleGenerator *puzzles.Generator
+	var reviewer analysis.Reviewer
+	var coach engine.Evaluator
+	if config.EnginePath != "" {
+		uciEngine, err := engine.NewUCIEngine(config.EngineDepth, config.EngineTimeout, config.EnginePath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer uciEngine.Close()
+		puzzleGenerator = puzzles.NewGenerator(uciEngine)
+		reviewer = analysis.NewEngineReviewer(uciEngine)
+		coach = uciEngine
+	}
+	var prober tablebase.Prober
+	if config.TablebasePath != "" {
+		prober = tablebase.NewFathom(config.TablebasePath, config.FathomPath)
+	}
+	replayLink := rendering.NewReplayLink(config.Hostname, config.SigningKey, config.SigningPreviousKeys...).
+		WithTTL(config.LinkTTL).
+		WithLegacyDeadline(legacyLinksUntil)
+	renderCache, err := rendering.NewRenderCache(config.RenderCacheSize, config.RenderCachePath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if config.AdminPort != 0 {
+		// Operational endpoints are served on their own listener, which shouldn't be exposed publicly
+		admin := http.NewServeMux()
+		admin.Handle("/cache", rendering.CacheStatsHandler{Cache: renderCache})
+		go func() {
+			log.Fatal(http.ListenAndServe(fmt.Sprintf("127.0.0.1:%v", config.AdminPort), admin))
+		}()
+	}
+	if puzzleGenerator != nil {
+		// puzzles of the day are found by the engine, without one there is nothing to post
+		go integration.PuzzlePoster{
+			AuthStorage:   authStorage,
+			PuzzleStorage: puzzleStorage,
+			LinkRenderer:  renderLink,
+			Hour:          config.PuzzleHour,
+		}.Run(time.Minute)
+	}
+	boardRenderHandler := rendering.BoardRenderHandler{
 		LinkRenderer: renderLink,
-	})
-	http.Handle("/analyze", analysis.NewHTTPHandler(gameStorage, analysis.NewChesscomAnalyzer(config.ChessAffiliateCode)))
+		Cache:        renderCache,
+	}
+	http.Handle("/board", boardRenderHandler)
+	http.Handle("/board.png", boardRenderHandler)
+	http.Handle("/board.svg", boardRenderHandler)
+	http.Handle("/replay.gif", rendering.NewReplayHandler(gameStorage, replayLink, rendering.ReplayOptions{
+		Options: rendering.Options{
+			BoardSize: config.ReplayBoardSize,
+		},
+		FrameDelay: config.ReplayFrameDelay,
+	}))
+	http.Handle("/game/", rendering.NewPositionHandler(gameStorage, positionLink, ""))
+	http.Handle("/scoresheet.pdf", rendering.NewScoresheetHandler(gameStorage, scoresheetLink, ""))
+	http.Handle("/scoresheet.png", rendering.NewScoresheetHandler(gameStorage, scoresheetLink, ""))
+	http.Handle("/export.pgn", archive.NewHTTPHandler(archiveStorage, exportLink))
+	http.Handle("/explore", explorer.NewHTTPHandler(openingIndex, exploreLink, renderLink))
+	http.Handle("/analyze", analysis.NewHTTPHandler(gameStorage, analyzers))
 	http.Handle("/slack", integration.SlackHandler{
-		SigningKey:       config.SlackSigningKey,
-		Hostname:         config.Hostname,
-		AuthStorage:      authStorage,
-		GameStorage:      gameStorage,
-		ChallengeStorage: challengeStorage,
-		TakebackStorage:  takebackStorage,
-		LinkRenderer:     renderLink,
+		SigningKey:         config.SlackSigningKey,
+		Hostname:           config.Hostname,
+		AuthStorage:        authStorage,
+		GameStorage:        gameStorage,
+		ChallengeStorage:   challengeStorage,
+		TakebackStorage:    takebackStorage,
+		ArchiveStorage:     archiveStorage,
+		PlayerAliasStorage: aliasStorage,
+		PreferenceStorage:  preferenceStorage,
+		LinkRenderer:       renderLink,
+		ExportLink:         exportLink,
+		ReplayLink:         replayLink,
+		PositionLink:       positionLink,
+		ScoresheetLink:     scoresheetLink,
+		Explorer:           openingIndex,
+		ExploreLink:        exploreLink,
+		PuzzleStorage:      puzzleStorage,
+		PuzzleGenerator:    puzzleGenerator,
+		Reviewer:           reviewer,
+		AnalysisProviders:  analyzers.Names(),
+		Tablebase:          prober,
+		Coach:              coach,
+		BoardStorage:       boardStorage,
 	})
 	http.Handle("/slack/action", integration.SlackActionHandler{
-		SigningKey:       config.SlackSigningKey,
-		Hostname:         config.Hostname,
-		AuthStorage:      authStorage,
-		GameStorage:      gameStorage,
-		ChallengeStorage: challengeStorage,
-		TakebackStorage:  takebackStorage,
-		LinkRenderer:     renderLink,
+		SigningKey:        config.SlackSigningKey,
+		Hostname:          config.Hostname,
+		AuthStorage:       authStorage,
+		GameStorage:       gameStorage,
+		ChallengeStorage:  challengeStorage,
+		TakebackStorage:   takebackStorage,
+		PreferenceStorage: preferenceStorage,
+		LinkRenderer:      renderLink,
 	})
 	http.Handle("/slack/oauth", integration.SlackOauthHandler{
 		SlackClientID:     config.SlackClientID,
@@ -82,3 +258,19 @@ func main() {
 	log.Printf("Listening on port %v\n", config.Port)
 	log.Fatal(http.ListenAndServe(fmt.Sprintf(":%v", config.Port), nil))
 }
+
+// analyzerRegistry registers the configured analysis providers in their order of priority
+func analyzerRegistry(config config.Configuration) (*analysis.Registry, error) {
+	registry := analysis.NewRegistry(config.AnalysisCacheSize)
+	for _, name := range config.Analyzers {
+		switch name {
+		case "chesscom":
+			registry.Register(name, analysis.NewChesscomAnalyzer(config.ChessAffiliateCode))
+		case "lichess":
+			registry.Register(name, analysis.NewLichessAnalyzer())
+		default:
+			return nil, fmt.Errorf("unknown analyzer %v in ANALYZERS", name)
+		}
+	}
+	return registry, nil
+}
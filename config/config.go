@@ -2,20 +2,41 @@
 package config
 
 import (
+	"time"
+
 	"github.com/caarlos0/env"
 )
 
 // Configuration holds all application configuration
 type Configuration struct {
-	Port               int    `env:"PORT" envDefault:"8080"`
-	Hostname           string `env:"HOSTNAME" envDefault:"localhost:8080"`
-	SigningKey         string `env:"SIGNINGKEY"`
-	SqlitePath         string `env:"SQLITEPATH"`
-	SlackAppID         string `env:"SLACKAPPID"`
-	SlackClientID      string `env:"SLACKCLIENTID"`
-	SlackClientSecret  string `env:"SLACKCLIENTSECRET"`
-	SlackSigningKey    string `env:"SLACKSIGNINGKEY"`
-	ChessAffiliateCode string `env:"CHESSAFFILIATECODE" envDefault:"75071678"`
+	Port                int           `env:"PORT" envDefault:"8080"`
+	AdminPort           int           `env:"ADMINPORT"`
+	Hostname            string        `env:"HOSTNAME" envDefault:"localhost:8080"`
+	SigningKey          string        `env:"SIGNINGKEY"`
+	SigningPreviousKeys []string      `env:"SIGNINGPREVIOUSKEYS"`
+	LinkTTL             time.Duration `env:"LINKTTL"`
+	LegacyLinksUntil    string        `env:"LEGACYLINKSUNTIL"`
+	SqlitePath          string        `env:"SQLITEPATH"`
+	BoltPath            string        `env:"BOLTPATH"`
+	TokenKey            string        `env:"TOKENKEY"`
+	TokenPreviousKeys   []string      `env:"TOKENPREVIOUSKEYS"`
+	SlackAppID          string        `env:"SLACKAPPID"`
+	SlackClientID       string        `env:"SLACKCLIENTID"`
+	SlackClientSecret   string        `env:"SLACKCLIENTSECRET"`
+	SlackSigningKey     string        `env:"SLACKSIGNINGKEY"`
+	ChessAffiliateCode  string        `env:"CHESSAFFILIATECODE" envDefault:"75071678"`
+	Analyzers           []string      `env:"ANALYZERS" envDefault:"lichess,chesscom"`
+	AnalysisCacheSize   int           `env:"ANALYSISCACHESIZE" envDefault:"1000"`
+	ReplayFrameDelay    time.Duration `env:"REPLAYFRAMEDELAY" envDefault:"1s"`
+	ReplayBoardSize     int           `env:"REPLAYBOARDSIZE" envDefault:"256"`
+	RenderCacheSize     int           `env:"RENDERCACHESIZE" envDefault:"1000"`
+	RenderCachePath     string        `env:"RENDERCACHEPATH"`
+	EnginePath          string        `env:"ENGINEPATH"`
+	EngineDepth         int           `env:"ENGINEDEPTH" envDefault:"18"`
+	EngineTimeout       time.Duration `env:"ENGINETIMEOUT" envDefault:"1m"`
+	TablebasePath       string        `env:"TABLEBASEPATH"`
+	FathomPath          string        `env:"FATHOMPATH" envDefault:"fathom"`
+	PuzzleHour          int           `env:"PUZZLEHOUR" envDefault:"9"`
 }
 
 // ParseConfiguration retrieves values from environment variables and returns a Configuration struct
@@ -0,0 +1,303 @@
+// Package engine evaluates chess positions with a local engine speaking the UCI (Universal Chess Interface) protocol, such as Stockfish
+package engine
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MateScore is the centipawn value of a forced mate, less the number of moves to it so quicker mates are worth more
+const MateScore = 100000
+
+// Score is the evaluation of a position from the point of view of the side to move
+type Score struct {
+	Centipawns int
+	// Mate is the number of moves to a forced mate, negative when the side to move is getting mated, and 0 without a mate
+	Mate int
+}
+
+// Value converts the score to centipawns, counting forced mates as MateScore
+func (s Score) Value() int {
+	switch {
+	case s.Mate > 0:
+		return MateScore - s.Mate
+	case s.Mate < 0:
+		return -MateScore - s.Mate
+	}
+	return s.Centipawns
+}
+
+// Negate is the same score from the point of view of the other side
+func (s Score) Negate() Score {
+	return Score{Centipawns: -s.Centipawns, Mate: -s.Mate}
+}
+
+func (s Score) String() string {
+	if s.Mate != 0 {
+		return fmt.Sprintf("#%v", s.Mate)
+	}
+	return fmt.Sprintf("%+.2f", float64(s.Centipawns)/100)
+}
+
+// Line is a principal variation found by the engine
+type Line struct {
+	Score Score
+	// Moves are in long algebraic (UCI) notation, such as e2e4 or e7e8q
+	Moves []string
+}
+
+// Evaluator finds the best lines of play from a position
+type Evaluator interface {
+	// Evaluate returns up to the requested number of lines, best first, from a position given as FEN
+	Evaluate(fen string, lines int) ([]Line, error)
+}
+
+// ErrEngineClosed is returned when evaluating with an engine that was closed or whose process exited
+var ErrEngineClosed = errors.New("engine is closed")
+
+// ErrEngineTimeout is returned when the engine doesn't answer in time, after which its process is killed.
+// The engine is started again on the next evaluation.
+var ErrEngineTimeout = errors.New("engine timed out")
+
+// UCIEngine runs a UCI engine process and evaluates one position at a time to a fixed depth
+type UCIEngine struct {
+	mutex   sync.Mutex
+	depth   int
+	timeout time.Duration
+	path    string
+	args    []string
+	// cmd is the running engine process, nil when it died and needs to be started again
+	cmd    *exec.Cmd
+	input  io.WriteCloser
+	output *engineOutput
+	lines  int
+	closed bool
+}
+
+// engineOutput is the output of an engine process, line by line, and the error it ended with
+type engineOutput struct {
+	lines chan string
+	err   error
+}
+
+// NewUCIEngine starts the engine executable at the path (with any arguments) and waits until it is ready.
+// A hung engine would block every evaluation, so it is killed when it takes longer than the timeout to answer,
+// and started again on the next evaluation.
+func NewUCIEngine(depth int, timeout time.Duration, path string, args ...string) (*UCIEngine, error) {
+	e := &UCIEngine{
+		depth:   depth,
+		timeout: timeout,
+		path:    path,
+		args:    args,
+	}
+	if err := e.start(); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// start runs the engine process and waits until it is ready
+func (e *UCIEngine) start() error {
+	cmd := exec.Command(e.path, e.args...)
+	input, err := cmd.StdinPipe()
+	if err != nil {
+		return err
+	}
+	output, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	e.cmd = cmd
+	e.input = input
+	e.output = &engineOutput{lines: make(chan string)}
+	e.lines = 0
+	go e.output.read(bufio.NewScanner(output))
+	if err := e.send("uci"); err != nil {
+		return err
+	}
+	if _, err := e.waitFor("uciok"); err != nil {
+		return err
+	}
+	return e.ready()
+}
+
+// Evaluate searches the position to the engine's depth and returns its best lines
+func (e *UCIEngine) Evaluate(fen string, lines int) ([]Line, error) {
+	if lines < 1 {
+		lines = 1
+	}
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	if e.closed {
+		return nil, ErrEngineClosed
+	}
+	if e.cmd == nil {
+		if err := e.start(); err != nil {
+			return nil, err
+		}
+	}
+	if lines != e.lines {
+		if err := e.send(fmt.Sprintf("setoption name MultiPV value %v", lines)); err != nil {
+			return nil, err
+		}
+		e.lines = lines
+	}
+	if err := e.ready(); err != nil {
+		return nil, err
+	}
+	if err := e.send("position fen " + fen); err != nil {
+		return nil, err
+	}
+	if err := e.send(fmt.Sprintf("go depth %v", e.depth)); err != nil {
+		return nil, err
+	}
+	found := make([]Line, lines)
+	info, err := e.waitFor("bestmove")
+	if err != nil {
+		return nil, err
+	}
+	for _, text := range info {
+		if index, line, ok := parseInfo(text); ok && index <= lines {
+			found[index-1] = line
+		}
+	}
+	result := []Line{}
+	for _, line := range found {
+		if len(line.Moves) > 0 {
+			result = append(result, line)
+		}
+	}
+	return result, nil
+}
+
+// Close quits the engine process
+func (e *UCIEngine) Close() error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.closed = true
+	if e.cmd == nil {
+		return nil
+	}
+	io.WriteString(e.input, "quit\n")
+	e.input.Close()
+	for range e.output.lines {
+	}
+	err := e.cmd.Wait()
+	e.cmd = nil
+	return err
+}
+
+func (e *UCIEngine) ready() error {
+	if err := e.send("isready"); err != nil {
+		return err
+	}
+	_, err := e.waitFor("readyok")
+	return err
+}
+
+// send writes a command to the engine, stopping it when the command can't be written as the process has died
+func (e *UCIEngine) send(command string) error {
+	if _, err := io.WriteString(e.input, command+"\n"); err != nil {
+		e.stop()
+		return err
+	}
+	return nil
+}
+
+// stop kills the engine process so it is started again on the next evaluation.
+// Its remaining output is drained so the goroutine reading it exits, and the process is waited on so it doesn't linger.
+func (e *UCIEngine) stop() {
+	e.cmd.Process.Kill()
+	go func(cmd *exec.Cmd, output *engineOutput) {
+		for range output.lines {
+		}
+		cmd.Wait()
+	}(e.cmd, e.output)
+	e.cmd = nil
+}
+
+// read forwards the engine's output line by line until the process exits
+func (o *engineOutput) read(output *bufio.Scanner) {
+	for output.Scan() {
+		o.lines <- output.Text()
+	}
+	o.err = output.Err()
+	close(o.lines)
+}
+
+// waitFor reads the engine's output until a line starting with the token, returning every line read before it.
+// The engine is killed if the token doesn't come within the timeout.
+func (e *UCIEngine) waitFor(token string) ([]string, error) {
+	deadline := time.NewTimer(e.timeout)
+	defer deadline.Stop()
+	output := e.output
+	read := []string{}
+	for {
+		select {
+		case text, ok := <-output.lines:
+			if !ok {
+				e.stop()
+				if output.err != nil {
+					return nil, output.err
+				}
+				return nil, ErrEngineClosed
+			}
+			if strings.HasPrefix(text, token) {
+				return read, nil
+			}
+			read = append(read, text)
+		case <-deadline.C:
+			e.stop()
+			return nil, ErrEngineTimeout
+		}
+	}
+}
+
+// parseInfo reads the line number (starting at 1), score and principal variation of an info line of the engine's output
+func parseInfo(text string) (int, Line, bool) {
+	fields := strings.Fields(text)
+	if len(fields) == 0 || fields[0] != "info" {
+		return 0, Line{}, false
+	}
+	index, line, scored := 1, Line{}, false
+	for i := 1; i < len(fields); i++ {
+		switch fields[i] {
+		case "multipv":
+			if i+1 < len(fields) {
+				index, _ = strconv.Atoi(fields[i+1])
+			}
+		case "score":
+			if i+2 < len(fields) {
+				value, err := strconv.Atoi(fields[i+2])
+				if err != nil {
+					return 0, Line{}, false
+				}
+				switch fields[i+1] {
+				case "cp":
+					line.Score.Centipawns = value
+					scored = true
+				case "mate":
+					line.Score.Mate = value
+					scored = true
+				}
+			}
+		case "pv":
+			line.Moves = fields[i+1:]
+			i = len(fields)
+		}
+	}
+	if !scored || len(line.Moves) == 0 || index < 1 {
+		return 0, Line{}, false
+	}
+	return index, line, true
+}
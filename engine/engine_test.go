@@ -0,0 +1,108 @@
+package engine_test
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cjsaylor/chessbot/engine"
+)
+
+// TestHelperProcess isn't a real test, it's a fake UCI engine started by the tests below
+func TestHelperProcess(t *testing.T) {
+	if os.Getenv("GO_WANT_HELPER_PROCESS") != "1" {
+		return
+	}
+	defer os.Exit(0)
+	lines := 1
+	input := bufio.NewScanner(os.Stdin)
+	for input.Scan() {
+		command := input.Text()
+		switch {
+		case command == "uci":
+			fmt.Println("id name Fake")
+			fmt.Println("uciok")
+		case command == "isready":
+			fmt.Println("readyok")
+		case strings.HasPrefix(command, "setoption name MultiPV value "):
+			fmt.Sscan(strings.TrimPrefix(command, "setoption name MultiPV value "), &lines)
+		case strings.HasPrefix(command, "go") && os.Getenv("HELPER_PROCESS_HANGS") == "1":
+			// Never answer, like an engine stuck in a search
+		case strings.HasPrefix(command, "go"):
+			fmt.Println("info depth 1 seldepth 1 multipv 1 score cp 10 nodes 20 pv e2e4")
+			fmt.Println("info depth 2 seldepth 2 multipv 1 score cp 35 nodes 40 pv e2e4 e7e5")
+			if lines > 1 {
+				fmt.Println("info depth 2 seldepth 2 multipv 2 score mate -3 nodes 40 pv f2f3 e7e5")
+			}
+			fmt.Println("info string the end")
+			fmt.Println("bestmove e2e4 ponder e7e5")
+		case command == "quit":
+			return
+		}
+	}
+}
+
+func TestUCIEngine(t *testing.T) {
+	os.Setenv("GO_WANT_HELPER_PROCESS", "1")
+	defer os.Unsetenv("GO_WANT_HELPER_PROCESS")
+	uci, err := engine.NewUCIEngine(2, time.Minute, os.Args[0], "-test.run=TestHelperProcess")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer uci.Close()
+	lines, err := uci.Evaluate("rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %v", len(lines))
+	}
+	if lines[0].Score.Centipawns != 35 || strings.Join(lines[0].Moves, " ") != "e2e4 e7e5" {
+		t.Errorf("expected the deepest line to be kept, got %v", lines[0])
+	}
+	if lines[1].Score.Mate != -3 || lines[1].Score.Value() != -engine.MateScore+3 {
+		t.Errorf("expected to be mated in 3, got %v", lines[1].Score)
+	}
+	if lines, err = uci.Evaluate("rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1", 1); err != nil || len(lines) != 1 {
+		t.Errorf("expected a single line, got %v (%v)", lines, err)
+	}
+	uci.Close()
+	if _, err := uci.Evaluate("rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1", 1); err != engine.ErrEngineClosed {
+		t.Errorf("expected a closed engine not to be restarted, got %v", err)
+	}
+}
+
+func TestUCIEngineTimeout(t *testing.T) {
+	os.Setenv("GO_WANT_HELPER_PROCESS", "1")
+	os.Setenv("HELPER_PROCESS_HANGS", "1")
+	defer os.Unsetenv("GO_WANT_HELPER_PROCESS")
+	defer os.Unsetenv("HELPER_PROCESS_HANGS")
+	uci, err := engine.NewUCIEngine(2, 500*time.Millisecond, os.Args[0], "-test.run=TestHelperProcess")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer uci.Close()
+	if _, err := uci.Evaluate("rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1", 1); err != engine.ErrEngineTimeout {
+		t.Fatalf("expected the hung engine to time out, got %v", err)
+	}
+	// the engine started in place of the killed one answers
+	os.Unsetenv("HELPER_PROCESS_HANGS")
+	if lines, err := uci.Evaluate("rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1", 1); err != nil || len(lines) != 1 {
+		t.Errorf("expected the engine to be restarted after the timeout, got %v (%v)", lines, err)
+	}
+}
+
+func TestScore(t *testing.T) {
+	if (engine.Score{Mate: 2}).Value() <= (engine.Score{Mate: 5}).Value() {
+		t.Error("expected a quicker mate to be worth more")
+	}
+	if score := (engine.Score{Centipawns: 125}); score.String() != "+1.25" || score.Negate().String() != "-1.25" {
+		t.Errorf("unexpected score text %v", score)
+	}
+	if score := (engine.Score{Mate: -3}); score.String() != "#-3" {
+		t.Errorf("unexpected score text %v", score)
+	}
+}
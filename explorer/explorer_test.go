@@ -0,0 +1,126 @@
+package explorer_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cjsaylor/chessbot/explorer"
+	"github.com/cjsaylor/chessbot/game"
+	"github.com/cjsaylor/chessbot/rendering"
+	"github.com/notnil/chess"
+)
+
+func archivedGame(ID string, teamID string, moves []string, resigning game.Color) *game.ArchivedGame {
+	gm := game.NewGame(ID, game.Player{ID: "a"}, game.Player{ID: "b"})
+	for _, move := range moves {
+		gm.Move(move)
+	}
+	gm.Resign(gm.Players[resigning])
+	archived, _ := gm.Archive(teamID)
+	return archived
+}
+
+func coachedGame(ID string, teamID string, moves []string, resigning game.Color) *game.ArchivedGame {
+	gm := game.NewGame(ID, game.Player{ID: "a"}, game.Player{ID: "b"})
+	gm.SetCoached()
+	for _, move := range moves {
+		gm.Move(move)
+	}
+	gm.Resign(gm.Players[resigning])
+	archived, _ := gm.Archive(teamID)
+	return archived
+}
+
+func indexedStore() *game.MemoryStore {
+	store := game.NewMemoryStore()
+	store.StoreArchivedGame(archivedGame("1", "team", []string{"e2e4", "c7c5", "g1f3"}, game.Black))
+	store.StoreArchivedGame(archivedGame("2", "team", []string{"e2e4", "e7e5"}, game.White))
+	store.StoreArchivedGame(archivedGame("3", "team", []string{"e2e4", "c7c5", "b1c3"}, game.Black))
+	store.StoreArchivedGame(archivedGame("4", "team", []string{"d2d4"}, game.White))
+	store.StoreArchivedGame(archivedGame("5", "other", []string{"c2c4"}, game.White))
+	// coached games are unrated and left out of the tree
+	store.StoreArchivedGame(coachedGame("6", "team", []string{"e2e4", "e7e6"}, game.White))
+	return store
+}
+
+func TestIndex(t *testing.T) {
+	index := explorer.NewIndex()
+	if err := index.Rebuild(indexedStore(), ""); err != nil {
+		t.Fatal(err)
+	}
+	start, _ := explorer.PositionsFromMoves("")
+	continuations := index.Continuations("team", start[0])
+	if len(continuations) != 2 || continuations[0].Move != "e4" || continuations[0].Games != 3 {
+		t.Fatalf("expected e4 to be played 3 times, got %+v", continuations)
+	}
+	if e4 := continuations[0]; e4.WhiteWins != 2 || e4.BlackWins != 1 || e4.Draws != 0 {
+		t.Errorf("unexpected results after e4 %+v", e4)
+	}
+	positions, err := explorer.PositionsFromMoves("1. e4 c5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if continuations := index.Continuations("team", positions[2]); len(continuations) != 2 || continuations[0].WhitePercent() != 100 {
+		t.Errorf("expected Nc3 and Nf3 won by White, got %+v", continuations)
+	}
+	if continuations := index.Continuations("other", start[0]); len(continuations) != 1 || continuations[0].Move != "c4" {
+		t.Errorf("expected teams to have separate trees, got %+v", continuations)
+	}
+	// Transposing into the position after 1. e4 through a FEN without the en passant square
+	position, _ := explorer.PositionFromFEN("rnbqkbnr/pppppppp/8/8/4P3/8/PPPP1PPP/RNBQKBNR b KQkq - 0 1")
+	if continuations := index.Continuations("team", position); len(continuations) != 2 {
+		t.Errorf("expected the continuations after e4, got %+v", continuations)
+	}
+}
+
+func TestExplorePage(t *testing.T) {
+	index := explorer.NewIndex()
+	index.Rebuild(indexedStore(), "")
+	exploreLink := explorer.NewExploreLink("", "secret")
+	handler := explorer.NewHTTPHandler(index, exploreLink, rendering.NewRenderLink("", "secret"))
+	link, _ := exploreLink.CreateLink("team", "e4")
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, link.String(), nil))
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected status %v, got %v", http.StatusOK, recorder.Code)
+	}
+	body := recorder.Body.String()
+	if !strings.Contains(body, "B00 King&#39;s Pawn Game") || !strings.Contains(body, ">c5</a>") || !strings.Contains(body, "moves=e4&#43;c5") {
+		t.Errorf("expected the continuations of the King's Pawn Game to be linked, got %v", body)
+	}
+
+	// Following the continuations keeps the link valid, but the team is signed
+	q := link.Query()
+	q.Set("moves", "e4 c5 Nf3")
+	link.RawQuery = q.Encode()
+	if !exploreLink.ValidateLink(*link) {
+		t.Error("expected the position to be changeable")
+	}
+	q.Set("team_id", "other")
+	link.RawQuery = q.Encode()
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, link.String(), nil))
+	if recorder.Code != http.StatusForbidden {
+		t.Errorf("expected status %v for another team, got %v", http.StatusForbidden, recorder.Code)
+	}
+
+	link, _ = exploreLink.CreateLink("team", "e4 e4")
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, link.String(), nil))
+	if recorder.Code != http.StatusBadRequest {
+		t.Errorf("expected status %v for an illegal move, got %v", http.StatusBadRequest, recorder.Code)
+	}
+}
+
+func TestPositionsFromMoves(t *testing.T) {
+	positions, err := explorer.PositionsFromMoves("1.e4 c5 2. Nf3 d7d6")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(positions) != 5 || positions[4].Turn() != chess.White {
+		t.Errorf("expected 4 moves to be played, got %v positions", len(positions))
+	}
+}
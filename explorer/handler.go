@@ -0,0 +1,170 @@
+package explorer
+
+import (
+	"html/template"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/cjsaylor/chessbot/openings"
+	"github.com/cjsaylor/chessbot/rendering"
+	"github.com/notnil/chess"
+)
+
+// maxArrows is the number of most played continuations drawn on the board
+const maxArrows = 3
+
+var explorerTemplate = template.Must(template.New("explorer").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Opening explorer</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; margin-top: 1em; }
+td, th { padding: 0.3em 0.8em; text-align: left; }
+.results { display: flex; width: 20em; height: 1.2em; font-size: 0.8em; }
+.results span { overflow: hidden; text-align: center; }
+.white { background: #eeeeee; }
+.draw { background: #a0a0a0; }
+.black { background: #333333; color: #ffffff; }
+</style>
+</head>
+<body>
+<h1>Opening explorer</h1>
+{{if .Opening}}<h2>{{.Opening}}</h2>{{end}}
+<p><a href="{{.StartLink}}">Start</a>{{if .Moves}} {{.Moves}}{{end}}</p>
+<img src="{{.BoardLink}}" alt="{{.FEN}}" width="512" height="512">
+{{if .Continuations}}
+<table>
+<tr><th>Move</th><th>Games</th><th>White / Draw / Black</th></tr>
+{{range .Continuations}}
+<tr>
+<td><a href="{{.Link}}">{{.Move}}</a></td>
+<td>{{.Games}}</td>
+<td><div class="results">
+<span class="white" style="width: {{printf "%.0f" .WhitePercent}}%">{{printf "%.0f" .WhitePercent}}%</span>
+<span class="draw" style="width: {{printf "%.0f" .DrawPercent}}%">{{printf "%.0f" .DrawPercent}}%</span>
+<span class="black" style="width: {{printf "%.0f" .BlackPercent}}%">{{printf "%.0f" .BlackPercent}}%</span>
+</div></td>
+</tr>
+{{end}}
+</table>
+{{else}}
+<p>No games of the team reached this position.</p>
+{{end}}
+</body>
+</html>
+`))
+
+// ContinuationArrows draws the most played continuations from a position as arrows
+func ContinuationArrows(position *chess.Position, continuations []Continuation) rendering.Annotations {
+	annotations := rendering.Annotations{}
+	for _, continuation := range continuations {
+		if len(annotations.Arrows) == maxArrows {
+			break
+		}
+		if move, err := (chess.AlgebraicNotation{}).Decode(position, continuation.Move); err == nil {
+			annotations.Arrows = append(annotations.Arrows, rendering.Arrow{From: move.S1(), To: move.S2()})
+		}
+	}
+	return annotations
+}
+
+// linkedContinuation is a continuation with the link exploring the position after it
+type linkedContinuation struct {
+	Continuation
+	Link string
+}
+
+// Handler is an http handler that shows the continuations played by a team from a position as a web page
+type Handler struct {
+	index       *Index
+	exploreLink ExploreLink
+	renderLink  rendering.RenderLink
+}
+
+// NewHTTPHandler returns an instance of an opening explorer endpoint handler
+func NewHTTPHandler(index *Index, exploreLink ExploreLink, renderLink rendering.RenderLink) *Handler {
+	return &Handler{
+		index:       index,
+		exploreLink: exploreLink,
+		renderLink:  renderLink,
+	}
+}
+
+func (h Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if !h.exploreLink.ValidateLink(*r.URL) {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	query := r.URL.Query()
+	moves := strings.TrimSpace(query.Get("moves"))
+	var positions []*chess.Position
+	var err error
+	if fen := query.Get("fen"); fen != "" {
+		var position *chess.Position
+		position, err = PositionFromFEN(fen)
+		positions = []*chess.Position{position}
+	} else {
+		positions, err = PositionsFromMoves(moves)
+	}
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	position := positions[len(positions)-1]
+	continuations := h.index.Continuations(query.Get("team_id"), position)
+	linked := make([]linkedContinuation, 0, len(continuations))
+	for _, continuation := range continuations {
+		move, err := chess.AlgebraicNotation{}.Decode(position, continuation.Move)
+		if err != nil {
+			continue
+		}
+		next := r.URL.Query()
+		if query.Get("fen") != "" {
+			next.Set("fen", position.Update(move).String())
+		} else {
+			next.Set("moves", strings.TrimSpace(moves+" "+continuation.Move))
+		}
+		linked = append(linked, linkedContinuation{
+			Continuation: continuation,
+			Link:         r.URL.Path + "?" + next.Encode(),
+		})
+	}
+	boardLink, err := h.renderLink.CreatePositionLink(position.String(), ContinuationArrows(position, continuations))
+	if err != nil {
+		log.Println(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	start := r.URL.Query()
+	for _, param := range positionParams {
+		start.Del(param)
+	}
+	page := struct {
+		Opening       string
+		Moves         string
+		FEN           string
+		BoardLink     string
+		StartLink     string
+		Continuations []linkedContinuation
+	}{
+		Moves:         moves,
+		FEN:           position.String(),
+		BoardLink:     boardLink.String(),
+		StartLink:     r.URL.Path + "?" + start.Encode(),
+		Continuations: linked,
+	}
+	if opening, ok := openings.Classify(positions); ok {
+		page.Opening = opening.String()
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := explorerTemplate.Execute(w, page); err != nil {
+		log.Println(err)
+	}
+}
@@ -0,0 +1,147 @@
+// Package explorer builds an opening tree of the completed games of each team, to explore how positions were played
+package explorer
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/cjsaylor/chessbot/game"
+	"github.com/cjsaylor/chessbot/openings"
+	"github.com/notnil/chess"
+)
+
+// indexedPlies is how deep into each game positions are indexed
+const indexedPlies = 40
+
+// Continuation is a move played from a position and the results of the games it was played in
+type Continuation struct {
+	// Move is in standard algebraic notation
+	Move      string
+	Games     int
+	WhiteWins int
+	Draws     int
+	BlackWins int
+}
+
+// WhitePercent is the share of games won by White after the move
+func (c Continuation) WhitePercent() float64 {
+	return percent(c.WhiteWins, c.Games)
+}
+
+// DrawPercent is the share of drawn games after the move
+func (c Continuation) DrawPercent() float64 {
+	return percent(c.Draws, c.Games)
+}
+
+// BlackPercent is the share of games won by Black after the move
+func (c Continuation) BlackPercent() float64 {
+	return percent(c.BlackWins, c.Games)
+}
+
+func percent(count int, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(count) * 100 / float64(total)
+}
+
+// Index is the opening tree of the completed games of each team.
+// Positions are keyed by openings.PositionKey so transpositions share their continuations.
+type Index struct {
+	mutex sync.RWMutex
+	// teams maps a team to its positions, and each position to the continuations played from it by their move
+	teams map[string]map[string]map[string]*Continuation
+}
+
+// NewIndex creates an empty opening tree
+func NewIndex() *Index {
+	return &Index{
+		teams: map[string]map[string]map[string]*Continuation{},
+	}
+}
+
+// Add indexes the opening of a completed game. Unrated (coached) games are left out of the tree.
+func (i *Index) Add(archived *game.ArchivedGame) error {
+	if !archived.Rated() {
+		return nil
+	}
+	option, err := chess.PGN(strings.NewReader(game.StripNAGs(archived.PGN)))
+	if err != nil {
+		return err
+	}
+	gm := chess.NewGame(option)
+	positions, moves := gm.Positions(), gm.Moves()
+	if len(moves) > indexedPlies {
+		moves = moves[:indexedPlies]
+	}
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	positionMap, ok := i.teams[archived.TeamID]
+	if !ok {
+		positionMap = map[string]map[string]*Continuation{}
+		i.teams[archived.TeamID] = positionMap
+	}
+	for ply, move := range moves {
+		key := openings.PositionKey(positions[ply])
+		continuations, ok := positionMap[key]
+		if !ok {
+			continuations = map[string]*Continuation{}
+			positionMap[key] = continuations
+		}
+		san := chess.AlgebraicNotation{}.Encode(positions[ply], move)
+		continuation, ok := continuations[san]
+		if !ok {
+			continuation = &Continuation{Move: san}
+			continuations[san] = continuation
+		}
+		continuation.Games++
+		switch archived.Result {
+		case chess.WhiteWon:
+			continuation.WhiteWins++
+		case chess.BlackWon:
+			continuation.BlackWins++
+		default:
+			continuation.Draws++
+		}
+	}
+	return nil
+}
+
+// Rebuild replaces the opening tree of a team (or of every team if empty) with its stored games
+func (i *Index) Rebuild(storage game.ArchiveStorage, teamID string) error {
+	rebuilt := NewIndex()
+	err := storage.RetrieveArchivedGames(game.ArchiveFilter{TeamID: teamID}, func(archived *game.ArchivedGame) error {
+		// Games that fail to parse are left out of the tree rather than failing the whole team
+		rebuilt.Add(archived)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	if teamID == "" {
+		i.teams = rebuilt.teams
+		return nil
+	}
+	i.teams[teamID] = rebuilt.teams[teamID]
+	return nil
+}
+
+// Continuations lists the moves played by a team from a position, the most played first
+func (i *Index) Continuations(teamID string, position *chess.Position) []Continuation {
+	i.mutex.RLock()
+	defer i.mutex.RUnlock()
+	continuations := []Continuation{}
+	for _, continuation := range i.teams[teamID][openings.PositionKey(position)] {
+		continuations = append(continuations, *continuation)
+	}
+	sort.Slice(continuations, func(a, b int) bool {
+		if continuations[a].Games != continuations[b].Games {
+			return continuations[a].Games > continuations[b].Games
+		}
+		return continuations[a].Move < continuations[b].Move
+	})
+	return continuations
+}
@@ -0,0 +1,67 @@
+package explorer
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/cjsaylor/chessbot/rendering"
+)
+
+// positionParams select the position to explore. They are left out of the signature,
+// so a link to the explorer of a team can be followed from position to position.
+var positionParams = []string{"fen", "moves"}
+
+// ExploreLink is a simple struct for creating valid external opening explorer URLs
+type ExploreLink struct {
+	hostName string
+	signer   rendering.URLSigner
+}
+
+// NewExploreLink creates a new ExploreLink struct instance
+func NewExploreLink(hostname string, signingKey string, previousKeys ...string) ExploreLink {
+	return ExploreLink{
+		hostName: hostname,
+		signer:   rendering.NewURLSigner("explore", signingKey, previousKeys...),
+	}
+}
+
+// WithTTL returns a copy of the explore link creator whose links expire after the duration
+func (e ExploreLink) WithTTL(ttl time.Duration) ExploreLink {
+	e.signer = e.signer.WithTTL(ttl)
+	return e
+}
+
+// CreateLink returns an externally accessible URL exploring the games of a team from the position after a line of moves
+func (e ExploreLink) CreateLink(teamID string, moves string) (*url.URL, error) {
+	return e.createLink(teamID, "moves", moves)
+}
+
+// CreateFENLink returns an externally accessible URL exploring the games of a team from a position
+func (e ExploreLink) CreateFENLink(teamID string, fen string) (*url.URL, error) {
+	return e.createLink(teamID, "fen", fen)
+}
+
+func (e ExploreLink) createLink(teamID string, param string, value string) (*url.URL, error) {
+	u, err := url.Parse(fmt.Sprintf("%v/explore", e.hostName))
+	if err != nil {
+		return nil, err
+	}
+	q := u.Query()
+	q.Add("team_id", teamID)
+	e.signer.Sign(q)
+	if value != "" {
+		q.Add(param, value)
+	}
+	u.RawQuery = q.Encode()
+	return u, nil
+}
+
+// ValidateLink ensures that the team of the link is signed properly with the app signing key
+func (e ExploreLink) ValidateLink(u url.URL) bool {
+	q := u.Query()
+	for _, param := range positionParams {
+		q.Del(param)
+	}
+	return e.signer.Verify(q) == nil
+}
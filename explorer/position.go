@@ -0,0 +1,41 @@
+package explorer
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/notnil/chess"
+)
+
+// moveNumberPattern matches the move numbers of a line of moves, such as "1." or "12..."
+var moveNumberPattern = regexp.MustCompile(`^\d+\.+`)
+
+// PositionFromFEN reads the position of a FEN
+func PositionFromFEN(fen string) (*chess.Position, error) {
+	option, err := chess.FEN(fen)
+	if err != nil {
+		return nil, err
+	}
+	return chess.NewGame(option).Position(), nil
+}
+
+// PositionsFromMoves plays a line of moves from the starting position, such as "1. e4 c5 2. Nf3" (or "e2e4 c7c5"),
+// and returns every position of the line, starting with the starting position.
+func PositionsFromMoves(moves string) ([]*chess.Position, error) {
+	positions := []*chess.Position{chess.NewGame().Position()}
+	for _, token := range strings.Fields(moves) {
+		token = moveNumberPattern.ReplaceAllString(token, "")
+		if token == "" {
+			continue
+		}
+		position := positions[len(positions)-1]
+		move, err := chess.AlgebraicNotation{}.Decode(position, token)
+		if err != nil {
+			if move, err = (chess.LongAlgebraicNotation{}).Decode(position, token); err != nil {
+				return nil, err
+			}
+		}
+		positions = append(positions, position.Update(move))
+	}
+	return positions, nil
+}
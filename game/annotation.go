@@ -0,0 +1,136 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ErrNoSuchPly is an error representing an action on a ply the game doesn't have.
+var ErrNoSuchPly = errors.New("game has no such ply")
+
+// glyphNAGs are the Numeric Annotation Glyphs of the move quality glyphs, as numbered by the PGN standard
+var glyphNAGs = map[string]int{
+	"!":  1,
+	"?":  2,
+	"!!": 3,
+	"??": 4,
+	"!?": 5,
+	"?!": 6,
+}
+
+// nagPattern matches numeric annotation glyphs ($1, $14, etc) which the PGN parser does not support
+var nagPattern = regexp.MustCompile(`\$\d+`)
+
+// StripNAGs removes the numeric annotation glyphs of a PGN, such as the output of Export, so it can be parsed by the chess package
+func StripNAGs(pgn string) string {
+	return nagPattern.ReplaceAllString(pgn, "")
+}
+
+// MoveAnnotation is a player's comment and Numeric Annotation Glyph (NAG) on a move of the game
+type MoveAnnotation struct {
+	// NAG is the PGN numeric annotation glyph of the move ($1 being a good move), or 0 for none
+	NAG     int    `json:",omitempty"`
+	Comment string `json:",omitempty"`
+}
+
+// ParseNAG parses a move quality glyph (!!, !, !?, ?!, ?, ??) or a numeric annotation glyph ($14) into its NAG
+func ParseNAG(text string) (int, bool) {
+	if nag, ok := glyphNAGs[text]; ok {
+		return nag, true
+	}
+	if !strings.HasPrefix(text, "$") {
+		return 0, false
+	}
+	nag, err := strconv.Atoi(text[1:])
+	if err != nil || nag < 0 || nag > 255 {
+		return 0, false
+	}
+	return nag, true
+}
+
+// Glyph returns the move quality glyph of the NAG, or an empty string if the NAG isn't one of them
+func (a MoveAnnotation) Glyph() string {
+	for glyph, nag := range glyphNAGs {
+		if nag == a.NAG {
+			return glyph
+		}
+	}
+	return ""
+}
+
+// IsEmpty determines if the annotation has neither a NAG nor a comment
+func (a MoveAnnotation) IsEmpty() bool {
+	return a.NAG == 0 && a.Comment == ""
+}
+
+// pgn formats the annotation as it follows its move in PGN movetext, such as "$6 {missed Nf5 here}"
+func (a MoveAnnotation) pgn() string {
+	parts := []string{}
+	if a.NAG != 0 {
+		parts = append(parts, fmt.Sprintf("$%v", a.NAG))
+	}
+	if a.Comment != "" {
+		// Comments can't be nested, so braces in the comment would end it early
+		comment := strings.NewReplacer("{", "(", "}", ")").Replace(a.Comment)
+		parts = append(parts, "{"+strings.Join(strings.Fields(comment), " ")+"}")
+	}
+	return strings.Join(parts, " ")
+}
+
+// AnnotateMove annotates the ply (starting at 1) with a NAG and/or comment.
+// Only the parts provided replace those of an existing annotation of the ply.
+func (g *Game) AnnotateMove(ply int, annotation MoveAnnotation) error {
+	if ply < 1 || ply > len(g.game.Moves()) {
+		return ErrNoSuchPly
+	}
+	existing := g.annotations[ply]
+	if annotation.NAG != 0 {
+		existing.NAG = annotation.NAG
+	}
+	if annotation.Comment != "" {
+		existing.Comment = annotation.Comment
+	}
+	if g.annotations == nil {
+		g.annotations = make(map[int]MoveAnnotation)
+	}
+	g.annotations[ply] = existing
+	return nil
+}
+
+// MoveAnnotations returns the annotations of the game keyed by their ply (starting at 1)
+func (g *Game) MoveAnnotations() map[int]MoveAnnotation {
+	annotations := make(map[int]MoveAnnotation, len(g.annotations))
+	for ply, annotation := range g.annotations {
+		annotations[ply] = annotation
+	}
+	return annotations
+}
+
+// annotateMovetext adds the annotations of the game after their moves in PGN movetext exported by the chess package.
+// A comment interrupts its move pair, so Black's move after one is numbered again ("12...Nf5").
+func (g *Game) annotateMovetext(movetext string) string {
+	if len(g.annotations) == 0 {
+		return movetext
+	}
+	tokens := strings.Fields(movetext)
+	moves := len(g.game.Moves())
+	if len(tokens) < moves {
+		return movetext
+	}
+	annotated := []string{}
+	for i, token := range tokens[:moves] {
+		ply := i + 1
+		if ply%2 == 0 && g.annotations[ply-1].Comment != "" {
+			token = fmt.Sprintf("%v...%v", ply/2, token)
+		}
+		annotated = append(annotated, token)
+		if annotation := g.annotations[ply]; !annotation.IsEmpty() {
+			annotated = append(annotated, annotation.pgn())
+		}
+	}
+	annotated = append(annotated, tokens[moves:]...)
+	return strings.Join(annotated, " ")
+}
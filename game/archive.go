@@ -0,0 +1,126 @@
+package game
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/notnil/chess"
+)
+
+// ErrGameInProgress is an error representing an action that failed due to the game not being completed yet.
+var ErrGameInProgress = errors.New("game is still in progress")
+
+// ArchivedGame is the permanent record of a completed game
+type ArchivedGame struct {
+	GameID  string
+	TeamID  string
+	WhiteID string
+	BlackID string
+	Result  chess.Outcome
+	Method  string
+	EndedAt time.Time
+	PGN     string
+}
+
+// Rated determines if the game counts towards ratings and the team's statistics, which coached games do not.
+// It reads the Rated tag the game was archived with.
+func (a *ArchivedGame) Rated() bool {
+	for _, line := range strings.Split(a.PGN, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "[") {
+			break
+		}
+		if line == `[Rated "No"]` {
+			return false
+		}
+	}
+	return true
+}
+
+// ArchiveFilter narrows down which archived games are retrieved.
+// Empty fields are not filtered on. From is inclusive while To is exclusive.
+type ArchiveFilter struct {
+	TeamID   string
+	PlayerID string
+	From     time.Time
+	To       time.Time
+}
+
+// Matches determines if an archived game satisfies the filter
+func (f ArchiveFilter) Matches(archived *ArchivedGame) bool {
+	if f.TeamID != "" && f.TeamID != archived.TeamID {
+		return false
+	}
+	if f.PlayerID != "" && f.PlayerID != archived.WhiteID && f.PlayerID != archived.BlackID {
+		return false
+	}
+	if !f.From.IsZero() && archived.EndedAt.Before(f.From) {
+		return false
+	}
+	if !f.To.IsZero() && !archived.EndedAt.Before(f.To) {
+		return false
+	}
+	return true
+}
+
+// Archive creates the permanent record of a completed game played in a team (workspace) that ended just now.
+// The result, termination method and end date are recorded as PGN tags of the game.
+func (g *Game) Archive(teamID string) (*ArchivedGame, error) {
+	return g.ArchiveAt(teamID, g.timeProvider())
+}
+
+// ArchiveAt creates the permanent record of a completed game that ended at a given time.
+// Games that ended without a known termination method (such as imported games) keep their existing Termination tag.
+func (g *Game) ArchiveAt(teamID string, endedAt time.Time) (*ArchivedGame, error) {
+	return g.archive(teamID, endedAt, "Unknown")
+}
+
+// archive creates the record of a completed game, falling back to a termination method when the game has none.
+// The tags of the record are only set on its PGN, not on the game.
+func (g *Game) archive(teamID string, endedAt time.Time, fallbackMethod string) (*ArchivedGame, error) {
+	outcome := g.Outcome()
+	if outcome == chess.NoOutcome || outcome == "" {
+		return nil, ErrGameInProgress
+	}
+	method := g.game.Method().String()
+	if g.Adjudicated() {
+		method = "adjudication"
+	} else if g.game.Method() == chess.NoMethod {
+		method = fallbackMethod
+		if termination := g.game.GetTagPair("Termination"); termination != nil {
+			method = termination.Value
+		}
+	}
+	return &ArchivedGame{
+		GameID:  g.ID,
+		TeamID:  teamID,
+		WhiteID: g.Players[White].ID,
+		BlackID: g.Players[Black].ID,
+		Result:  outcome,
+		Method:  method,
+		EndedAt: endedAt,
+		PGN: g.export(
+			chess.TagPair{Key: "Site", Value: "Slack ChessBot match"},
+			chess.TagPair{Key: "Date", Value: endedAt.Format("2006.01.02")},
+			chess.TagPair{Key: "White", Value: g.Players[White].ID},
+			chess.TagPair{Key: "Black", Value: g.Players[Black].ID},
+			chess.TagPair{Key: "Result", Value: outcome.String()},
+			chess.TagPair{Key: "Termination", Value: method},
+		),
+	}, nil
+}
+
+// UpdateArchive replaces the archived record of a completed game with its current state, such as after its moves were annotated.
+// The record keeps the time and way the game ended, while a game that wasn't archived yet is archived as ending now.
+func UpdateArchive(store ArchiveStorage, teamID string, gm *Game) error {
+	endedAt, method := gm.timeProvider(), "Unknown"
+	if previous, err := store.RetrieveArchivedGame(gm.ID); err == nil {
+		endedAt, method = previous.EndedAt, previous.Method
+	}
+	archived, err := gm.archive(teamID, endedAt, method)
+	if err != nil {
+		return err
+	}
+	return store.StoreArchivedGame(archived)
+}
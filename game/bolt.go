@@ -0,0 +1,275 @@
+package game
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	gameBucket      = []byte("games")
+	challengeBucket = []byte("challenges")
+	takebackBucket  = []byte("takebacks")
+	archiveBucket   = []byte("archived_games")
+	aliasBucket     = []byte("player_aliases")
+)
+
+// boltGame is the persisted representation of a game
+type boltGame struct {
+	WhiteID   string
+	BlackID   string
+	LastMoved time.Time
+	PGN       string
+	// Annotations of the moves, keyed by ply
+	Annotations map[int]MoveAnnotation `json:",omitempty"`
+	// Adjudication is the players' pending agreement to adjudicate the game
+	Adjudication *Adjudication `json:",omitempty"`
+}
+
+// BoltStore is an implementation of all game storage interfaces that persists using an embedded bbolt database.
+// It is pure Go, so it is suitable for builds without CGO.
+type BoltStore struct {
+	db *bolt.DB
+}
+
+// NewBoltStore creates (if not exists) the buckets used for games within an open bbolt database
+// It implements the GameStorage, ChallengeStorage, TakebackStorage, ArchiveStorage and PlayerAliasStorage
+// interfaces and is intended as a suitable perminent storage of games
+func NewBoltStore(db *bolt.DB) (*BoltStore, error) {
+	err := db.Update(func(tx *bolt.Tx) error {
+		for _, bucket := range [][]byte{gameBucket, challengeBucket, takebackBucket, archiveBucket, aliasBucket} {
+			if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &BoltStore{db: db}, nil
+}
+
+// compositeKey joins key parts with a separator that can't appear in Slack IDs or player names
+func compositeKey(parts ...string) []byte {
+	key := []byte{}
+	for i, part := range parts {
+		if i > 0 {
+			key = append(key, 0)
+		}
+		key = append(key, part...)
+	}
+	return key
+}
+
+func (b *BoltStore) get(bucket []byte, key []byte, value interface{}) (bool, error) {
+	found := false
+	err := b.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(bucket).Get(key)
+		if data == nil {
+			return nil
+		}
+		found = true
+		return json.Unmarshal(data, value)
+	})
+	return found, err
+}
+
+func (b *BoltStore) put(bucket []byte, key []byte, value interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return b.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucket).Put(key, data)
+	})
+}
+
+func (b *BoltStore) delete(bucket []byte, key []byte) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucket).Delete(key)
+	})
+}
+
+// StoreGame stores a game by ID.
+// If a game is already established, only the PGN log is updated
+func (b *BoltStore) StoreGame(ID string, gm *Game) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(gameBucket)
+		record := boltGame{
+			WhiteID: gm.Players[White].ID,
+			BlackID: gm.Players[Black].ID,
+		}
+		if data := bucket.Get([]byte(ID)); data != nil {
+			if err := json.Unmarshal(data, &record); err != nil {
+				return err
+			}
+		}
+		record.LastMoved = gm.LastMoved()
+		record.PGN = gm.PGN()
+		record.Annotations = gm.annotations
+		record.Adjudication = gm.adjudication
+		data, err := json.Marshal(record)
+		if err != nil {
+			return err
+		}
+		return bucket.Put([]byte(ID), data)
+	})
+}
+
+// RetrieveGame retrieves a game by ID
+func (b *BoltStore) RetrieveGame(ID string) (*Game, error) {
+	var record boltGame
+	found, err := b.get(gameBucket, []byte(ID), &record)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("Game by %v not found", ID)
+	}
+	gm, err := NewGameFromPGN(ID, record.PGN, Player{
+		ID: record.WhiteID,
+	}, Player{
+		ID: record.BlackID,
+	})
+	if err == nil {
+		gm.lastMoved = record.LastMoved
+		gm.annotations = record.Annotations
+		gm.adjudication = record.Adjudication
+	}
+	return gm, err
+}
+
+// StoreChallenge only supports inserting new challenges. Challenges should not be updated only inserted/removed
+func (b *BoltStore) StoreChallenge(challenge *Challenge) error {
+	data, err := json.Marshal(challenge)
+	if err != nil {
+		return err
+	}
+	key := compositeKey(challenge.ChallengerID, challenge.ChallengedID)
+	return b.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(challengeBucket)
+		if bucket.Get(key) != nil {
+			return fmt.Errorf("Challenge %v%v already exists", challenge.ChallengerID, challenge.ChallengedID)
+		}
+		return bucket.Put(key, data)
+	})
+}
+
+// RetrieveChallenge retrives a challenge by the challenger and challenged ID
+func (b *BoltStore) RetrieveChallenge(challengerID string, challengedID string) (*Challenge, error) {
+	var challenge Challenge
+	found, err := b.get(challengeBucket, compositeKey(challengerID, challengedID), &challenge)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("Challenge %v%v not found", challengerID, challengedID)
+	}
+	return &challenge, nil
+}
+
+// RemoveChallenge removes a challenge from the DB
+func (b *BoltStore) RemoveChallenge(challengerID string, challengedID string) error {
+	return b.delete(challengeBucket, compositeKey(challengerID, challengedID))
+}
+
+// StoreTakeback stores a takeback request
+// Note: This will overwrite a takeback request if a previous request is left open
+func (b *BoltStore) StoreTakeback(takeback *Takeback) error {
+	return b.put(takebackBucket, []byte(takeback.CurrentGame.ID), takeback.FENSnapshot)
+}
+
+// RetrieveTakeback finds a takeback request by a game ID
+func (b *BoltStore) RetrieveTakeback(gameID string) (*Takeback, error) {
+	gm, err := b.RetrieveGame(gameID)
+	if err != nil {
+		return nil, err
+	}
+	takeback := Takeback{
+		CurrentGame: gm,
+	}
+	found, err := b.get(takebackBucket, []byte(gameID), &takeback.FENSnapshot)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("Takeback not found for provided game ID")
+	}
+	return &takeback, nil
+}
+
+// RemoveTakeback removes a takeback request from storage
+func (b *BoltStore) RemoveTakeback(takeback *Takeback) error {
+	return b.delete(takebackBucket, []byte(takeback.CurrentGame.ID))
+}
+
+// StoreArchivedGame stores the record of a completed game
+// Note: This will overwrite a previous record of the same game
+func (b *BoltStore) StoreArchivedGame(archived *ArchivedGame) error {
+	return b.put(archiveBucket, []byte(archived.GameID), archived)
+}
+
+// RetrieveArchivedGame retrieves the record of a completed game by its ID
+func (b *BoltStore) RetrieveArchivedGame(ID string) (*ArchivedGame, error) {
+	var archived ArchivedGame
+	found, err := b.get(archiveBucket, []byte(ID), &archived)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("Archived game by %v not found", ID)
+	}
+	return &archived, nil
+}
+
+// RetrieveArchivedGames finds all completed games matching the filter in the order they ended
+func (b *BoltStore) RetrieveArchivedGames(filter ArchiveFilter, each func(*ArchivedGame) error) error {
+	matched := []*ArchivedGame{}
+	err := b.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(archiveBucket).ForEach(func(_, data []byte) error {
+			var archived ArchivedGame
+			if err := json.Unmarshal(data, &archived); err != nil {
+				return err
+			}
+			if filter.Matches(&archived) {
+				matched = append(matched, &archived)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return err
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		return matched[i].EndedAt.Before(matched[j].EndedAt)
+	})
+	for _, archived := range matched {
+		if err := each(archived); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StorePlayerAlias maps a player name to a Slack user ID within a team
+// Note: This will overwrite a previous mapping of the same name
+func (b *BoltStore) StorePlayerAlias(teamID string, name string, playerID string) error {
+	return b.put(aliasBucket, compositeKey(teamID, name), playerID)
+}
+
+// RetrievePlayerAlias finds the Slack user ID a player name is mapped to within a team
+func (b *BoltStore) RetrievePlayerAlias(teamID string, name string) (string, error) {
+	var playerID string
+	found, err := b.get(aliasBucket, compositeKey(teamID, name), &playerID)
+	if err != nil {
+		return "", err
+	}
+	if !found {
+		return "", fmt.Errorf("Player alias %v not found", name)
+	}
+	return playerID, nil
+}
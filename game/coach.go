@@ -0,0 +1,68 @@
+package game
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Coached games are tagged in their PGN, along with the plies the players were given hints for
+const (
+	coachTag = "Coach"
+	hintsTag = "Hints"
+)
+
+// SetCoached enables hints for the players of the game.
+// Coached games are unrated, as players can be helped by the engine.
+func (g *Game) SetCoached() {
+	g.game.AddTagPair(coachTag, "Yes")
+	g.game.AddTagPair("Rated", "No")
+}
+
+// Coached determines if the players of the game may ask for hints
+func (g *Game) Coached() bool {
+	coach := g.game.GetTagPair(coachTag)
+	return coach != nil && coach.Value == "Yes"
+}
+
+// Rated determines if the game counts towards ratings, which coached games do not
+func (g *Game) Rated() bool {
+	return !g.Coached()
+}
+
+// RecordHint records that the player to move was given a hint, and returns how many hints they had for their move
+func (g *Game) RecordHint() int {
+	hints := append(g.Hints(), len(g.game.Moves())+1)
+	recorded := make([]string, len(hints))
+	for i, hint := range hints {
+		recorded[i] = strconv.Itoa(hint)
+	}
+	g.game.AddTagPair(hintsTag, strings.Join(recorded, " "))
+	return g.HintsForMove()
+}
+
+// HintsForMove counts the hints the player to move was given for their move
+func (g *Game) HintsForMove() int {
+	ply := len(g.game.Moves()) + 1
+	given := 0
+	for _, hint := range g.Hints() {
+		if hint == ply {
+			given++
+		}
+	}
+	return given
+}
+
+// Hints lists the plies (starting at 1) players were given a hint for, once per hint
+func (g *Game) Hints() []int {
+	hints := []int{}
+	tag := g.game.GetTagPair(hintsTag)
+	if tag == nil {
+		return hints
+	}
+	for _, field := range strings.Fields(tag.Value) {
+		if ply, err := strconv.Atoi(field); err == nil {
+			hints = append(hints, ply)
+		}
+	}
+	return hints
+}
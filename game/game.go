@@ -17,6 +17,8 @@ type Challenge struct {
 	ChallengedID string
 	GameID       string
 	ChannelID    string
+	// Coach enables hints for the players of the game
+	Coach bool
 }
 
 // Color represents the game color (white/black)
@@ -58,6 +60,16 @@ type Game struct {
 	lastMoved    time.Time
 	checkedTile  *chess.Square
 	timeProvider TimeProvider
+	// annotations are the players' comments and NAGs keyed by ply (starting at 1)
+	annotations map[int]MoveAnnotation
+	// adjudication is the players' agreement to end the game by the tablebase verdict of its current position
+	adjudication *Adjudication
+}
+
+// Adjudication records the players who agreed to end a game by the verdict of a position
+type Adjudication struct {
+	FEN       string
+	PlayerIDs []string
 }
 
 // NewGame will create a new game with typical starting positions
@@ -105,7 +117,7 @@ func NewGameFromFEN(ID string, fen string, players ...Player) (*Game, error) {
 
 // NewGameFromPGN will create a new game at the state expressed by a provided PGN
 func NewGameFromPGN(ID string, pgn string, white Player, black Player) (*Game, error) {
-	reader := strings.NewReader(pgn)
+	reader := strings.NewReader(StripNAGs(pgn))
 	gameState, err := chess.PGN(reader)
 	if err != nil {
 		return &Game{}, err
@@ -116,12 +128,17 @@ func NewGameFromPGN(ID string, pgn string, white Player, black Player) (*Game, e
 		lastMoved:    time.Time{},
 		timeProvider: defaultTimeProvider,
 	}
-	game.Players = make(map[Color]Player)
+	game.SetPlayers(white, black)
+	return game, nil
+}
+
+// SetPlayers assigns the players of each color, replacing the (randomly) assigned ones
+func (g *Game) SetPlayers(white Player, black Player) {
+	g.Players = make(map[Color]Player)
 	white.color = White
-	game.Players[White] = white
+	g.Players[White] = white
 	black.color = Black
-	game.Players[Black] = black
-	return game, nil
+	g.Players[Black] = black
 }
 
 // PlayerByID returns a reference to a player given their ID
@@ -139,6 +156,48 @@ func (g *Game) Resign(resigner Player) {
 	g.game.Resign(colorMap[resigner.color])
 }
 
+// Adjudicate ends the game with an outcome agreed by both players, such as a position known to be won
+func (g *Game) Adjudicate(outcome chess.Outcome) {
+	switch outcome {
+	case chess.WhiteWon:
+		g.game.Resign(chess.Black)
+	case chess.BlackWon:
+		g.game.Resign(chess.White)
+	case chess.Draw:
+		g.game.Draw(chess.DrawOffer)
+	default:
+		return
+	}
+	g.adjudication = nil
+	g.game.AddTagPair("Termination", "adjudication")
+}
+
+// AgreeToAdjudicate records a player's agreement to adjudicate the game in its current position, returning how many players agreed to it.
+// Agreements made in an earlier position of the game no longer count.
+func (g *Game) AgreeToAdjudicate(playerID string) int {
+	if g.adjudication == nil || g.adjudication.FEN != g.FEN() {
+		g.adjudication = &Adjudication{FEN: g.FEN()}
+	}
+	for _, agreed := range g.adjudication.PlayerIDs {
+		if agreed == playerID {
+			return len(g.adjudication.PlayerIDs)
+		}
+	}
+	g.adjudication.PlayerIDs = append(g.adjudication.PlayerIDs, playerID)
+	return len(g.adjudication.PlayerIDs)
+}
+
+// Adjudicated determines if the game was ended by adjudication
+func (g *Game) Adjudicated() bool {
+	termination := g.game.GetTagPair("Termination")
+	return termination != nil && termination.Value == "adjudication"
+}
+
+// Annotate tags the game with extra information that is kept with it and exported, such as an evaluation of its final position
+func (g *Game) Annotate(tag string, value string) {
+	g.game.AddTagPair(tag, value)
+}
+
 // TurnPlayer returns which player should move next
 func (g *Game) TurnPlayer() Player {
 	return g.Players[g.Turn()]
@@ -178,14 +237,38 @@ func (g *Game) PGN() string {
 
 // Export a game in PGN format
 func (g *Game) Export() string {
-	regularNotation := chess.UseNotation(chess.AlgebraicNotation{})
-	longNotation := chess.UseNotation(chess.LongAlgebraicNotation{})
-	defer longNotation(g.game)
-	g.game.AddTagPair("Site", "Slack ChessBot match")
-	g.game.AddTagPair("White", g.Players[White].ID)
-	g.game.AddTagPair("Black", g.Players[Black].ID)
-	regularNotation(g.game)
-	return g.game.String()
+	return g.export(
+		chess.TagPair{Key: "Site", Value: "Slack ChessBot match"},
+		chess.TagPair{Key: "White", Value: g.Players[White].ID},
+		chess.TagPair{Key: "Black", Value: g.Players[Black].ID},
+	)
+}
+
+// export formats the game in PGN with extra tags, which are only set on the exported copy and not the game itself
+func (g *Game) export(tags ...chess.TagPair) string {
+	exported := g.game.Clone()
+	// the clone shares its tag pairs with the game, so they are copied before being changed
+	copied := []*chess.TagPair{}
+	for _, tag := range exported.TagPairs() {
+		copy := *tag
+		copied = append(copied, &copy)
+	}
+	chess.TagPairs(copied)(exported)
+	for _, tag := range tags {
+		exported.AddTagPair(tag.Key, tag.Value)
+	}
+	// The opening changes as moves are played, so it is only tagged on the export (unless the game was imported with it)
+	if opening, ok := g.Opening(); ok && exported.GetTagPair("ECO") == nil {
+		exported.AddTagPair("ECO", opening.ECO)
+		exported.AddTagPair("Opening", opening.Name)
+	}
+	chess.UseNotation(chess.AlgebraicNotation{})(exported)
+	pgn := exported.String()
+	separator := strings.LastIndex(pgn, "\n\n")
+	if separator < 0 {
+		return pgn
+	}
+	return pgn[:separator+2] + g.annotateMovetext(pgn[separator+2:])
 }
 
 // Outcome determines the outcome of the game (or no outcome)
@@ -196,8 +279,12 @@ func (g *Game) Outcome() chess.Outcome {
 // ResultText will show the outcome of the game in textual format
 func (g *Game) ResultText() string {
 	outcome := g.Outcome()
+	method := g.game.Method().String()
+	if g.Adjudicated() {
+		method = "Adjudication"
+	}
 	if outcome == chess.Draw {
-		return fmt.Sprintf("Game completed. %s by %s.", g.Outcome(), g.game.Method())
+		return fmt.Sprintf("Game completed. %s by %s.", g.Outcome(), method)
 	}
 	var winningPlayer Player
 	if outcome == chess.WhiteWon {
@@ -205,7 +292,7 @@ func (g *Game) ResultText() string {
 	} else {
 		winningPlayer = g.Players[Black]
 	}
-	return fmt.Sprintf("Congratulations, <@%v>! %s by %s", winningPlayer.ID, g.Outcome(), g.game.Method())
+	return fmt.Sprintf("Congratulations, <@%v>! %s by %s", winningPlayer.ID, g.Outcome(), method)
 }
 
 // LastMove returns the last move done of the game
@@ -296,13 +383,19 @@ func (g *Game) Takeback(requestingPlayer *Player) (*chess.Move, error) {
 	if requestingPlayer.ID == turnPlayer.ID {
 		return nil, ErrPlayerAlreadyMoved
 	}
-	newGame := chess.NewGame(chess.UseNotation(chess.LongAlgebraicNotation{}))
+	// Replay from the starting position of the game, keeping its tags (such as coaching and hints)
+	start, err := chess.FEN(g.StartingFEN())
+	if err != nil {
+		return nil, err
+	}
+	newGame := chess.NewGame(start, chess.TagPairs(g.game.TagPairs()), chess.UseNotation(chess.LongAlgebraicNotation{}))
 	moves := g.game.Moves()
 	withoutLast := moves[:len(moves)-1]
 	for _, move := range withoutLast {
 		newGame.Move(move)
 	}
 	g.game = newGame
+	delete(g.annotations, len(moves))
 	// Prevent cascading takebacks
 	g.lastMoved = g.timeProvider().Add(-TakebackThreshold - time.Second)
 	return g.LastMove(), nil
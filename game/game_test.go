@@ -1,11 +1,14 @@
 package game_test
 
 import (
+	"fmt"
 	"math/rand"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/cjsaylor/chessbot/game"
+	"github.com/notnil/chess"
 )
 
 func init() {
@@ -24,7 +27,7 @@ func TestExport(t *testing.T) {
 	gm.Move("d2d4")
 	gm.Move("d7d5")
 	result := gm.Export()
-	expected := "[Site \"Slack ChessBot match\"]\n[White \"a\"]\n[Black \"b\"]\n\n1.d4 d5  *"
+	expected := "[Site \"Slack ChessBot match\"]\n[White \"a\"]\n[Black \"b\"]\n[ECO \"D00\"]\n[Opening \"Queen's Pawn Game\"]\n\n1.d4 d5  *"
 	if result != expected {
 		t.Errorf("expected %v got %v", expected, result)
 	}
@@ -205,3 +208,320 @@ func TestTakebackSnapshotValidation(t *testing.T) {
 	}
 
 }
+
+func TestArchiveGameInProgress(t *testing.T) {
+	gm := game.NewGame("1234", []game.Player{
+		{
+			ID: "a",
+		},
+		{
+			ID: "b",
+		},
+	}...)
+	gm.Move("d2d4")
+	if _, err := gm.Archive("team"); err != game.ErrGameInProgress {
+		t.Errorf("expected %v, got %v", game.ErrGameInProgress, err)
+	}
+}
+
+func TestArchiveCompletedGame(t *testing.T) {
+	gm := game.NewGame("1234", []game.Player{
+		{
+			ID: "a",
+		},
+		{
+			ID: "b",
+		},
+	}...)
+	endedAt := time.Date(2019, time.March, 1, 12, 0, 0, 0, time.UTC)
+	gm.SetTimeProvider(func() time.Time {
+		return endedAt
+	})
+	gm.Move("d2d4")
+	gm.Resign(gm.Players[game.Black])
+	archived, err := gm.Archive("team")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if archived.Result != "1-0" || archived.Method != "Resignation" || !archived.EndedAt.Equal(endedAt) {
+		t.Errorf("unexpected archived game record %v", archived)
+	}
+	expected := fmt.Sprintf(
+		"[Site \"Slack ChessBot match\"]\n[Date \"2019.03.01\"]\n[White \"%v\"]\n[Black \"%v\"]\n[Result \"1-0\"]\n[Termination \"Resignation\"]\n[ECO \"A40\"]\n[Opening \"Queen's Pawn Game\"]\n\n1.d4 1-0",
+		gm.Players[game.White].ID,
+		gm.Players[game.Black].ID,
+	)
+	if archived.PGN != expected {
+		t.Errorf("expected %v got %v", expected, archived.PGN)
+	}
+}
+
+func TestArchiveOnlyTagsTheRecord(t *testing.T) {
+	gm := game.NewGame("1234", game.Player{ID: "a"}, game.Player{ID: "b"})
+	gm.Move("d2d4")
+	gm.Resign(gm.Players[game.Black])
+	archived, err := gm.Archive("team")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tag := range []string{"[Site ", "[Date ", "[White ", "[Termination "} {
+		if !strings.Contains(archived.PGN, tag) {
+			t.Errorf("expected the record to be tagged with %v, got %v", tag, archived.PGN)
+		}
+		if strings.Contains(gm.PGN(), tag) {
+			t.Errorf("expected the game not to be tagged with %v, got %v", tag, gm.PGN())
+		}
+	}
+}
+
+func TestUpdateArchiveKeepsHowTheGameEnded(t *testing.T) {
+	store := game.NewMemoryStore()
+	gm := game.NewGame("1234", game.Player{ID: "a"}, game.Player{ID: "b"})
+	gm.Move("d2d4")
+	gm.Resign(gm.Players[game.Black])
+	endedAt := time.Date(2019, time.March, 1, 12, 0, 0, 0, time.UTC)
+	archived, _ := gm.ArchiveAt("team", endedAt)
+	store.StoreArchivedGame(archived)
+	// a game restored from its PGN no longer knows it ended by resignation
+	restored, err := game.NewGameFromPGN("1234", gm.PGN(), gm.Players[game.White], gm.Players[game.Black])
+	if err != nil {
+		t.Fatal(err)
+	}
+	restored.Annotate("Tablebase", "Win")
+	if err := game.UpdateArchive(store, "team", restored); err != nil {
+		t.Fatal(err)
+	}
+	updated, err := store.RetrieveArchivedGame("1234")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Method != archived.Method || !updated.EndedAt.Equal(endedAt) || !strings.Contains(updated.PGN, `[Tablebase "Win"]`) {
+		t.Errorf("expected the updated record to keep how and when the game ended, got %v", updated)
+	}
+}
+
+func TestArchiveCoachedGame(t *testing.T) {
+	gm := game.NewGame("1234", game.Player{ID: "a"}, game.Player{ID: "b"})
+	gm.Move("d2d4")
+	gm.Resign(gm.Players[game.Black])
+	if archived, _ := gm.Archive("team"); !archived.Rated() {
+		t.Error("expected archived games to be rated by default")
+	}
+	coached := game.NewGame("5678", game.Player{ID: "a"}, game.Player{ID: "b"})
+	coached.SetCoached()
+	coached.Move("d2d4")
+	coached.Resign(coached.Players[game.Black])
+	archived, err := coached.Archive("team")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if archived.Rated() {
+		t.Errorf("expected the archived coached game to be unrated, got %v", archived.PGN)
+	}
+}
+
+func TestPlies(t *testing.T) {
+	gm := game.NewGame("1", game.Player{ID: "a"}, game.Player{ID: "b"})
+	for _, move := range []string{"f2f3", "e7e5", "g2g4", "d8h4"} {
+		gm.Move(move)
+	}
+	plies := gm.Plies()
+	if len(plies) != 4 {
+		t.Fatalf("expected 4 plies, got %v", len(plies))
+	}
+	if plies[0].FEN != "rnbqkbnr/pppppppp/8/8/8/5P2/PPPPP1PP/RNBQKBNR b KQkq - 0 1" {
+		t.Errorf("unexpected FEN after the first ply: %v", plies[0].FEN)
+	}
+	if plies[2].CheckedKing != chess.NoSquare {
+		t.Errorf("expected no check after the third ply, got %v", plies[2].CheckedKing)
+	}
+	if plies[3].Number != 4 || plies[3].CheckedKing != chess.E1 {
+		t.Errorf("expected the fourth ply to check e1, got %v", plies[3].CheckedKing)
+	}
+}
+
+func TestAdjudicate(t *testing.T) {
+	gm := game.NewGame("1234", game.Player{ID: "a"}, game.Player{ID: "b"})
+	gm.Move("e2e4")
+	gm.Move("e7e5")
+	gm.Adjudicate(chess.WhiteWon)
+	if gm.Outcome() != chess.WhiteWon {
+		t.Errorf("expected White to win, got %v", gm.Outcome())
+	}
+	expected := fmt.Sprintf("Congratulations, <@%v>! 1-0 by Adjudication", gm.Players[game.White].ID)
+	if gm.ResultText() != expected {
+		t.Errorf("expected %v got %v", expected, gm.ResultText())
+	}
+	if !strings.Contains(gm.Export(), "[Termination \"adjudication\"]") {
+		t.Errorf("expected the export to be tagged with the termination, got %v", gm.Export())
+	}
+	archived, err := gm.Archive("team")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if archived.Method != "adjudication" || !strings.Contains(archived.PGN, "[Termination \"adjudication\"]") {
+		t.Errorf("expected the archive to keep the adjudication, got %v", archived.Method)
+	}
+	restored, err := game.NewGameFromPGN("1234", gm.PGN(), gm.Players[game.White], gm.Players[game.Black])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !restored.Adjudicated() || restored.Outcome() != chess.WhiteWon {
+		t.Errorf("expected the adjudication to be restored, got %v", restored.ResultText())
+	}
+}
+
+func TestAgreeToAdjudicate(t *testing.T) {
+	gm, err := game.NewGameFromFEN("1234", "8/8/8/8/8/2k5/8/KQ6 w - - 0 1", game.Player{ID: "a"}, game.Player{ID: "b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if agreed := gm.AgreeToAdjudicate("a"); agreed != 1 {
+		t.Errorf("expected 1 agreement, got %v", agreed)
+	}
+	if agreed := gm.AgreeToAdjudicate("a"); agreed != 1 {
+		t.Errorf("expected agreeing twice to count once, got %v", agreed)
+	}
+	if agreed := gm.AgreeToAdjudicate("b"); agreed != 2 {
+		t.Errorf("expected 2 agreements, got %v", agreed)
+	}
+	gm.Move("b1b2")
+	if agreed := gm.AgreeToAdjudicate("b"); agreed != 1 {
+		t.Errorf("expected agreements in an earlier position to be dropped, got %v", agreed)
+	}
+	gm.Adjudicate(chess.WhiteWon)
+	if agreed := gm.AgreeToAdjudicate("a"); agreed != 1 {
+		t.Errorf("expected agreements to be dropped once adjudicated, got %v", agreed)
+	}
+}
+
+func TestCoachedHints(t *testing.T) {
+	gm := game.NewGame("1234", game.Player{ID: "a"}, game.Player{ID: "b"})
+	if gm.Coached() || !gm.Rated() {
+		t.Error("expected games to be rated and without hints by default")
+	}
+	gm.SetCoached()
+	if !gm.Coached() || gm.Rated() {
+		t.Error("expected coached games to be unrated")
+	}
+	if given := gm.RecordHint(); given != 1 {
+		t.Errorf("expected the first hint for the move, got %v", given)
+	}
+	if given := gm.RecordHint(); given != 2 {
+		t.Errorf("expected the second hint for the move, got %v", given)
+	}
+	gm.Move("e2e4")
+	if given := gm.HintsForMove(); given != 0 {
+		t.Errorf("expected no hints for Black's move yet, got %v", given)
+	}
+	gm.RecordHint()
+	exported := gm.Export()
+	for _, tag := range []string{"[Coach \"Yes\"]", "[Rated \"No\"]", "[Hints \"1 1 2\"]"} {
+		if !strings.Contains(exported, tag) {
+			t.Errorf("expected the export to contain %v, got %v", tag, exported)
+		}
+	}
+	restored, err := game.NewGameFromPGN("1234", gm.PGN(), gm.Players[game.White], gm.Players[game.Black])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !restored.Coached() || len(restored.Hints()) != 3 || restored.HintsForMove() != 1 {
+		t.Errorf("expected the coaching to be restored, got hints %v", restored.Hints())
+	}
+}
+
+func TestAnnotateMove(t *testing.T) {
+	gm := game.NewGame("1234", game.Player{ID: "a"}, game.Player{ID: "b"})
+	if err := gm.AnnotateMove(1, game.MoveAnnotation{NAG: 1}); err != game.ErrNoSuchPly {
+		t.Errorf("expected %v annotating a game without moves, got %v", game.ErrNoSuchPly, err)
+	}
+	for _, move := range []string{"e2e4", "e7e5", "g1f3", "b8c6"} {
+		gm.Move(move)
+	}
+	gm.AnnotateMove(2, game.MoveAnnotation{NAG: 6})
+	gm.AnnotateMove(2, game.MoveAnnotation{Comment: "missed {d5} here"})
+	gm.AnnotateMove(3, game.MoveAnnotation{NAG: 14, Comment: "developing"})
+	annotation := gm.Plies()[1].Annotation
+	if annotation.NAG != 6 || annotation.Comment != "missed {d5} here" || annotation.Glyph() != "?!" {
+		t.Errorf("expected the NAG and comment to be kept together, got %v", annotation)
+	}
+	if glyph := gm.Plies()[2].Annotation.Glyph(); glyph != "" {
+		t.Errorf("expected no glyph for a positional NAG, got %v", glyph)
+	}
+	expected := "1.e4 e5 $6 {missed (d5) here} 2.Nf3 $14 {developing} 2...Nc6 *"
+	if result := gm.Export(); !strings.HasSuffix(result, "\n\n"+expected) {
+		t.Errorf("expected movetext %v, got %v", expected, result)
+	}
+	if _, err := game.NewGameFromPGN("5678", gm.Export(), game.Player{ID: "a"}, game.Player{ID: "b"}); err != nil {
+		t.Errorf("expected an annotated export to be parsed, got %v", err)
+	}
+	gm.AnnotateMove(4, game.MoveAnnotation{NAG: 2})
+	if _, err := gm.Takeback(&game.Player{ID: gm.Players[game.Black].ID}); err != nil {
+		t.Fatal(err)
+	}
+	gm.Move("g8f6")
+	if annotations := gm.MoveAnnotations(); len(annotations) != 2 {
+		t.Errorf("expected the annotation of the taken back move to be removed, got %v", annotations)
+	}
+}
+
+func TestParseNAG(t *testing.T) {
+	cases := map[string]int{"!!": 3, "?!": 6, "$14": 14, "$0": 0}
+	for text, expected := range cases {
+		if nag, ok := game.ParseNAG(text); !ok || nag != expected {
+			t.Errorf("expected %v to parse as NAG %v, got %v", text, expected, nag)
+		}
+	}
+	for _, text := range []string{"", "!!!", "$", "$256", "14"} {
+		if _, ok := game.ParseNAG(text); ok {
+			t.Errorf("expected %v not to parse as a NAG", text)
+		}
+	}
+}
+
+func TestTakebackKeepsTagsAndStartingPosition(t *testing.T) {
+	gm := game.NewGame("1234", game.Player{ID: "a"}, game.Player{ID: "b"})
+	gm.SetCoached()
+	gm.Move("e2e4")
+	gm.RecordHint()
+	gm.Move("e7e5")
+	if _, err := gm.Takeback(&game.Player{ID: gm.Players[game.Black].ID}); err != nil {
+		t.Fatal(err)
+	}
+	if !gm.Coached() || gm.Rated() {
+		t.Error("expected the game to remain coached and unrated after a takeback")
+	}
+	if hints := gm.Hints(); len(hints) != 1 || hints[0] != 2 {
+		t.Errorf("expected the hint for ply 2 to be kept, got %v", hints)
+	}
+
+	fen := "4k3/8/8/8/8/8/4P3/4K3 w - - 0 1"
+	fromFEN, err := game.NewGameFromFEN("5678", fen, game.Player{ID: "a"}, game.Player{ID: "b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fromFEN.Move("e2e4")
+	fromFEN.Move("e8d7")
+	if _, err := fromFEN.Takeback(&game.Player{ID: fromFEN.Players[game.Black].ID}); err != nil {
+		t.Fatal(err)
+	}
+	if fromFEN.StartingFEN() != fen {
+		t.Errorf("expected the starting position %v to be kept, got %v", fen, fromFEN.StartingFEN())
+	}
+	if expected := "4k3/8/8/8/4P3/8/8/4K3 b - e3 0 1"; fromFEN.FEN() != expected {
+		t.Errorf("expected position %v after the takeback, got %v", expected, fromFEN.FEN())
+	}
+}
+
+func TestDecodeMove(t *testing.T) {
+	position := chess.NewGame().Position()
+	for _, text := range []string{"Nf3", "g1f3", "G1F3", " Nf3!? "} {
+		move, err := game.DecodeMove(position, text)
+		if err != nil || move.S1() != chess.G1 || move.S2() != chess.F3 {
+			t.Errorf("expected %q to decode to g1f3, got %v (%v)", text, move, err)
+		}
+	}
+	if _, err := game.DecodeMove(position, "Nf4"); err != game.ErrIllegalMove {
+		t.Errorf("expected %v, got %v", game.ErrIllegalMove, err)
+	}
+}
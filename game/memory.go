@@ -2,14 +2,19 @@ package game
 
 import (
 	"fmt"
+	"sort"
+	"sync"
 )
 
 // MemoryStore implements the Game and Challenge storage interfaces and holds all state in memory
 // Once the MemoryStore instance is released, all data in that storage is lost
 type MemoryStore struct {
+	mu         sync.RWMutex
 	games      map[string]*Game
 	challenges map[string]*Challenge
 	takebacks  map[string]*Takeback
+	archive    map[string]*ArchivedGame
+	aliases    map[string]string
 }
 
 // NewMemoryStore returns a MemoryStore pointer
@@ -17,12 +22,17 @@ func NewMemoryStore() *MemoryStore {
 	store := MemoryStore{
 		games:      make(map[string]*Game, 10),
 		challenges: make(map[string]*Challenge, 10),
+		takebacks:  make(map[string]*Takeback, 10),
+		archive:    make(map[string]*ArchivedGame, 10),
+		aliases:    make(map[string]string, 10),
 	}
 	return &store
 }
 
 // RetrieveGame will get a game from storage by its ID
 func (m *MemoryStore) RetrieveGame(ID string) (*Game, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
 	gm, ok := m.games[ID]
 	if !ok {
 		return nil, fmt.Errorf("Game by %v not found", ID)
@@ -32,12 +42,16 @@ func (m *MemoryStore) RetrieveGame(ID string) (*Game, error) {
 
 // StoreGame persists a game into memory
 func (m *MemoryStore) StoreGame(ID string, game *Game) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	m.games[ID] = game
 	return nil
 }
 
 // RetrieveChallenge will get a challenge request by challenger ID and challenged ID
 func (m *MemoryStore) RetrieveChallenge(challengerID string, challengedID string) (*Challenge, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
 	challenge, ok := m.challenges[challengerID+challengedID]
 	if !ok {
 		return nil, fmt.Errorf("Challenge %v%v not found", challengerID, challengedID)
@@ -45,37 +59,121 @@ func (m *MemoryStore) RetrieveChallenge(challengerID string, challengedID string
 	return challenge, nil
 }
 
-// StoreChallenge will persist a challenge request
+// StoreChallenge will persist a challenge request.
+// Challenges should not be updated only inserted/removed
 func (m *MemoryStore) StoreChallenge(c *Challenge) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	key := c.ChallengerID + c.ChallengedID
+	if _, ok := m.challenges[key]; ok {
+		return fmt.Errorf("Challenge %v already exists", key)
+	}
 	m.challenges[key] = c
 	return nil
 }
 
 // RemoveChallenge deletes a challenge request
 func (m *MemoryStore) RemoveChallenge(challengerID string, challengedID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	key := challengerID + challengedID
 	delete(m.challenges, key)
 	return nil
 }
 
 // StoreTakeback stores a takeback request
+// Note: This will overwrite a takeback request if a previous request is left open
 func (m *MemoryStore) StoreTakeback(takeback *Takeback) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	key := takeback.CurrentGame.ID
 	m.takebacks[key] = takeback
 	return nil
 }
 
 // RetrieveTakeback finds a takeback request by a game ID
+// The takeback is attached to the currently stored state of the game.
 func (m *MemoryStore) RetrieveTakeback(gameID string) (*Takeback, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	gm, ok := m.games[gameID]
+	if !ok {
+		return nil, fmt.Errorf("Game by %v not found", gameID)
+	}
 	if takeback, ok := m.takebacks[gameID]; ok {
-		return takeback, nil
+		return &Takeback{
+			CurrentGame: gm,
+			FENSnapshot: takeback.FENSnapshot,
+		}, nil
 	}
 	return nil, fmt.Errorf("Takeback not found for provided game ID")
 }
 
 // RemoveTakeback removes a takeback request from storage
 func (m *MemoryStore) RemoveTakeback(takeback *Takeback) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	delete(m.takebacks, takeback.CurrentGame.ID)
 	return nil
 }
+
+// StoreArchivedGame stores the record of a completed game
+// Note: This will overwrite a previous record of the same game
+func (m *MemoryStore) StoreArchivedGame(archived *ArchivedGame) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.archive[archived.GameID] = archived
+	return nil
+}
+
+// RetrieveArchivedGame retrieves the record of a completed game by its ID
+func (m *MemoryStore) RetrieveArchivedGame(ID string) (*ArchivedGame, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	archived, ok := m.archive[ID]
+	if !ok {
+		return nil, fmt.Errorf("Archived game by %v not found", ID)
+	}
+	return archived, nil
+}
+
+// RetrieveArchivedGames finds all completed games matching the filter in the order they ended
+func (m *MemoryStore) RetrieveArchivedGames(filter ArchiveFilter, each func(*ArchivedGame) error) error {
+	m.mu.RLock()
+	matched := []*ArchivedGame{}
+	for _, archived := range m.archive {
+		if filter.Matches(archived) {
+			matched = append(matched, archived)
+		}
+	}
+	m.mu.RUnlock()
+	sort.Slice(matched, func(i, j int) bool {
+		return matched[i].EndedAt.Before(matched[j].EndedAt)
+	})
+	for _, archived := range matched {
+		if err := each(archived); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StorePlayerAlias maps a player name to a Slack user ID within a team
+// Note: This will overwrite a previous mapping of the same name
+func (m *MemoryStore) StorePlayerAlias(teamID string, name string, playerID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.aliases[teamID+"\x00"+name] = playerID
+	return nil
+}
+
+// RetrievePlayerAlias finds the Slack user ID a player name is mapped to within a team
+func (m *MemoryStore) RetrievePlayerAlias(teamID string, name string) (string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	playerID, ok := m.aliases[teamID+"\x00"+name]
+	if !ok {
+		return "", fmt.Errorf("Player alias %v not found", name)
+	}
+	return playerID, nil
+}
@@ -0,0 +1,27 @@
+package game
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/notnil/chess"
+)
+
+// ErrIllegalMove is returned when a move can't be played in a position
+var ErrIllegalMove = errors.New("that move isn't legal in this position")
+
+// DecodeMove reads a move in algebraic (Nf3) or long algebraic (g1f3) notation from a position
+func DecodeMove(position *chess.Position, text string) (*chess.Move, error) {
+	text = strings.TrimRight(strings.TrimSpace(text), "!?")
+	if move, err := (chess.AlgebraicNotation{}).Decode(position, text); err == nil {
+		return move, nil
+	}
+	if move, err := (chess.LongAlgebraicNotation{}).Decode(position, strings.ToLower(text)); err == nil {
+		for _, valid := range position.ValidMoves() {
+			if valid.S1() == move.S1() && valid.S2() == move.S2() && valid.Promo() == move.Promo() {
+				return valid, nil
+			}
+		}
+	}
+	return nil, ErrIllegalMove
+}
@@ -0,0 +1,8 @@
+package game
+
+import "github.com/cjsaylor/chessbot/openings"
+
+// Opening classifies the game by the most specific opening reached so far
+func (g *Game) Opening() (openings.Opening, bool) {
+	return openings.Classify(g.game.Positions())
+}
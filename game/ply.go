@@ -0,0 +1,51 @@
+package game
+
+import "github.com/notnil/chess"
+
+// Ply is the state of a game directly after a single move
+type Ply struct {
+	// Number of the ply within the game, starting at 1
+	Number int
+	FEN    string
+	Move   *chess.Move
+	// CheckedKing is the square of the king put in check by the move, or chess.NoSquare
+	CheckedKing chess.Square
+	// Annotation is the players' comment and NAG on the move, if any
+	Annotation MoveAnnotation
+}
+
+// Plies returns the state of the game after each move that was played, in order
+func (g *Game) Plies() []Ply {
+	positions := g.game.Positions()
+	moves := g.game.Moves()
+	plies := make([]Ply, 0, len(moves))
+	for i, move := range moves {
+		position := positions[i+1]
+		checked := chess.NoSquare
+		if move.HasTag(chess.Check) {
+			checked = kingSquare(position, position.Turn())
+		}
+		plies = append(plies, Ply{
+			Number:      i + 1,
+			FEN:         position.String(),
+			Move:        move,
+			CheckedKing: checked,
+			Annotation:  g.annotations[i+1],
+		})
+	}
+	return plies
+}
+
+func kingSquare(position *chess.Position, color chess.Color) chess.Square {
+	for square, piece := range position.Board().SquareMap() {
+		if piece.Type() == chess.King && piece.Color() == color {
+			return square
+		}
+	}
+	return chess.NoSquare
+}
+
+// StartingFEN is the position of the game before any move was played
+func (g *Game) StartingFEN() string {
+	return g.game.Positions()[0].String()
+}
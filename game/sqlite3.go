@@ -2,11 +2,14 @@ package game
 
 import (
 	"database/sql"
+	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	// import sqlite package for use with the sql interface
 	_ "github.com/mattn/go-sqlite3"
+	"github.com/notnil/chess"
 )
 
 const gameTabelCreation = `
@@ -15,7 +18,9 @@ const gameTabelCreation = `
 		player_white_id text,
 		player_black_id text,
 		last_moved datetime,
-		pgn text
+		pgn text,
+		annotations text NOT NULL DEFAULT '',
+		adjudication text NOT NULL DEFAULT ''
 	);
 `
 
@@ -25,6 +30,7 @@ const challengeTableCreation = `
 		challenged_id text NOT NULL,
 		channel_id text NOT NULL,
 		game_id text NOT NULL UNIQUE,
+		coach integer NOT NULL DEFAULT 0,
 		PRIMARY KEY (challenger_id, challenged_id)
 	);
 `
@@ -36,6 +42,29 @@ const takebackTableCreation = `
 	);
 `
 
+const archiveTableCreation = `
+	CREATE TABLE IF NOT EXISTS archived_games (
+		id text PRIMARY KEY,
+		team_id text NOT NULL,
+		player_white_id text NOT NULL,
+		player_black_id text NOT NULL,
+		result text NOT NULL,
+		method text NOT NULL,
+		ended_at datetime NOT NULL,
+		pgn text NOT NULL
+	);
+	CREATE INDEX IF NOT EXISTS archived_games_team_ended ON archived_games (team_id, ended_at);
+`
+
+const aliasTableCreation = `
+	CREATE TABLE IF NOT EXISTS player_aliases (
+		team_id text NOT NULL,
+		name text NOT NULL,
+		player_id text NOT NULL,
+		PRIMARY KEY (team_id, name)
+	);
+`
+
 // SqliteStore is an implementation of GameStorage and ChallengeStorage interfaces that persists using sqlite3
 type SqliteStore struct {
 	path string
@@ -53,45 +82,107 @@ func NewSqliteStore(path string) (*SqliteStore, error) {
 	if err != nil {
 		return nil, err
 	}
+	// sqlite only supports a single writer, serialize access to avoid locking errors
+	db.SetMaxOpenConns(1)
 	if _, err = db.Exec(gameTabelCreation); err != nil {
 		return nil, err
 	}
+	// Databases created before move annotations have a games table without the annotations column
+	if err = addColumn(db, "games", "annotations", "text NOT NULL DEFAULT ''"); err != nil {
+		return nil, err
+	}
+	// Databases created before tablebase adjudication have a games table without the adjudication column
+	if err = addColumn(db, "games", "adjudication", "text NOT NULL DEFAULT ''"); err != nil {
+		return nil, err
+	}
 	if _, err = db.Exec(challengeTableCreation); err != nil {
 		return nil, err
 	}
+	// Databases created before coached games have a challenges table without the coach column
+	if err = addColumn(db, "challenges", "coach", "integer NOT NULL DEFAULT 0"); err != nil {
+		return nil, err
+	}
 	if _, err = db.Exec(takebackTableCreation); err != nil {
 		return nil, err
 	}
+	if _, err = db.Exec(archiveTableCreation); err != nil {
+		return nil, err
+	}
+	if _, err = db.Exec(aliasTableCreation); err != nil {
+		return nil, err
+	}
 	store.db = db
 	return &store, nil
 }
 
+// addColumn adds a column to a table created before the column was introduced
+func addColumn(db *sql.DB, table string, column string, definition string) error {
+	rows, err := db.Query(fmt.Sprintf("pragma table_info(%v)", table))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var cid, notNull, primaryKey int
+		var name, columnType string
+		var defaultValue sql.NullString
+		if err := rows.Scan(&cid, &name, &columnType, &notNull, &defaultValue, &primaryKey); err != nil {
+			return err
+		}
+		if name == column {
+			return nil
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	rows.Close()
+	_, err = db.Exec(fmt.Sprintf("alter table %v add column %v %v", table, column, definition))
+	return err
+}
+
 // StoreGame stores a game by ID.
 // If a game is already established, only the PGN log is updated
 func (s *SqliteStore) StoreGame(ID string, gm *Game) error {
+	annotations := ""
+	if len(gm.annotations) > 0 {
+		encoded, err := json.Marshal(gm.annotations)
+		if err != nil {
+			return err
+		}
+		annotations = string(encoded)
+	}
+	adjudication := ""
+	if gm.adjudication != nil {
+		encoded, err := json.Marshal(gm.adjudication)
+		if err != nil {
+			return err
+		}
+		adjudication = string(encoded)
+	}
 	if _, err := s.RetrieveGame(ID); err == nil {
-		stmt, _ := s.db.Prepare("update games set pgn = ?, last_moved = ? where id = ?")
+		stmt, _ := s.db.Prepare("update games set pgn = ?, last_moved = ?, annotations = ?, adjudication = ? where id = ?")
 		defer stmt.Close()
-		_, err := stmt.Exec(gm.PGN(), gm.LastMoved(), ID)
+		_, err := stmt.Exec(gm.PGN(), gm.LastMoved(), annotations, adjudication, ID)
 		return err
 	}
-	stmt, _ := s.db.Prepare("insert into games (id, player_white_id, player_black_id, last_moved, pgn) values (?, ?, ?, ?, ?)")
+	stmt, _ := s.db.Prepare("insert into games (id, player_white_id, player_black_id, last_moved, pgn, annotations, adjudication) values (?, ?, ?, ?, ?, ?, ?)")
 	defer stmt.Close()
-	_, err := stmt.Exec(ID, gm.Players[White].ID, gm.Players[Black].ID, gm.LastMoved(), gm.PGN())
+	_, err := stmt.Exec(ID, gm.Players[White].ID, gm.Players[Black].ID, gm.LastMoved(), gm.PGN(), annotations, adjudication)
 	return err
 }
 
 // RetrieveGame retrieves a game by ID
 func (s *SqliteStore) RetrieveGame(ID string) (*Game, error) {
-	stmt, err := s.db.Prepare("select player_white_id, player_black_id, last_moved, pgn from games where id = ?")
+	stmt, err := s.db.Prepare("select player_white_id, player_black_id, last_moved, pgn, annotations, adjudication from games where id = ?")
 	if err != nil {
 		return nil, err
 	}
 	defer stmt.Close()
-	var player1, player2, pgn string
+	var player1, player2, pgn, annotations, adjudication string
 	var lastMoved time.Time
 	row := stmt.QueryRow(ID)
-	err = row.Scan(&player1, &player2, &lastMoved, &pgn)
+	err = row.Scan(&player1, &player2, &lastMoved, &pgn, &annotations, &adjudication)
 	if err != nil {
 		return nil, err
 	}
@@ -103,28 +194,33 @@ func (s *SqliteStore) RetrieveGame(ID string) (*Game, error) {
 	if err == nil {
 		gm.lastMoved = lastMoved
 	}
-
+	if err == nil && annotations != "" {
+		err = json.Unmarshal([]byte(annotations), &gm.annotations)
+	}
+	if err == nil && adjudication != "" {
+		err = json.Unmarshal([]byte(adjudication), &gm.adjudication)
+	}
 	return gm, err
 }
 
 // StoreChallenge only supports inserting new challenges. Challenges should not be updated only inserted/removed
 func (s *SqliteStore) StoreChallenge(challenge *Challenge) error {
-	stmt, _ := s.db.Prepare("insert into challenges (challenger_id, challenged_id, game_id, channel_id) values (?, ?, ?, ?) ")
+	stmt, _ := s.db.Prepare("insert into challenges (challenger_id, challenged_id, game_id, channel_id, coach) values (?, ?, ?, ?, ?) ")
 	defer stmt.Close()
-	_, err := stmt.Exec(challenge.ChallengerID, challenge.ChallengedID, challenge.GameID, challenge.ChannelID)
+	_, err := stmt.Exec(challenge.ChallengerID, challenge.ChallengedID, challenge.GameID, challenge.ChannelID, challenge.Coach)
 	return err
 }
 
 // RetrieveChallenge retrives a challenge by the challenger and challenged ID
 func (s *SqliteStore) RetrieveChallenge(challengerID string, challengedID string) (*Challenge, error) {
-	stmt, _ := s.db.Prepare("select game_id, channel_id from challenges where challenger_id = ? and challenged_id = ?")
+	stmt, _ := s.db.Prepare("select game_id, channel_id, coach from challenges where challenger_id = ? and challenged_id = ?")
 	defer stmt.Close()
 	challenge := Challenge{
 		ChallengerID: challengerID,
 		ChallengedID: challengedID,
 	}
 	row := stmt.QueryRow(challengerID, challengedID)
-	err := row.Scan(&challenge.GameID, &challenge.ChannelID)
+	err := row.Scan(&challenge.GameID, &challenge.ChannelID, &challenge.Coach)
 	return &challenge, err
 }
 
@@ -147,8 +243,8 @@ func (s *SqliteStore) StoreTakeback(takeback *Takeback) error {
 	defer stmt.Close()
 	_, err := stmt.Exec(
 		takeback.CurrentGame.ID,
-		takeback.CurrentGame.FEN(),
-		takeback.CurrentGame.FEN(),
+		takeback.FENSnapshot,
+		takeback.FENSnapshot,
 	)
 	return err
 }
@@ -177,3 +273,144 @@ func (s *SqliteStore) RemoveTakeback(takeback *Takeback) error {
 	_, err := stmt.Exec(takeback.CurrentGame.ID)
 	return err
 }
+
+// StoreArchivedGame stores the record of a completed game
+// Note: This will overwrite a previous record of the same game
+func (s *SqliteStore) StoreArchivedGame(archived *ArchivedGame) error {
+	stmt, _ := s.db.Prepare(`
+	insert or replace into archived_games
+		(id, team_id, player_white_id, player_black_id, result, method, ended_at, pgn)
+		values (?, ?, ?, ?, ?, ?, ?, ?)
+	`)
+	defer stmt.Close()
+	_, err := stmt.Exec(
+		archived.GameID,
+		archived.TeamID,
+		archived.WhiteID,
+		archived.BlackID,
+		archived.Result.String(),
+		archived.Method,
+		archived.EndedAt.UTC(),
+		archived.PGN,
+	)
+	return err
+}
+
+// archivePageSize is how many archived games are read from the DB at a time
+// RetrieveArchivedGame retrieves the record of a completed game by its ID
+func (s *SqliteStore) RetrieveArchivedGame(ID string) (*ArchivedGame, error) {
+	page, err := s.archivedGamesPage("id = ?", []interface{}{ID})
+	if err != nil {
+		return nil, err
+	}
+	if len(page) == 0 {
+		return nil, fmt.Errorf("Archived game by %v not found", ID)
+	}
+	return page[0], nil
+}
+
+const archivePageSize = 100
+
+// RetrieveArchivedGames finds all completed games matching the filter in the order they ended.
+// Games are read a page at a time and the rows are closed before the callback is called,
+// so the callback may use the store or block (such as writing to a slow client) without holding the DB connection.
+func (s *SqliteStore) RetrieveArchivedGames(filter ArchiveFilter, each func(*ArchivedGame) error) error {
+	conditions := []string{"1 = 1"}
+	args := []interface{}{}
+	if filter.TeamID != "" {
+		conditions = append(conditions, "team_id = ?")
+		args = append(args, filter.TeamID)
+	}
+	if filter.PlayerID != "" {
+		conditions = append(conditions, "(player_white_id = ? or player_black_id = ?)")
+		args = append(args, filter.PlayerID, filter.PlayerID)
+	}
+	if !filter.From.IsZero() {
+		conditions = append(conditions, "ended_at >= ?")
+		args = append(args, filter.From.UTC())
+	}
+	if !filter.To.IsZero() {
+		conditions = append(conditions, "ended_at < ?")
+		args = append(args, filter.To.UTC())
+	}
+	var last *ArchivedGame
+	for {
+		pageConditions, pageArgs := conditions, args
+		if last != nil {
+			// continue after the last game of the previous page, games ending at the same time are ordered by ID
+			pageConditions = append(append([]string{}, conditions...), "(ended_at > ? or (ended_at = ? and id > ?))")
+			pageArgs = append(append([]interface{}{}, args...), last.EndedAt.UTC(), last.EndedAt.UTC(), last.GameID)
+		}
+		page, err := s.archivedGamesPage(strings.Join(pageConditions, " and "), pageArgs)
+		if err != nil {
+			return err
+		}
+		for _, archived := range page {
+			if err := each(archived); err != nil {
+				return err
+			}
+		}
+		if len(page) < archivePageSize {
+			return nil
+		}
+		last = page[len(page)-1]
+	}
+}
+
+// archivedGamesPage reads up to a page of the archived games matching the conditions
+func (s *SqliteStore) archivedGamesPage(conditions string, args []interface{}) ([]*ArchivedGame, error) {
+	rows, err := s.db.Query(fmt.Sprintf(`
+	select id, team_id, player_white_id, player_black_id, result, method, ended_at, pgn
+		from archived_games
+		where %v
+		order by ended_at, id
+		limit %v
+	`, conditions, archivePageSize), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	page := []*ArchivedGame{}
+	for rows.Next() {
+		var archived ArchivedGame
+		var result string
+		if err := rows.Scan(
+			&archived.GameID,
+			&archived.TeamID,
+			&archived.WhiteID,
+			&archived.BlackID,
+			&result,
+			&archived.Method,
+			&archived.EndedAt,
+			&archived.PGN,
+		); err != nil {
+			return nil, err
+		}
+		archived.Result = chess.Outcome(result)
+		page = append(page, &archived)
+	}
+	return page, rows.Err()
+}
+
+// StorePlayerAlias maps a player name to a Slack user ID within a team
+// Note: This will overwrite a previous mapping of the same name
+func (s *SqliteStore) StorePlayerAlias(teamID string, name string, playerID string) error {
+	stmt, _ := s.db.Prepare(`
+	insert into player_aliases (team_id, name, player_id) values (?, ?, ?)
+	on conflict (team_id, name) do update set
+		player_id = ?
+	`)
+	defer stmt.Close()
+	_, err := stmt.Exec(teamID, name, playerID, playerID)
+	return err
+}
+
+// RetrievePlayerAlias finds the Slack user ID a player name is mapped to within a team
+func (s *SqliteStore) RetrievePlayerAlias(teamID string, name string) (string, error) {
+	stmt, _ := s.db.Prepare("select player_id from player_aliases where team_id = ? and name = ?")
+	defer stmt.Close()
+	var playerID string
+	row := stmt.QueryRow(teamID, name)
+	err := row.Scan(&playerID)
+	return playerID, err
+}
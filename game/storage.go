@@ -19,3 +19,17 @@ type TakebackStorage interface {
 	StoreTakeback(takeback *Takeback) error
 	RemoveTakeback(takeback *Takeback) error
 }
+
+// ArchiveStorage is an interface to be implemented for persisting completed games.
+// Archived games are retrieved in the order they ended and handed to the provided callback one at a time.
+type ArchiveStorage interface {
+	StoreArchivedGame(archived *ArchivedGame) error
+	RetrieveArchivedGame(ID string) (*ArchivedGame, error)
+	RetrieveArchivedGames(filter ArchiveFilter, each func(*ArchivedGame) error) error
+}
+
+// PlayerAliasStorage is an interface to be implemented for mapping player names of imported games to Slack user IDs.
+type PlayerAliasStorage interface {
+	StorePlayerAlias(teamID string, name string, playerID string) error
+	RetrievePlayerAlias(teamID string, name string) (string, error)
+}
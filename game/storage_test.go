@@ -1,9 +1,16 @@
 package game_test
 
 import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/cjsaylor/chessbot/game"
+	"github.com/cjsaylor/chessbot/storagetest"
+	"github.com/cjsaylor/chessbot/storagetest/gametest"
+	bolt "go.etcd.io/bbolt"
 )
 
 type dbTest struct {
@@ -79,3 +86,108 @@ func TestRetrieveGameThatDoesNotExist(t *testing.T) {
 	}
 
 }
+
+func TestStoreConformance(t *testing.T) {
+	storagetest.RunStores(t, storagetest.Stores{
+		Memory: func() interface{} { return game.NewMemoryStore() },
+		Sqlite: func(path string) (interface{}, error) { return game.NewSqliteStore(path) },
+		Bolt:   func(db *bolt.DB) (interface{}, error) { return game.NewBoltStore(db) },
+	}, func(t *testing.T, open storagetest.Opener) {
+		gametest.Run(t, func(t *testing.T) gametest.Backend {
+			store := open(t)
+			return gametest.Backend{
+				GameStorage:      store.(game.GameStorage),
+				ChallengeStorage: store.(game.ChallengeStorage),
+				TakebackStorage:  store.(game.TakebackStorage),
+				ArchiveStorage:   store.(game.ArchiveStorage),
+				AliasStorage:     store.(game.PlayerAliasStorage),
+			}
+		})
+	})
+}
+
+func TestSqliteStoreUpgradesChallenges(t *testing.T) {
+	dir, err := ioutil.TempDir("", "chessbot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "upgrade.db")
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The challenges table as created before coached games
+	if _, err := db.Exec(`CREATE TABLE challenges (
+		challenger_id text NOT NULL,
+		challenged_id text NOT NULL,
+		channel_id text NOT NULL,
+		game_id text NOT NULL UNIQUE,
+		PRIMARY KEY (challenger_id, challenged_id)
+	)`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec("insert into challenges values ('challenger', 'challenged', 'channel', '1234')"); err != nil {
+		t.Fatal(err)
+	}
+	db.Close()
+	store, err := game.NewSqliteStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	challenge, err := store.RetrieveChallenge("challenger", "challenged")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if challenge.GameID != "1234" || challenge.Coach {
+		t.Errorf("expected the existing challenge without coaching, got %v", *challenge)
+	}
+	if _, err := game.NewSqliteStore(path); err != nil {
+		t.Errorf("expected reopening the upgraded database to succeed, got %v", err)
+	}
+}
+
+func TestSqliteStoreUpgradesGames(t *testing.T) {
+	dir, err := ioutil.TempDir("", "chessbot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "upgrade.db")
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The games table as created before move annotations
+	if _, err := db.Exec(`CREATE TABLE games (
+		id text PRIMARY KEY,
+		player_white_id text,
+		player_black_id text,
+		last_moved datetime,
+		pgn text
+	)`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec("insert into games values ('1234', 'white', 'black', '2019-01-01 00:00:00', '1.e2e4 *')"); err != nil {
+		t.Fatal(err)
+	}
+	db.Close()
+	store, err := game.NewSqliteStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gm, err := store.RetrieveGame("1234")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(gm.MoveAnnotations()) != 0 {
+		t.Errorf("expected the existing game without annotations, got %v", gm.MoveAnnotations())
+	}
+	gm.AnnotateMove(1, game.MoveAnnotation{NAG: 1})
+	if err := store.StoreGame(gm.ID, gm); err != nil {
+		t.Fatal(err)
+	}
+	if gm, _ = store.RetrieveGame("1234"); gm == nil || gm.MoveAnnotations()[1].NAG != 1 {
+		t.Error("expected the upgraded game to keep its annotations")
+	}
+}
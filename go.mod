@@ -5,9 +5,9 @@ go 1.12
 require (
 	github.com/caarlos0/env v3.5.0+incompatible
 	github.com/cjsaylor/chessimage v0.0.0-20190107020940-8abad33612f4
-	github.com/flopp/go-findfont v0.0.0-20180308170802-e788239e52bc // indirect
-	github.com/fogleman/gg v1.1.0 // indirect
-	github.com/golang/freetype v0.0.0-20170609003504-e2365dfdc4a0 // indirect
+	github.com/flopp/go-findfont v0.0.0-20180308170802-e788239e52bc
+	github.com/fogleman/gg v1.1.0
+	github.com/golang/freetype v0.0.0-20170609003504-e2365dfdc4a0
 	github.com/gorilla/websocket v1.4.0 // indirect
 	github.com/lusis/go-slackbot v0.0.0-20180109053408-401027ccfef5 // indirect
 	github.com/lusis/slack-test v0.0.0-20180109053238-3c758769bfa6 // indirect
@@ -16,5 +16,6 @@ require (
 	github.com/notnil/chess v0.0.0-20181214160432-429595102215
 	github.com/pkg/errors v0.8.0 // indirect
 	github.com/stretchr/testify v1.3.0 // indirect
-	golang.org/x/image v0.0.0-20180708004352-c73c2afc3b81 // indirect
+	go.etcd.io/bbolt v1.3.5
+	golang.org/x/image v0.0.0-20180708004352-c73c2afc3b81
 )
@@ -0,0 +1,230 @@
+// Package importer stores historical games from PGN files as completed games of a team
+package importer
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/cjsaylor/chessbot/game"
+)
+
+var tagPattern = regexp.MustCompile(`^\[(\w+)\s+"(.*)"\]$`)
+
+// ErrNotAPlayer is the reason games are skipped when they are imported on behalf of a player who didn't play them
+var ErrNotAPlayer = errors.New("the game was not played by the uploader")
+
+// Importer stores games parsed from PGN as completed (and therefore unplayable) games
+type Importer struct {
+	aliasStorage   game.PlayerAliasStorage
+	archiveStorage game.ArchiveStorage
+}
+
+// NewImporter returns an importer that resolves players with the alias storage and stores games in the archive
+func NewImporter(aliasStorage game.PlayerAliasStorage, archiveStorage game.ArchiveStorage) *Importer {
+	return &Importer{
+		aliasStorage:   aliasStorage,
+		archiveStorage: archiveStorage,
+	}
+}
+
+// Report summarizes the result of an import
+type Report struct {
+	Imported int
+	Skipped  []SkippedGame
+}
+
+// SkippedGame describes a game of the PGN file that could not be imported
+type SkippedGame struct {
+	// Number is the position of the game within the PGN file, starting at 1
+	Number int
+	Reason error
+}
+
+func (s SkippedGame) Error() string {
+	return fmt.Sprintf("game %v: %v", s.Number, s.Reason)
+}
+
+// normalizeName makes player name lookups insensitive to case and surrounding whitespace
+func normalizeName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// MapPlayer maps a player name as it appears in PGN [White] and [Black] tags to a Slack user ID
+func (i *Importer) MapPlayer(teamID string, name string, playerID string) error {
+	return i.aliasStorage.StorePlayerAlias(teamID, normalizeName(name), playerID)
+}
+
+// MappedPlayer finds the Slack user ID a player name is mapped to
+func (i *Importer) MappedPlayer(teamID string, name string) (string, error) {
+	return i.aliasStorage.RetrievePlayerAlias(teamID, normalizeName(name))
+}
+
+// Import parses every game of a multi-game PGN and stores the completed ones for the team.
+// Games with unmapped players, without a result or with invalid moves are skipped and reported.
+func (i *Importer) Import(teamID string, r io.Reader) (*Report, error) {
+	return i.ImportPlayedBy(teamID, "", r)
+}
+
+// ImportPlayedBy imports the games of a multi-game PGN like Import, but only those played by the player (a Slack user ID),
+// so players can't add games of others to their records. Games the player didn't play are skipped with ErrNotAPlayer.
+// An empty player ID imports every game.
+func (i *Importer) ImportPlayedBy(teamID string, playerID string, r io.Reader) (*Report, error) {
+	pgns, err := SplitPGN(r)
+	if err != nil {
+		return nil, err
+	}
+	report := &Report{}
+	for n, pgn := range pgns {
+		if err := i.importGame(teamID, playerID, pgn); err != nil {
+			report.Skipped = append(report.Skipped, SkippedGame{
+				Number: n + 1,
+				Reason: err,
+			})
+			continue
+		}
+		report.Imported++
+	}
+	return report, nil
+}
+
+func (i *Importer) importGame(teamID string, playerID string, pgn string) error {
+	tags := parseTags(pgn)
+	white, err := i.resolvePlayer(teamID, tags["White"])
+	if err != nil {
+		return err
+	}
+	black, err := i.resolvePlayer(teamID, tags["Black"])
+	if err != nil {
+		return err
+	}
+	if playerID != "" && white.ID != playerID && black.ID != playerID {
+		return ErrNotAPlayer
+	}
+	sum := sha256.Sum256([]byte(teamID + pgn))
+	gm, err := game.NewGameFromPGN("import-"+hex.EncodeToString(sum[:8]), cleanMovetext(pgn), white, black)
+	if err != nil {
+		return err
+	}
+	archived, err := gm.ArchiveAt(teamID, parseDate(tags["Date"]))
+	if err != nil {
+		return err
+	}
+	return i.archiveStorage.StoreArchivedGame(archived)
+}
+
+func (i *Importer) resolvePlayer(teamID string, name string) (game.Player, error) {
+	if strings.TrimSpace(name) == "" {
+		return game.Player{}, fmt.Errorf("missing player name")
+	}
+	playerID, err := i.aliasStorage.RetrievePlayerAlias(teamID, normalizeName(name))
+	if err != nil {
+		return game.Player{}, fmt.Errorf("player %q is not mapped to a Slack user", name)
+	}
+	return game.Player{ID: playerID}, nil
+}
+
+// SplitPGN splits a multi-game PGN into the PGN of each individual game
+func SplitPGN(r io.Reader) ([]string, error) {
+	pgns := []string{}
+	var current strings.Builder
+	inMovetext := false
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		isTag := strings.HasPrefix(line, "[")
+		if isTag && inMovetext {
+			pgns = append(pgns, current.String())
+			current.Reset()
+			inMovetext = false
+		}
+		if line != "" && !isTag {
+			inMovetext = true
+		}
+		current.WriteString(line + "\n")
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(current.String()) != "" {
+		pgns = append(pgns, current.String())
+	}
+	return pgns, nil
+}
+
+func parseTags(pgn string) map[string]string {
+	tags := map[string]string{}
+	for _, line := range strings.Split(pgn, "\n") {
+		if results := tagPattern.FindStringSubmatch(strings.TrimSpace(line)); results != nil {
+			tags[results[1]] = results[2]
+		}
+	}
+	return tags
+}
+
+// parseDate parses a PGN date, treating unknown months and days ("2019.??.??") as the first
+func parseDate(date string) time.Time {
+	parts := strings.Split(date, ".")
+	if len(parts) != 3 || strings.Contains(parts[0], "?") {
+		return time.Time{}
+	}
+	for i := 1; i < 3; i++ {
+		if strings.Contains(parts[i], "?") {
+			parts[i] = "01"
+		}
+	}
+	parsed, err := time.Parse("2006.01.02", strings.Join(parts, "."))
+	if err != nil {
+		return time.Time{}
+	}
+	return parsed
+}
+
+// cleanMovetext removes comments, variations and annotation glyphs that the PGN parser cannot handle
+func cleanMovetext(pgn string) string {
+	var cleaned strings.Builder
+	comment, variations, lineComment := false, 0, false
+	inTag := false
+	for _, char := range pgn {
+		switch {
+		case lineComment:
+			if char == '\n' {
+				lineComment = false
+				cleaned.WriteRune(char)
+			}
+		case comment:
+			if char == '}' {
+				comment = false
+			}
+		case inTag:
+			if char == '\n' {
+				inTag = false
+			}
+			cleaned.WriteRune(char)
+		case char == '[' && variations == 0:
+			inTag = true
+			cleaned.WriteRune(char)
+		case char == '{':
+			comment = true
+		case char == ';':
+			lineComment = true
+		case char == '(':
+			variations++
+		case char == ')':
+			if variations > 0 {
+				variations--
+			}
+		case variations > 0:
+		default:
+			cleaned.WriteRune(char)
+		}
+	}
+	return game.StripNAGs(cleaned.String())
+}
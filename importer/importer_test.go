@@ -0,0 +1,113 @@
+package importer_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cjsaylor/chessbot/game"
+	"github.com/cjsaylor/chessbot/importer"
+)
+
+func TestSplitPGN(t *testing.T) {
+	file, err := os.Open("testdata/club.pgn")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	pgns, err := importer.SplitPGN(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pgns) != 4 {
+		t.Fatalf("expected 4 games, got %v", len(pgns))
+	}
+	if !strings.HasPrefix(pgns[1], "[Event \"Club Championship\"]") || !strings.Contains(pgns[1], "[Round \"2\"]") {
+		t.Errorf("expected the second game to start with its tags, got %v", pgns[1])
+	}
+}
+
+func TestImportPlayedBy(t *testing.T) {
+	store := game.NewMemoryStore()
+	imp := importer.NewImporter(store, store)
+	imp.MapPlayer("team", "Smith, John", "U1")
+	imp.MapPlayer("team", "Doe, Jane", "U2")
+	file, err := os.Open("testdata/club.pgn")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	report, err := imp.ImportPlayedBy("team", "U3", file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Imported != 0 || len(report.Skipped) != 4 || report.Skipped[0].Reason != importer.ErrNotAPlayer {
+		t.Errorf("expected games of other players to be skipped, got %v imported (skipped %v)", report.Imported, report.Skipped)
+	}
+	file.Seek(0, 0)
+	if report, err = imp.ImportPlayedBy("team", "U2", file); err != nil || report.Imported != 2 {
+		t.Errorf("expected the games of the uploader to be imported, got %v (%v)", report, err)
+	}
+	if mapped, err := imp.MappedPlayer("team", " doe, jane"); err != nil || mapped != "U2" {
+		t.Errorf("expected the name to be mapped to U2, got %v (%v)", mapped, err)
+	}
+}
+
+func TestImport(t *testing.T) {
+	store := game.NewMemoryStore()
+	imp := importer.NewImporter(store, store)
+	imp.MapPlayer("team", "Smith, John", "U1")
+	imp.MapPlayer("team", "Doe, Jane", "U2")
+	file, err := os.Open("testdata/club.pgn")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	report, err := imp.Import("team", file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Imported != 2 {
+		t.Errorf("expected 2 imported games, got %v (skipped %v)", report.Imported, report.Skipped)
+	}
+	if len(report.Skipped) != 2 || report.Skipped[0].Number != 3 || report.Skipped[1].Number != 4 {
+		t.Errorf("expected the unmapped and unfinished games to be skipped, got %v", report.Skipped)
+	}
+
+	archived := []*game.ArchivedGame{}
+	store.RetrieveArchivedGames(game.ArchiveFilter{TeamID: "team"}, func(a *game.ArchivedGame) error {
+		archived = append(archived, a)
+		return nil
+	})
+	if len(archived) != 2 {
+		t.Fatalf("expected 2 archived games, got %v", len(archived))
+	}
+	first := archived[1]
+	if first.WhiteID != "U1" || first.BlackID != "U2" || first.Result != "1-0" {
+		t.Errorf("unexpected archived game %v", first)
+	}
+	if !first.EndedAt.Equal(time.Date(2015, time.March, 14, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected the game to end on its PGN date, got %v", first.EndedAt)
+	}
+	if !strings.Contains(first.PGN, "9.Qxa8 1-0") {
+		t.Errorf("expected the main line to be imported, got %v", first.PGN)
+	}
+	if second := archived[0]; second.WhiteID != "U2" || second.Result != "1/2-1/2" {
+		t.Errorf("expected the case insensitive alias to resolve, got %v", second)
+	}
+	if _, err := store.RetrieveGame(first.GameID); err == nil {
+		t.Error("expected imported games not to be playable")
+	}
+
+	file.Seek(0, 0)
+	imp.Import("team", file)
+	count := 0
+	store.RetrieveArchivedGames(game.ArchiveFilter{}, func(a *game.ArchivedGame) error {
+		count++
+		return nil
+	})
+	if count != 2 {
+		t.Errorf("expected importing the same file twice not to duplicate games, got %v", count)
+	}
+}
@@ -19,14 +19,15 @@ var challengerPattern = regexp.MustCompile("^<@([\\w|\\d]+).*$")
 
 // SlackActionHandler will respond to all Slack integration component requests
 type SlackActionHandler struct {
-	SigningKey       string
-	Hostname         string
-	SlackClient      *slack.Client
-	AuthStorage      AuthStorage
-	GameStorage      game.GameStorage
-	ChallengeStorage game.ChallengeStorage
-	TakebackStorage  game.TakebackStorage
-	LinkRenderer     rendering.RenderLink
+	SigningKey        string
+	Hostname          string
+	SlackClient       *slack.Client
+	AuthStorage       AuthStorage
+	GameStorage       game.GameStorage
+	ChallengeStorage  game.ChallengeStorage
+	TakebackStorage   game.TakebackStorage
+	PreferenceStorage PreferenceStorage
+	LinkRenderer      rendering.RenderLink
 }
 
 // HandleChallenge does the necessary operations for action responses to player challenges.
@@ -55,17 +56,19 @@ func (s SlackActionHandler) HandleChallenge(w http.ResponseWriter, event slackev
 	}, game.Player{
 		ID: challenge.ChallengerID,
 	})
+	if challenge.Coach {
+		gm.SetCoached()
+	}
 	s.GameStorage.StoreGame(gameID, gm)
 	gm.Start()
-	link, _ := s.LinkRenderer.CreateLink(gm)
+	preferences := retrievePreferences(s.PreferenceStorage, event.Team.ID, gm.TurnPlayer().ID)
 	s.SlackClient.PostMessage(
 		challenge.ChannelID,
-		slack.MsgOptionText(fmt.Sprintf("<@%v>'s (%v) turn.", gm.TurnPlayer().ID, gm.Turn()), false),
+		slack.MsgOptionText(turnText(gm), false),
 		slack.MsgOptionTS(gameID),
-		slack.MsgOptionAttachments(slack.Attachment{
-			Text:     fmt.Sprintf("<@%v> has accepted. Here is the opening.", event.User.ID),
-			ImageURL: link.String(),
-		}))
+		slack.MsgOptionAttachments(preferences.gameBoardAttachment(slack.Attachment{
+			Text: fmt.Sprintf("<@%v> has accepted. Here is the opening.", event.User.ID),
+		}, s.LinkRenderer, s.SlackClient, gm, rendering.Annotations{})))
 	s.sendResponse(w, event.OriginalMessage, ":ok: Game begun!")
 	if err := s.ChallengeStorage.RemoveChallenge(challenge.ChallengerID, challenge.ChallengedID); err != nil {
 		log.Printf("Failed to remove challenge %v: %v\n", challenge, err)
@@ -119,14 +122,14 @@ func (s SlackActionHandler) HandleTakeback(w http.ResponseWriter, event slackeve
 		s.sendError(gameID, event.Channel.ID, fmt.Sprintf("Take back request failed: %v", err))
 		return
 	}
-	link, _ := s.LinkRenderer.CreateLink(takeback.CurrentGame)
 	boardAttachment := slack.Attachment{
-		ImageURL: link.String(),
-		Color:    colorToHex[takeback.CurrentGame.Turn()],
+		Color: colorToHex[takeback.CurrentGame.Turn()],
 	}
 	if chessMove != nil {
 		boardAttachment.Text = chessMove.String()
 	}
+	preferences := retrievePreferences(s.PreferenceStorage, event.Team.ID, takeback.CurrentGame.TurnPlayer().ID)
+	boardAttachment = preferences.gameBoardAttachment(boardAttachment, s.LinkRenderer, s.SlackClient, takeback.CurrentGame, rendering.Annotations{})
 	if err := s.GameStorage.StoreGame(gameID, takeback.CurrentGame); err != nil {
 		s.sendError(gameID, event.Channel.ID, err.Error())
 		return
@@ -0,0 +1,53 @@
+package integration
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/cjsaylor/chessbot/game"
+	"github.com/nlopes/slack/slackevents"
+	"github.com/notnil/chess"
+)
+
+// handleAnnotateCommand lets a player of the game in the thread comment on one of its moves (the last one by default).
+// The annotated position is shown once the annotation is saved (and archived, for a completed game).
+func (s SlackHandler) handleAnnotateCommand(gameID string, command *AnnotateCommand, ev *slackevents.AppMentionEvent) {
+	gm, err := s.GameStorage.RetrieveGame(gameID)
+	if err != nil {
+		s.sendError(gameID, ev.Channel, "There is no game in this thread to annotate.")
+		return
+	}
+	if _, err := gm.PlayerByID(ev.User); err != nil {
+		s.sendError(gameID, ev.Channel, "Only the players of this game can annotate its moves.")
+		return
+	}
+	plies := len(gm.Plies())
+	if plies == 0 {
+		s.sendError(gameID, ev.Channel, "This game has no moves to annotate yet.")
+		return
+	}
+	ply := command.Ply
+	if ply == 0 {
+		ply = plies
+	}
+	if ply > plies {
+		s.sendError(gameID, ev.Channel, fmt.Sprintf("This game only has %v plies.", plies))
+		return
+	}
+	gm.AnnotateMove(ply, game.MoveAnnotation{
+		NAG:     command.NAG,
+		Comment: command.Comment,
+	})
+	if err := s.GameStorage.StoreGame(gameID, gm); err != nil {
+		log.Println(err)
+		s.sendError(gameID, ev.Channel, "Unable to save the annotation.")
+		return
+	}
+	// Completed games are exported from their archived record, which has to include the annotation too
+	if gm.Outcome() != chess.NoOutcome && s.ArchiveStorage != nil {
+		if err := game.UpdateArchive(s.ArchiveStorage, s.teamID, gm); err != nil {
+			log.Printf("Failed to archive the annotations of game %v: %v\n", gameID, err)
+		}
+	}
+	s.handlePositionCommand(gameID, &PositionCommand{Ply: ply}, ev)
+}
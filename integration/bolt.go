@@ -0,0 +1,93 @@
+package integration
+
+import (
+	"encoding/json"
+	"fmt"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	authBucket       = []byte("authorizations")
+	preferenceBucket = []byte("user_preferences")
+)
+
+// BoltStore is an implementation of the AuthStorage and PreferenceStorage interfaces that persists using an embedded bbolt database
+type BoltStore struct {
+	db *bolt.DB
+}
+
+// NewBoltStore creates (if not exists) the buckets used for oauth tokens and user preferences within an open bbolt database
+// It implements the AuthStorage and PreferenceStorage interfaces and is intended as a suitable
+// perminent storage of oauth tokens
+func NewBoltStore(db *bolt.DB) (*BoltStore, error) {
+	err := db.Update(func(tx *bolt.Tx) error {
+		for _, bucket := range [][]byte{authBucket, preferenceBucket} {
+			if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &BoltStore{db: db}, nil
+}
+
+// StoreAuthToken stores the oauth token granted by slack user for a given team ID
+func (b *BoltStore) StoreAuthToken(teamID string, oauthToken string) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(authBucket).Put([]byte(teamID), []byte(oauthToken))
+	})
+}
+
+// GetAuthToken retrieves an oauth token for use with slack given a team ID
+func (b *BoltStore) GetAuthToken(teamID string) (string, error) {
+	var token string
+	err := b.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(authBucket).Get([]byte(teamID))
+		if data == nil {
+			return fmt.Errorf("Auth token not found for team %v", teamID)
+		}
+		token = string(data)
+		return nil
+	})
+	return token, err
+}
+
+// AuthTeamIDs lists every team ID that has an oauth token stored
+func (b *BoltStore) AuthTeamIDs() ([]string, error) {
+	teamIDs := []string{}
+	err := b.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(authBucket).ForEach(func(teamID, _ []byte) error {
+			teamIDs = append(teamIDs, string(teamID))
+			return nil
+		})
+	})
+	return teamIDs, err
+}
+
+// StorePreferences stores the display preferences of a user within a team
+func (b *BoltStore) StorePreferences(teamID string, userID string, preferences DisplayPreferences) error {
+	data, err := json.Marshal(preferences)
+	if err != nil {
+		return err
+	}
+	return b.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(preferenceBucket).Put([]byte(teamID+"\x00"+userID), data)
+	})
+}
+
+// RetrievePreferences retrieves the display preferences of a user within a team
+func (b *BoltStore) RetrievePreferences(teamID string, userID string) (DisplayPreferences, error) {
+	var preferences DisplayPreferences
+	err := b.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(preferenceBucket).Get([]byte(teamID + "\x00" + userID))
+		if data == nil {
+			return fmt.Errorf("Preferences not found for user %v", userID)
+		}
+		return json.Unmarshal(data, &preferences)
+	})
+	return preferences, err
+}
@@ -0,0 +1,114 @@
+package integration
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// encryptedTokenPrefix marks a stored token as encrypted. Tokens without it are legacy plaintext.
+const encryptedTokenPrefix = "enc"
+
+// ErrUnknownTokenKey is returned when a token was encrypted with a key that is no longer configured.
+var ErrUnknownTokenKey = errors.New("token was encrypted with an unknown key")
+
+// TokenCipher encrypts and decrypts oauth tokens at rest with AES-GCM.
+// Tokens are always encrypted with the current key, while previous keys are kept
+// so that tokens encrypted before a key rotation can still be read.
+// Each token is bound to its team, so a token copied to another team fails to decrypt.
+type TokenCipher struct {
+	currentKeyID string
+	keys         map[string]cipher.AEAD
+}
+
+// NewTokenCipher creates a cipher from base64 encoded 16, 24 or 32 byte AES keys.
+// The first key is used for encryption, previous keys are only used for decryption.
+func NewTokenCipher(key string, previousKeys ...string) (*TokenCipher, error) {
+	c := &TokenCipher{
+		keys: make(map[string]cipher.AEAD, len(previousKeys)+1),
+	}
+	for i, encodedKey := range append([]string{key}, previousKeys...) {
+		rawKey, err := base64.StdEncoding.DecodeString(encodedKey)
+		if err != nil {
+			return nil, fmt.Errorf("token key is not valid base64: %v", err)
+		}
+		block, err := aes.NewCipher(rawKey)
+		if err != nil {
+			return nil, err
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, err
+		}
+		keyID := tokenKeyID(rawKey)
+		c.keys[keyID] = aead
+		if i == 0 {
+			c.currentKeyID = keyID
+		}
+	}
+	return c, nil
+}
+
+// tokenKeyID derives a short, non-secret identifier for a key so stored tokens record which key sealed them
+func tokenKeyID(key []byte) string {
+	sum := sha256.Sum256(key)
+	return hex.EncodeToString(sum[:4])
+}
+
+// additionalData authenticates the key and the team a token is sealed for along with the token
+func additionalData(keyID string, teamID string) []byte {
+	return []byte(keyID + ":" + teamID)
+}
+
+// Encrypt seals the token of a team with the current key
+func (c *TokenCipher) Encrypt(teamID string, token string) (string, error) {
+	aead := c.keys[c.currentKeyID]
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+	sealed := aead.Seal(nonce, nonce, []byte(token), additionalData(c.currentKeyID, teamID))
+	return strings.Join([]string{
+		encryptedTokenPrefix,
+		c.currentKeyID,
+		base64.StdEncoding.EncodeToString(sealed),
+	}, ":"), nil
+}
+
+// Decrypt opens the token of a team sealed by Encrypt with the current or a previous key.
+// Legacy plaintext tokens are returned as is.
+func (c *TokenCipher) Decrypt(teamID string, stored string) (string, error) {
+	parts := strings.SplitN(stored, ":", 3)
+	if len(parts) != 3 || parts[0] != encryptedTokenPrefix {
+		return stored, nil
+	}
+	aead, ok := c.keys[parts[1]]
+	if !ok {
+		return "", ErrUnknownTokenKey
+	}
+	sealed, err := base64.StdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < aead.NonceSize() {
+		return "", errors.New("encrypted token is too short")
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	token, err := aead.Open(nil, nonce, ciphertext, additionalData(parts[1], teamID))
+	if err != nil {
+		return "", err
+	}
+	return string(token), nil
+}
+
+// IsCurrent determines if a stored token is encrypted with the current key
+func (c *TokenCipher) IsCurrent(stored string) bool {
+	return strings.HasPrefix(stored, encryptedTokenPrefix+":"+c.currentKeyID+":")
+}
@@ -0,0 +1,88 @@
+package integration
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/cjsaylor/chessbot/rendering"
+	"github.com/nlopes/slack"
+	"github.com/nlopes/slack/slackevents"
+	"github.com/notnil/chess"
+)
+
+// maxHints is the number of hints a player can get for a move: the piece to move, then where to move it
+const maxHints = 2
+
+var pieceNames = map[chess.PieceType]string{
+	chess.King:   "king",
+	chess.Queen:  "queen",
+	chess.Rook:   "rook",
+	chess.Bishop: "bishop",
+	chess.Knight: "knight",
+	chess.Pawn:   "pawn",
+}
+
+// handleHintCommand privately gives the player to move of a coached game a hint at the engine's best move.
+// The first hint is the piece to move and the second one where to move it.
+func (s SlackHandler) handleHintCommand(gameID string, ev *slackevents.AppMentionEvent) {
+	gm, err := s.GameStorage.RetrieveGame(gameID)
+	if err != nil {
+		s.sendError(gameID, ev.Channel, "There is no game in this thread to give a hint for.")
+		return
+	}
+	if !gm.Coached() || s.Coach == nil {
+		s.sendError(gameID, ev.Channel, "Hints are only given in coached games. Start one with \"challenge @player coach\".")
+		return
+	}
+	if gm.Outcome() != chess.NoOutcome {
+		s.sendError(gameID, ev.Channel, "This game is already over.")
+		return
+	}
+	if gm.TurnPlayer().ID != ev.User {
+		s.sendError(gameID, ev.Channel, "Hints are only given to the player to move.")
+		return
+	}
+	if gm.HintsForMove() >= maxHints {
+		s.sendError(gameID, ev.Channel, "You've had every hint for this move.")
+		return
+	}
+	lines, err := s.Coach.Evaluate(gm.FEN(), 1)
+	if err != nil || len(lines) == 0 || len(lines[0].Moves) == 0 {
+		log.Printf("Failed to evaluate game %v for a hint: %v\n", gameID, err)
+		s.sendError(gameID, ev.Channel, "I couldn't come up with a hint for this position.")
+		return
+	}
+	position := positionOf(gm)
+	best, err := (chess.LongAlgebraicNotation{}).Decode(position, lines[0].Moves[0])
+	if err != nil {
+		log.Printf("Failed to decode hint %v for game %v: %v\n", lines[0].Moves[0], gameID, err)
+		s.sendError(gameID, ev.Channel, "I couldn't come up with a hint for this position.")
+		return
+	}
+	given := gm.RecordHint()
+	if err := s.GameStorage.StoreGame(gameID, gm); err != nil {
+		s.sendError(gameID, ev.Channel, err.Error())
+		return
+	}
+	piece := position.Board().Piece(best.S1())
+	text := []string{}
+	if mate := lines[0].Score.Mate; mate > 0 {
+		text = append(text, fmt.Sprintf("You have a forced mate in %v.", mate))
+	}
+	annotations := rendering.Annotations{}
+	if given == 1 {
+		text = append(text, fmt.Sprintf("Hint: look at your %v on %v.", pieceNames[piece.Type()], best.S1()))
+		annotations.Highlights = []rendering.Highlight{{Square: best.S1(), Color: "yellow"}}
+	} else {
+		text = append(text, fmt.Sprintf("Hint: move your %v from %v to %v.", pieceNames[piece.Type()], best.S1(), best.S2()))
+		annotations.Arrows = []rendering.Arrow{{From: best.S1(), To: best.S2(), Color: "yellow"}}
+	}
+	boardAttachment := s.preferencesFor(ev.User).gameBoardAttachment(slack.Attachment{}, s.LinkRenderer, s.SlackClient, gm, annotations)
+	s.SlackClient.PostEphemeral(
+		ev.Channel,
+		ev.User,
+		slack.MsgOptionText(strings.Join(text, " "), false),
+		slack.MsgOptionAttachments(boardAttachment),
+		slack.MsgOptionTS(ev.TimeStamp))
+}
@@ -2,7 +2,15 @@ package integration
 
 import (
 	"errors"
+	"fmt"
+	"html"
 	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cjsaylor/chessbot/game"
+	"github.com/cjsaylor/chessbot/rendering"
 )
 
 // CommandType is the kind of command a user wishes to execute.
@@ -21,6 +29,36 @@ const (
 	Takeback
 	// Help represents a player's need for help (UI or otherwise).
 	Help
+	// Export represents a request to export completed games as PGN.
+	Export
+	// Import represents a request to import historical games from a PGN file.
+	Import
+	// Alias represents a mapping of a player name in imported games to a Slack user.
+	Alias
+	// Show represents a request to show an annotated diagram of a position.
+	Show
+	// Settings represents a request to view or change a player's board display preferences.
+	Settings
+	// Position represents a request to show the board of the game after a given ply.
+	Position
+	// Scoresheet represents a request for a printable scoresheet of the game.
+	Scoresheet
+	// Openings represents a request for the opening statistics of a player.
+	Openings
+	// Explore represents a request for the continuations played by the team from a position.
+	Explore
+	// Puzzle represents a request to play a puzzle in a new thread.
+	Puzzle
+	// Tablebase represents a request for the tablebase verdict of the game's position, or to adjudicate the game by it.
+	Tablebase
+	// Hint represents a request for a hint at the best move in a coached game.
+	Hint
+	// Analyze represents a request to fork the game into an analysis board in a new thread.
+	Analyze
+	// Annotate represents a player's comment or NAG on a move of the game.
+	Annotate
+	// DailyPuzzle represents a request to post the team's puzzle of the day in the channel, or to stop posting it.
+	DailyPuzzle
 )
 
 // CommandPattern maps a regular expression pattern to a specific command type.
@@ -39,6 +77,8 @@ type CommandMatch struct {
 // ChallengeCommand represents a challenge to propose
 type ChallengeCommand struct {
 	ChallengedID string
+	// Coach enables hints for the players of the game
+	Coach bool
 }
 
 // MoveCommand represents a single long algebraic notation move.
@@ -46,14 +86,72 @@ type MoveCommand struct {
 	LAN string
 }
 
+// ExportCommand represents a request to export completed games, optionally narrowed by player and date range.
+type ExportCommand struct {
+	PlayerID string
+	From     time.Time
+	To       time.Time
+}
+
+// AliasCommand maps a player name as it appears in PGN tags to a Slack user
+type AliasCommand struct {
+	Name     string
+	PlayerID string
+}
+
+// ShowCommand represents a position (or the game of the thread if FEN is empty) to show with annotations
+type ShowCommand struct {
+	FEN         string
+	Annotations rendering.Annotations
+}
+
+// SettingsCommand represents a change to a single display preference. An empty setting only shows the current preferences.
+type SettingsCommand struct {
+	Setting string
+	Value   string
+}
+
+// PositionCommand represents a ply of the game in the thread to show the board after (0 being the starting position)
+type PositionCommand struct {
+	Ply int
+}
+
+// ScoresheetCommand represents a request for the scoresheet of the game in the thread with diagrams after each of the plies
+type ScoresheetCommand struct {
+	Diagrams []int
+}
+
+// OpeningsCommand represents a request for the openings played by a player (or the requesting user if PlayerID is empty)
+type OpeningsCommand struct {
+	PlayerID string
+}
+
+// ExploreCommand represents a position to explore, either as a FEN or as a line of moves from the starting position
+type ExploreCommand struct {
+	FEN   string
+	Moves string
+}
+
+// showAnnotationPattern matches each annotation list of a show command, such as "arrows e2e4,g1f3"
+var showAnnotationPattern = regexp.MustCompile(`(arrows|highlights|glyphs)\s+(\S+)`)
+
+// fenDefaults are used for the fields omitted from a FEN, such as when only piece placement is provided
+var fenDefaults = []string{"", "w", "-", "-", "0", "1"}
+
+var coachPattern = regexp.MustCompile(`\bcoach(ed)?\b`)
+
 // ToChallenge converts this command match to a proper challenge command
 func (c *CommandMatch) ToChallenge() (*ChallengeCommand, error) {
 	if c.Type != Challenge || len(c.Params) < 1 {
 		return nil, errors.New("match is not a valid challenge command")
 	}
-	return &ChallengeCommand{
+	command := &ChallengeCommand{
 		ChallengedID: c.Params[0],
-	}, nil
+	}
+	if len(c.Params) > 1 {
+		command.Coach = coachPattern.MatchString(c.Params[1])
+	}
+	return command, nil
 }
 
 // ToMove converts this command match to a proper move command
@@ -66,6 +164,206 @@ func (c *CommandMatch) ToMove() (*MoveCommand, error) {
 	}, nil
 }
 
+// ToExport converts this command match to a proper export command.
+// Dates are expected as YYYY-MM-DD and both ends of the date range are inclusive.
+func (c *CommandMatch) ToExport() (*ExportCommand, error) {
+	if c.Type != Export || len(c.Params) < 3 {
+		return nil, errors.New("match is not a valid export command")
+	}
+	command := &ExportCommand{
+		PlayerID: c.Params[0],
+	}
+	var err error
+	if c.Params[1] != "" {
+		if command.From, err = time.Parse("2006-01-02", c.Params[1]); err != nil {
+			return nil, err
+		}
+	}
+	if c.Params[2] != "" {
+		if command.To, err = time.Parse("2006-01-02", c.Params[2]); err != nil {
+			return nil, err
+		}
+		command.To = command.To.AddDate(0, 0, 1)
+	}
+	return command, nil
+}
+
+// ToAlias converts this command match to a proper alias command
+func (c *CommandMatch) ToAlias() (*AliasCommand, error) {
+	if c.Type != Alias || len(c.Params) < 2 || c.Params[0] == "" {
+		return nil, errors.New("match is not a valid alias command")
+	}
+	return &AliasCommand{
+		Name:     c.Params[0],
+		PlayerID: c.Params[1],
+	}, nil
+}
+
+// ToShow converts this command match to a proper show command.
+// A FEN may omit every field after the piece placement, in which case it is white to move.
+func (c *CommandMatch) ToShow() (*ShowCommand, error) {
+	if c.Type != Show || len(c.Params) < 2 || c.Params[0] == "" {
+		return nil, errors.New("match is not a valid show command")
+	}
+	command := &ShowCommand{}
+	if c.Params[0] != "game" {
+		command.FEN = completeFEN(c.Params[0])
+	}
+	lists := map[string]string{}
+	for _, result := range showAnnotationPattern.FindAllStringSubmatch(c.Params[1], -1) {
+		lists[result[1]] = result[2]
+	}
+	annotations, err := rendering.ParseAnnotations(lists["arrows"], lists["highlights"], lists["glyphs"])
+	if err != nil {
+		return nil, err
+	}
+	command.Annotations = annotations
+	return command, nil
+}
+
+// completeFEN fills in the fields omitted from a FEN with their defaults
+func completeFEN(fen string) string {
+	fields := strings.Fields(fen)
+	for i := len(fields); i < len(fenDefaults); i++ {
+		fields = append(fields, fenDefaults[i])
+	}
+	return strings.Join(fields, " ")
+}
+
+// ToSettings converts this command match to a proper settings command
+func (c *CommandMatch) ToSettings() (*SettingsCommand, error) {
+	if c.Type != Settings || len(c.Params) < 2 {
+		return nil, errors.New("match is not a valid settings command")
+	}
+	command := &SettingsCommand{
+		Setting: strings.ToLower(c.Params[0]),
+		Value:   c.Params[1],
+	}
+	if command.Setting != "" && command.Setting != "reset" && command.Value == "" {
+		return nil, fmt.Errorf("a value is required to change the %v setting", command.Setting)
+	}
+	return command, nil
+}
+
+// ToPosition converts this command match to a proper position command
+func (c *CommandMatch) ToPosition() (*PositionCommand, error) {
+	if c.Type != Position || len(c.Params) < 1 {
+		return nil, errors.New("match is not a valid position command")
+	}
+	ply, err := strconv.Atoi(c.Params[0])
+	if err != nil {
+		return nil, err
+	}
+	return &PositionCommand{
+		Ply: ply,
+	}, nil
+}
+
+// ToScoresheet converts this command match to a proper scoresheet command
+func (c *CommandMatch) ToScoresheet() (*ScoresheetCommand, error) {
+	if c.Type != Scoresheet || len(c.Params) < 1 {
+		return nil, errors.New("match is not a valid scoresheet command")
+	}
+	command := &ScoresheetCommand{}
+	for _, value := range strings.Fields(c.Params[0]) {
+		ply, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, err
+		}
+		command.Diagrams = append(command.Diagrams, ply)
+	}
+	return command, nil
+}
+
+// ToOpenings converts this command match to a proper openings command
+func (c *CommandMatch) ToOpenings() (*OpeningsCommand, error) {
+	if c.Type != Openings || len(c.Params) < 1 {
+		return nil, errors.New("match is not a valid openings command")
+	}
+	return &OpeningsCommand{
+		PlayerID: c.Params[0],
+	}, nil
+}
+
+// ToExplore converts this command match to a proper explore command.
+// A position with ranks separated by slashes is a FEN, anything else is a line of moves (none being the starting position).
+func (c *CommandMatch) ToExplore() (*ExploreCommand, error) {
+	if c.Type != Explore || len(c.Params) < 1 {
+		return nil, errors.New("match is not a valid explore command")
+	}
+	position := strings.TrimSpace(c.Params[0])
+	if strings.Contains(position, "/") {
+		return &ExploreCommand{FEN: completeFEN(position)}, nil
+	}
+	return &ExploreCommand{Moves: position}, nil
+}
+
+// TablebaseCommand represents a request for the tablebase verdict of a game, along with whether the player agrees to adjudicate it
+type TablebaseCommand struct {
+	Adjudicate bool
+}
+
+// ToTablebase converts this command match to a proper tablebase command
+func (c *CommandMatch) ToTablebase() (*TablebaseCommand, error) {
+	if c.Type != Tablebase || len(c.Params) < 1 {
+		return nil, errors.New("match is not a valid tablebase command")
+	}
+	return &TablebaseCommand{
+		Adjudicate: c.Params[0] != "",
+	}, nil
+}
+
+// DailyPuzzleCommand represents where the team's puzzle of the day is posted. Stop ends posting it instead.
+type DailyPuzzleCommand struct {
+	Stop bool
+}
+
+// ToDailyPuzzle converts this command match to a proper puzzle of the day command
+func (c *CommandMatch) ToDailyPuzzle() (*DailyPuzzleCommand, error) {
+	if c.Type != DailyPuzzle || len(c.Params) < 1 {
+		return nil, errors.New("match is not a valid puzzle of the day command")
+	}
+	return &DailyPuzzleCommand{
+		Stop: c.Params[0] == "off",
+	}, nil
+}
+
+// AnnotateCommand represents a comment and/or NAG to annotate a ply of the game with (0 being the last move)
+type AnnotateCommand struct {
+	Ply     int
+	NAG     int
+	Comment string
+}
+
+// ToAnnotate converts this command match to a proper annotate command.
+// Slack escapes &, < and > in messages, so they are unescaped in the comment.
+func (c *CommandMatch) ToAnnotate() (*AnnotateCommand, error) {
+	if c.Type != Annotate || len(c.Params) < 3 {
+		return nil, errors.New("match is not a valid annotate command")
+	}
+	command := &AnnotateCommand{
+		Comment: strings.TrimSpace(html.UnescapeString(c.Params[2])),
+	}
+	if c.Params[0] != "" {
+		ply, err := strconv.Atoi(c.Params[0])
+		if err != nil || ply < 1 {
+			return nil, fmt.Errorf("invalid ply %v", c.Params[0])
+		}
+		command.Ply = ply
+	}
+	if c.Params[1] != "" {
+		nag, ok := game.ParseNAG(c.Params[1])
+		if !ok {
+			return nil, fmt.Errorf("invalid NAG %v", c.Params[1])
+		}
+		command.NAG = nag
+	}
+	if command.NAG == 0 && command.Comment == "" {
+		return nil, errors.New("a glyph or comment is required to annotate a move")
+	}
+	return command, nil
+}
+
 // CommandParser will parse and return a CommandMatch.
 type CommandParser struct {
 	patterns []CommandPattern
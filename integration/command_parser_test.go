@@ -4,6 +4,7 @@ import (
 	"reflect"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/cjsaylor/chessbot/integration"
 )
@@ -52,3 +53,282 @@ func TestParse(t *testing.T) {
 	}
 
 }
+
+func TestToExport(t *testing.T) {
+	match := integration.CommandMatch{
+		Type:   integration.Export,
+		Params: []string{"U29109", "2019-01-01", "2019-01-31"},
+	}
+	command, err := match.ToExport()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if command.PlayerID != "U29109" {
+		t.Errorf("expected player U29109, got %v", command.PlayerID)
+	}
+	if expected := time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC); !command.From.Equal(expected) {
+		t.Errorf("expected from %v, got %v", expected, command.From)
+	}
+	if expected := time.Date(2019, time.February, 1, 0, 0, 0, 0, time.UTC); !command.To.Equal(expected) {
+		t.Errorf("expected the inclusive end date to become %v, got %v", expected, command.To)
+	}
+
+	match.Params = []string{"", "", ""}
+	if command, err = match.ToExport(); err != nil || !command.From.IsZero() || !command.To.IsZero() {
+		t.Errorf("expected an unfiltered export command, got %v (%v)", command, err)
+	}
+
+	match.Params = []string{"", "01/01/2019", ""}
+	if _, err := match.ToExport(); err == nil {
+		t.Error("expected an error for an invalid date")
+	}
+}
+
+func TestToAlias(t *testing.T) {
+	match := integration.CommandMatch{
+		Type:   integration.Alias,
+		Params: []string{"Smith, John", "U29109"},
+	}
+	command, err := match.ToAlias()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if command.Name != "Smith, John" || command.PlayerID != "U29109" {
+		t.Errorf("unexpected alias command %v", command)
+	}
+	match.Type = integration.Export
+	if _, err := match.ToAlias(); err == nil {
+		t.Error("expected an error converting a non alias command")
+	}
+}
+
+func TestToShow(t *testing.T) {
+	match := integration.CommandMatch{
+		Type:   integration.Show,
+		Params: []string{"rnbqkbnr/pppppppp/8/8/4P3/8/PPPP1PPP/RNBQKBNR b", " arrows e7e5,g8f6:red glyphs e4:!!"},
+	}
+	command, err := match.ToShow()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if command.FEN != "rnbqkbnr/pppppppp/8/8/4P3/8/PPPP1PPP/RNBQKBNR b - - 0 1" {
+		t.Errorf("expected omitted FEN fields to be defaulted, got %v", command.FEN)
+	}
+	if len(command.Annotations.Arrows) != 2 || command.Annotations.Arrows[1].Color != "red" {
+		t.Errorf("unexpected arrows %v", command.Annotations.Arrows)
+	}
+	if len(command.Annotations.Glyphs) != 1 || command.Annotations.Glyphs[0].Symbol != "!!" {
+		t.Errorf("unexpected glyphs %v", command.Annotations.Glyphs)
+	}
+	match.Params = []string{"game", " arrows e2e9"}
+	if _, err := match.ToShow(); err == nil {
+		t.Error("expected an error for an arrow off the board")
+	}
+}
+
+func TestToSettings(t *testing.T) {
+	match := integration.CommandMatch{
+		Type:   integration.Settings,
+		Params: []string{"Theme", "green"},
+	}
+	command, err := match.ToSettings()
+	if err != nil {
+		t.Fatal(err)
+	}
+	preferences, err := integration.DisplayPreferences{}.Apply(command)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if preferences.Theme != "green" {
+		t.Errorf("expected the green theme, got %v", preferences.Theme)
+	}
+	if _, err := preferences.Apply(&integration.SettingsCommand{Setting: "size", Value: "4096"}); err == nil {
+		t.Error("expected an error for a board size that is too large")
+	}
+	if _, err := preferences.Apply(&integration.SettingsCommand{Setting: "theme", Value: "neon"}); err == nil {
+		t.Error("expected an error for an unknown theme")
+	}
+	for _, command := range []integration.SettingsCommand{
+		{Setting: "text", Value: "ASCII"},
+		{Setting: "image", Value: "off"},
+		{Setting: "describe", Value: "on"},
+	} {
+		if preferences, err = preferences.Apply(&command); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if preferences.TextStyle != "ascii" || !preferences.HideImage || !preferences.Describe {
+		t.Errorf("expected text only boards with descriptions, got %v", preferences)
+	}
+	if _, err := preferences.Apply(&integration.SettingsCommand{Setting: "text", Value: "braille"}); err == nil {
+		t.Error("expected an error for an unknown text style")
+	}
+	if reset, _ := preferences.Apply(&integration.SettingsCommand{Setting: "reset"}); reset != (integration.DisplayPreferences{}) {
+		t.Errorf("expected preferences to be reset, got %v", reset)
+	}
+	match.Params = []string{"size", ""}
+	if _, err := match.ToSettings(); err == nil {
+		t.Error("expected an error changing a setting without a value")
+	}
+}
+
+func TestToPosition(t *testing.T) {
+	match := integration.CommandMatch{
+		Type:   integration.Position,
+		Params: []string{"23"},
+	}
+	command, err := match.ToPosition()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if command.Ply != 23 {
+		t.Errorf("expected ply 23, got %v", command.Ply)
+	}
+	match.Type = integration.Show
+	if _, err := match.ToPosition(); err == nil {
+		t.Error("expected an error converting a non position command")
+	}
+}
+
+func TestToScoresheet(t *testing.T) {
+	match := integration.CommandMatch{
+		Type:   integration.Scoresheet,
+		Params: []string{" 12 24"},
+	}
+	command, err := match.ToScoresheet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(command.Diagrams) != 2 || command.Diagrams[0] != 12 || command.Diagrams[1] != 24 {
+		t.Errorf("expected diagrams after plies 12 and 24, got %v", command.Diagrams)
+	}
+	match.Params = []string{""}
+	if command, _ := match.ToScoresheet(); len(command.Diagrams) != 0 {
+		t.Errorf("expected no diagrams, got %v", command.Diagrams)
+	}
+}
+
+func TestToOpenings(t *testing.T) {
+	match := integration.CommandMatch{
+		Type:   integration.Openings,
+		Params: []string{"U29109"},
+	}
+	command, err := match.ToOpenings()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if command.PlayerID != "U29109" {
+		t.Errorf("expected player U29109, got %v", command.PlayerID)
+	}
+	match.Type = integration.Export
+	if _, err := match.ToOpenings(); err == nil {
+		t.Error("expected an error converting a non openings command")
+	}
+}
+
+func TestToChallengeCoach(t *testing.T) {
+	match := integration.CommandMatch{
+		Type:   integration.Challenge,
+		Params: []string{"U29109", " coach"},
+	}
+	command, err := match.ToChallenge()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if command.ChallengedID != "U29109" || !command.Coach {
+		t.Errorf("expected a coached challenge of U29109, got %+v", *command)
+	}
+	match.Params = []string{"U29109", ""}
+	if command, _ := match.ToChallenge(); command.Coach {
+		t.Error("expected an uncoached challenge")
+	}
+}
+
+func TestToTablebase(t *testing.T) {
+	match := integration.CommandMatch{
+		Type:   integration.Tablebase,
+		Params: []string{"adjudicate"},
+	}
+	command, err := match.ToTablebase()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !command.Adjudicate {
+		t.Error("expected agreement to adjudicate")
+	}
+	match.Params = []string{""}
+	if command, _ := match.ToTablebase(); command.Adjudicate {
+		t.Error("expected a plain probe")
+	}
+	match.Type = integration.Export
+	if _, err := match.ToTablebase(); err == nil {
+		t.Error("expected an error converting a non tablebase command")
+	}
+}
+
+func TestToDailyPuzzle(t *testing.T) {
+	match := integration.CommandMatch{
+		Type:   integration.DailyPuzzle,
+		Params: []string{"here"},
+	}
+	command, err := match.ToDailyPuzzle()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if command.Stop {
+		t.Error("expected the puzzle of the day to be posted")
+	}
+	match.Params = []string{"off"}
+	if command, _ := match.ToDailyPuzzle(); !command.Stop {
+		t.Error("expected the puzzle of the day to stop")
+	}
+	match.Type = integration.Puzzle
+	if _, err := match.ToDailyPuzzle(); err == nil {
+		t.Error("expected an error converting a non puzzle of the day command")
+	}
+}
+
+func TestToExplore(t *testing.T) {
+	match := integration.CommandMatch{
+		Type:   integration.Explore,
+		Params: []string{" 1. e4 c5 2. Nf3"},
+	}
+	command, err := match.ToExplore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if command.Moves != "1. e4 c5 2. Nf3" || command.FEN != "" {
+		t.Errorf("expected a line of moves, got %v", command)
+	}
+	match.Params = []string{" rnbqkbnr/pppppppp/8/8/4P3/8/PPPP1PPP/RNBQKBNR b"}
+	if command, _ = match.ToExplore(); command.FEN != "rnbqkbnr/pppppppp/8/8/4P3/8/PPPP1PPP/RNBQKBNR b - - 0 1" {
+		t.Errorf("expected omitted FEN fields to be defaulted, got %v", command.FEN)
+	}
+}
+
+func TestToAnnotate(t *testing.T) {
+	match := integration.CommandMatch{
+		Type:   integration.Annotate,
+		Params: []string{"12", "??", " missed Nf5 &amp; the attack"},
+	}
+	command, err := match.ToAnnotate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := integration.AnnotateCommand{Ply: 12, NAG: 4, Comment: "missed Nf5 & the attack"}
+	if *command != expected {
+		t.Errorf("expected %v, got %v", expected, *command)
+	}
+	match.Params = []string{"", "$14", ""}
+	if command, err := match.ToAnnotate(); err != nil || command.Ply != 0 || command.NAG != 14 {
+		t.Errorf("expected a NAG on the last move, got %v (%v)", command, err)
+	}
+	match.Params = []string{"", "", " "}
+	if _, err := match.ToAnnotate(); err == nil {
+		t.Error("expected an error annotating without a glyph or comment")
+	}
+	match.Type = integration.Show
+	if _, err := match.ToAnnotate(); err == nil {
+		t.Error("expected an error converting a non annotate command")
+	}
+}
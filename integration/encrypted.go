@@ -0,0 +1,75 @@
+package integration
+
+import (
+	"errors"
+	"fmt"
+)
+
+// EncryptedAuthStore wraps an AuthStorage so that oauth tokens are encrypted before they are persisted
+type EncryptedAuthStore struct {
+	store  AuthStorage
+	cipher *TokenCipher
+}
+
+// NewEncryptedAuthStore returns an AuthStorage that encrypts tokens with the provided cipher
+func NewEncryptedAuthStore(store AuthStorage, cipher *TokenCipher) *EncryptedAuthStore {
+	return &EncryptedAuthStore{
+		store:  store,
+		cipher: cipher,
+	}
+}
+
+// StoreAuthToken encrypts and stores the oauth token for a given team ID
+func (e *EncryptedAuthStore) StoreAuthToken(teamID string, oauthToken string) error {
+	encrypted, err := e.cipher.Encrypt(teamID, oauthToken)
+	if err != nil {
+		return err
+	}
+	return e.store.StoreAuthToken(teamID, encrypted)
+}
+
+// GetAuthToken retrieves and decrypts the oauth token for a given team ID
+func (e *EncryptedAuthStore) GetAuthToken(teamID string) (string, error) {
+	stored, err := e.store.GetAuthToken(teamID)
+	if err != nil {
+		return "", err
+	}
+	return e.cipher.Decrypt(teamID, stored)
+}
+
+// AuthTeamIDs lists the team IDs of the underlying storage
+func (e *EncryptedAuthStore) AuthTeamIDs() ([]string, error) {
+	lister, ok := e.store.(AuthTeamLister)
+	if !ok {
+		return nil, errors.New("auth storage does not support listing teams")
+	}
+	return lister.AuthTeamIDs()
+}
+
+// RotateKeys re-encrypts every token that is not sealed with the current key, including legacy plaintext tokens.
+// It returns the number of tokens that were re-encrypted.
+func (e *EncryptedAuthStore) RotateKeys() (int, error) {
+	teamIDs, err := e.AuthTeamIDs()
+	if err != nil {
+		return 0, err
+	}
+	rotated := 0
+	for _, teamID := range teamIDs {
+		stored, err := e.store.GetAuthToken(teamID)
+		if err != nil {
+			return rotated, err
+		}
+		if e.cipher.IsCurrent(stored) {
+			continue
+		}
+		token, err := e.cipher.Decrypt(teamID, stored)
+		if err != nil {
+			return rotated, fmt.Errorf("unable to decrypt token for team %v: %v", teamID, err)
+		}
+		if err := e.StoreAuthToken(teamID, token); err != nil {
+			return rotated, err
+		}
+		rotated++
+	}
+	return rotated, nil
+}
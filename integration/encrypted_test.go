@@ -0,0 +1,145 @@
+package integration_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cjsaylor/chessbot/integration"
+	"github.com/cjsaylor/chessbot/storagetest"
+	"github.com/cjsaylor/chessbot/storagetest/integrationtest"
+)
+
+const (
+	oldTokenKey = "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="
+	newTokenKey = "ZmVkY2JhOTg3NjU0MzIxMGZlZGNiYTk4NzY1NDMyMTA="
+)
+
+func TestEncryptedStoreConformance(t *testing.T) {
+	tokenCipher, err := integration.NewTokenCipher(newTokenKey, oldTokenKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	storagetest.RunStores(t, storagetest.Stores{
+		Sqlite: func(path string) (interface{}, error) {
+			store, err := integration.NewSqliteStore(path)
+			if err != nil {
+				return nil, err
+			}
+			return integration.NewEncryptedAuthStore(store, tokenCipher), nil
+		},
+	}, func(t *testing.T, open storagetest.Opener) {
+		integrationtest.Run(t, func(t *testing.T) integrationtest.Backend {
+			return integrationtest.Backend{
+				AuthStorage: open(t).(integration.AuthStorage),
+			}
+		})
+	})
+}
+
+func TestTokenCipherRejectsInvalidKeys(t *testing.T) {
+	for _, key := range []string{"not base64!", "c2hvcnQ="} {
+		if _, err := integration.NewTokenCipher(key); err == nil {
+			t.Errorf("expected key %v to be rejected", key)
+		}
+	}
+}
+
+func TestTokenCipherRoundTrip(t *testing.T) {
+	tokenCipher, _ := integration.NewTokenCipher(newTokenKey)
+	encrypted, err := tokenCipher.Encrypt("team", "xoxb-secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(encrypted, "xoxb-secret") {
+		t.Error("expected the token not to appear in the encrypted value")
+	}
+	if !tokenCipher.IsCurrent(encrypted) {
+		t.Error("expected the token to be encrypted with the current key")
+	}
+	token, err := tokenCipher.Decrypt("team", encrypted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "xoxb-secret" {
+		t.Errorf("expected xoxb-secret, got %v", token)
+	}
+}
+
+func TestTokenCipherRejectsTampering(t *testing.T) {
+	tokenCipher, _ := integration.NewTokenCipher(newTokenKey)
+	encrypted, _ := tokenCipher.Encrypt("team", "xoxb-secret")
+	tampered := encrypted[:len(encrypted)-4] + "AAA="
+	if _, err := tokenCipher.Decrypt("team", tampered); err == nil {
+		t.Error("expected a tampered token to fail decryption")
+	}
+}
+
+func TestTokenCipherRejectsOtherTeams(t *testing.T) {
+	memory := integration.NewMemoryStore()
+	tokenCipher, _ := integration.NewTokenCipher(newTokenKey)
+	store := integration.NewEncryptedAuthStore(memory, tokenCipher)
+	store.StoreAuthToken("team", "xoxb-secret")
+	raw, _ := memory.GetAuthToken("team")
+	memory.StoreAuthToken("other", raw)
+	if _, err := store.GetAuthToken("other"); err == nil {
+		t.Error("expected a token copied to another team to fail decryption")
+	}
+}
+
+func TestTokenCipherUnknownKey(t *testing.T) {
+	oldCipher, _ := integration.NewTokenCipher(oldTokenKey)
+	newCipher, _ := integration.NewTokenCipher(newTokenKey)
+	encrypted, _ := oldCipher.Encrypt("team", "xoxb-secret")
+	if _, err := newCipher.Decrypt("team", encrypted); err != integration.ErrUnknownTokenKey {
+		t.Errorf("expected ErrUnknownTokenKey, got %v", err)
+	}
+}
+
+func TestEncryptedStoreDoesNotPersistPlaintext(t *testing.T) {
+	memory := integration.NewMemoryStore()
+	tokenCipher, _ := integration.NewTokenCipher(newTokenKey)
+	store := integration.NewEncryptedAuthStore(memory, tokenCipher)
+	store.StoreAuthToken("team", "xoxb-secret")
+	raw, _ := memory.GetAuthToken("team")
+	if raw == "xoxb-secret" {
+		t.Error("expected the persisted token to be encrypted")
+	}
+}
+
+func TestEncryptedStoreRotateKeys(t *testing.T) {
+	memory := integration.NewMemoryStore()
+	oldCipher, _ := integration.NewTokenCipher(oldTokenKey)
+	integration.NewEncryptedAuthStore(memory, oldCipher).StoreAuthToken("encrypted", "xoxb-encrypted")
+	memory.StoreAuthToken("plaintext", "xoxb-plaintext")
+
+	newCipher, _ := integration.NewTokenCipher(newTokenKey, oldTokenKey)
+	store := integration.NewEncryptedAuthStore(memory, newCipher)
+	rotated, err := store.RotateKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rotated != 2 {
+		t.Errorf("expected 2 tokens to be rotated, got %v", rotated)
+	}
+	if rotated, _ = store.RotateKeys(); rotated != 0 {
+		t.Errorf("expected rotation to be a no-op once complete, rotated %v", rotated)
+	}
+
+	rotatedOnly, _ := integration.NewTokenCipher(newTokenKey)
+	for teamID, expected := range map[string]string{
+		"encrypted": "xoxb-encrypted",
+		"plaintext": "xoxb-plaintext",
+	} {
+		raw, _ := memory.GetAuthToken(teamID)
+		if !rotatedOnly.IsCurrent(raw) {
+			t.Errorf("expected the %v token to be encrypted with the new key", teamID)
+		}
+		token, err := integration.NewEncryptedAuthStore(memory, rotatedOnly).GetAuthToken(teamID)
+		if err != nil {
+			t.Error(err)
+		}
+		if token != expected {
+			t.Errorf("expected %v, got %v", expected, token)
+		}
+	}
+}
@@ -4,13 +4,27 @@ package integration
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"log"
 	"net/http"
+	"net/url"
 	"regexp"
+	"strings"
 
+	"github.com/cjsaylor/chessbot/analysis"
+	"github.com/cjsaylor/chessbot/archive"
+	"github.com/cjsaylor/chessbot/engine"
+	"github.com/cjsaylor/chessbot/explorer"
 	"github.com/cjsaylor/chessbot/game"
+	"github.com/cjsaylor/chessbot/importer"
+	"github.com/cjsaylor/chessbot/openings"
+	"github.com/cjsaylor/chessbot/puzzles"
 	"github.com/cjsaylor/chessbot/rendering"
+	"github.com/cjsaylor/chessbot/sandbox"
+	"github.com/cjsaylor/chessbot/tablebase"
 	"github.com/nlopes/slack"
 	"github.com/nlopes/slack/slackevents"
 	"github.com/notnil/chess"
@@ -18,25 +32,96 @@ import (
 
 // SlackHandler will respond to all Slack event callback subscriptions
 type SlackHandler struct {
-	SigningKey       string
-	Hostname         string
-	SlackClient      *slack.Client
-	AuthStorage      AuthStorage
-	GameStorage      game.GameStorage
-	ChallengeStorage game.ChallengeStorage
-	TakebackStorage  game.TakebackStorage
-	LinkRenderer     rendering.RenderLink
+	SigningKey         string
+	Hostname           string
+	SlackClient        *slack.Client
+	AuthStorage        AuthStorage
+	GameStorage        game.GameStorage
+	ChallengeStorage   game.ChallengeStorage
+	TakebackStorage    game.TakebackStorage
+	ArchiveStorage     game.ArchiveStorage
+	PlayerAliasStorage game.PlayerAliasStorage
+	PreferenceStorage  PreferenceStorage
+	LinkRenderer       rendering.RenderLink
+	ExportLink         archive.ExportLink
+	ReplayLink         rendering.ReplayLink
+	PositionLink       rendering.PositionLink
+	ScoresheetLink     rendering.ScoresheetLink
+	Explorer           *explorer.Index
+	ExploreLink        explorer.ExploreLink
+	PuzzleStorage      puzzles.PuzzleStorage
+	PuzzleGenerator    *puzzles.Generator
+	Reviewer           analysis.Reviewer
+	AnalysisProviders  []string
+	Tablebase          tablebase.Prober
+	Coach              engine.Evaluator
+	BoardStorage       sandbox.BoardStorage
+	teamID             string
 }
 
+// maxImportSize is the largest file (in bytes) accepted for import
+const maxImportSize = 10 << 20
+
+// errFileTooLarge is returned when a file shared with the bot for import is larger than maxImportSize
+var errFileTooLarge = errors.New("file too large")
+
 const requestVersion = "v0"
 
 type command uint8
 
 // SlackCommandPatterns is a list of patterns specific to how text is transmitted in the Slack platform.
 var slackCommandPatterns = []CommandPattern{
+	{
+		Type:    Annotate,
+		Pattern: regexp.MustCompile("(?s)^<@[\\w|\\d]+>\\s+annotate\\b(?:\\s+(\\d+)\\b)?(?:\\s+(!!|!\\?|\\?!|\\?\\?|!|\\?|\\$\\d+)(?:\\s|$))?(.*)$"),
+	},
 	{
 		Type:    Challenge,
-		Pattern: regexp.MustCompile("^<@[\\w|\\d]+>.*challenge.*?<@([\\w\\d]+)>.*$"),
+		Pattern: regexp.MustCompile("^<@[\\w|\\d]+>.*challenge.*?<@([\\w\\d]+)>(.*)$"),
+	},
+	{
+		Type:    Show,
+		Pattern: regexp.MustCompile("^<@[\\w|\\d]+>.*?\\bshow\\s+(game|[pnbrqkPNBRQK1-8]+(?:/[pnbrqkPNBRQK1-8]+){7}(?:\\s+[wb](?:\\s+(?:[KQkq]+|-))?(?:\\s+(?:[a-h][36]|-))?(?:\\s+\\d+)?(?:\\s+\\d+)?)?)(.*)$"),
+	},
+	{
+		Type:    Settings,
+		Pattern: regexp.MustCompile("^<@[\\w|\\d]+>.*?\\bsettings?(?:\\s+(theme|pieces|size|coordinates|frame|text|image|describe|reset)(?:\\s+(\\S+))?)?.*$"),
+	},
+	{
+		Type:    Position,
+		Pattern: regexp.MustCompile("^<@[\\w|\\d]+>.*?\\bposition\\s+(\\d+)\\b.*$"),
+	},
+	{
+		Type:    Scoresheet,
+		Pattern: regexp.MustCompile("^<@[\\w|\\d]+>.*?\\bscoresheet((?:\\s+\\d+)*).*$"),
+	},
+	{
+		Type:    Openings,
+		Pattern: regexp.MustCompile("^<@[\\w|\\d]+>.*?\\bopenings(?:\\s+<@([\\w\\d]+)>)?.*$"),
+	},
+	{
+		Type:    Explore,
+		Pattern: regexp.MustCompile("^<@[\\w|\\d]+>.*?\\bexplore\\b(.*)$"),
+	},
+	{
+		Type:    DailyPuzzle,
+		Pattern: regexp.MustCompile("^<@[\\w|\\d]+>.*?\\bpuzzle\\s+of\\s+the\\s+day\\s+(here|off)\\b.*$"),
+	},
+	{
+		Type:    Puzzle,
+		Pattern: regexp.MustCompile("^<@[\\w|\\d]+>.*?\\bpuzzle\\b.*$"),
+	},
+	{
+		Type:    Analyze,
+		Pattern: regexp.MustCompile("^<@[\\w|\\d]+>.*?\\banalyze\\s+here\\b.*$"),
+	},
+	{
+		Type:    Hint,
+		Pattern: regexp.MustCompile("^<@[\\w|\\d]+>.*?\\bhint\\b.*$"),
+	},
+	{
+		Type:    Tablebase,
+		Pattern: regexp.MustCompile("^<@[\\w|\\d]+>.*?\\btablebase\\b(?:\\s+(adjudicate))?.*$"),
 	},
 	{
 		Type:    Move,
@@ -50,6 +135,18 @@ var slackCommandPatterns = []CommandPattern{
 		Type:    Takeback,
 		Pattern: regexp.MustCompile("^<@[\\w|\\d]+>.*take\\s?back.*$"),
 	},
+	{
+		Type:    Export,
+		Pattern: regexp.MustCompile("^<@[\\w|\\d]+>.*export(?:\\s+<@([\\w\\d]+)>)?(?:\\s+(\\d{4}-\\d{2}-\\d{2}))?(?:\\s+(\\d{4}-\\d{2}-\\d{2}))?.*$"),
+	},
+	{
+		Type:    Alias,
+		Pattern: regexp.MustCompile("^<@[\\w|\\d]+>.*alias\\s+\"?(.+?)\"?\\s+<@([\\w\\d]+)>.*$"),
+	},
+	{
+		Type:    Import,
+		Pattern: regexp.MustCompile("^(?:<@[\\w|\\d]+>\\s+)?import\\b.*$"),
+	},
 	{
 		Type:    Help,
 		Pattern: regexp.MustCompile(".*help.*"),
@@ -101,6 +198,7 @@ func (s SlackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "text")
 		w.Write([]byte(r.Challenge))
 	} else if event.Type == slackevents.CallbackEvent {
+		s.teamID = event.TeamID
 		if s.SlackClient == nil {
 			botToken, err := s.AuthStorage.GetAuthToken(event.TeamID)
 			if err != nil {
@@ -118,12 +216,28 @@ func (s SlackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					slack.MsgOptionText("You can use ChessBot to play Chess with other teammates.", false),
 					slack.MsgOptionAttachments(getHelpAttachments()...))
 			}
+			if ev.ChannelType == "im" && ev.BotID == "" && matched.Type == Import && len(ev.Files) > 0 {
+				s.handleImportUpload(ev)
+			}
 		case *slackevents.AppMentionEvent:
 			var gameID string
 			if ev.ThreadTimeStamp == "" {
 				gameID = ev.TimeStamp
 			} else {
 				gameID = ev.ThreadTimeStamp
+				if s.PuzzleStorage != nil {
+					if daily, err := s.PuzzleStorage.RetrieveDailyPuzzle(s.teamID, ev.ThreadTimeStamp); err == nil && s.handlePuzzleAnswer(daily, ev) {
+						return
+					}
+					if attempt, err := s.PuzzleStorage.RetrieveAttempt(s.teamID, ev.ThreadTimeStamp); err == nil && s.handlePuzzleMove(attempt, ev) {
+						return
+					}
+				}
+				if s.BoardStorage != nil {
+					if board, err := s.BoardStorage.RetrieveBoard(s.teamID, ev.ThreadTimeStamp); err == nil && s.handleBoardMention(board, ev) {
+						return
+					}
+				}
 			}
 			matched := slackCommandParser.ParseInput(ev.Text)
 			switch matched.Type {
@@ -139,6 +253,63 @@ func (s SlackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				s.handleResignCommand(gameID, ev)
 			case Takeback:
 				s.handleTakebackCommand(gameID, ev)
+			case Export:
+				exportCommand, err := matched.ToExport()
+				if err != nil {
+					s.sendError(gameID, ev.Channel, "Dates should be formatted as YYYY-MM-DD.")
+					return
+				}
+				s.handleExportCommand(exportCommand, ev)
+			case Alias:
+				aliasCommand, _ := matched.ToAlias()
+				s.handleAliasCommand(gameID, aliasCommand, ev)
+			case Show:
+				showCommand, err := matched.ToShow()
+				if err != nil {
+					s.sendError(gameID, ev.Channel, fmt.Sprintf("Unable to show that: %v.", err))
+					return
+				}
+				s.handleShowCommand(gameID, showCommand, ev)
+			case Settings:
+				settingsCommand, err := matched.ToSettings()
+				if err != nil {
+					s.sendError(gameID, ev.Channel, fmt.Sprintf("Unable to change that setting: %v.", err))
+					return
+				}
+				s.handleSettingsCommand(gameID, settingsCommand, ev)
+			case Position:
+				positionCommand, _ := matched.ToPosition()
+				s.handlePositionCommand(gameID, positionCommand, ev)
+			case Scoresheet:
+				scoresheetCommand, _ := matched.ToScoresheet()
+				s.handleScoresheetCommand(gameID, scoresheetCommand, ev)
+			case Openings:
+				openingsCommand, _ := matched.ToOpenings()
+				s.handleOpeningsCommand(openingsCommand, ev)
+			case Explore:
+				exploreCommand, _ := matched.ToExplore()
+				s.handleExploreCommand(gameID, exploreCommand, ev)
+			case Puzzle:
+				s.handlePuzzleCommand(gameID, ev)
+			case DailyPuzzle:
+				dailyPuzzleCommand, _ := matched.ToDailyPuzzle()
+				s.handleDailyPuzzleCommand(gameID, dailyPuzzleCommand, ev)
+			case Analyze:
+				s.handleAnalyzeCommand(gameID, ev)
+			case Hint:
+				s.handleHintCommand(gameID, ev)
+			case Annotate:
+				annotateCommand, err := matched.ToAnnotate()
+				if err != nil {
+					s.sendError(gameID, ev.Channel, fmt.Sprintf("Unable to annotate that: %v.", err))
+					return
+				}
+				s.handleAnnotateCommand(gameID, annotateCommand, ev)
+			case Tablebase:
+				tablebaseCommand, _ := matched.ToTablebase()
+				s.handleTablebaseCommand(gameID, tablebaseCommand, ev)
+			case Import:
+				s.sendError(gameID, ev.Channel, "To import games, send me a PGN file in a direct message with the comment \"import\".")
 			case Help:
 				s.handleHelpCommand(gameID, ev)
 			}
@@ -166,40 +337,96 @@ func (s SlackHandler) handleMoveCommand(gameID string, moveCommand *MoveCommand,
 		s.sendError(gameID, ev.Channel, err.Error())
 		return
 	}
-	link, _ := s.LinkRenderer.CreateLink(gm)
-	boardAttachment := slack.Attachment{
-		Text:     chessMove.String(),
-		ImageURL: link.String(),
-		Color:    colorToHex[gm.Turn()],
-	}
+	boardAttachment := s.preferencesFor(gm.TurnPlayer().ID).gameBoardAttachment(slack.Attachment{
+		Text:  chessMove.String(),
+		Color: colorToHex[gm.Turn()],
+	}, s.LinkRenderer, s.SlackClient, gm, rendering.Annotations{})
 	if outcome := gm.Outcome(); outcome != chess.NoOutcome {
 		s.displayEndGame(gm, ev)
 	} else {
 		s.SlackClient.PostMessage(
 			ev.Channel,
-			slack.MsgOptionText(fmt.Sprintf("<@%v>'s (%v) turn.", gm.TurnPlayer().ID, gm.Turn()), false),
+			slack.MsgOptionText(turnText(gm), false),
 			slack.MsgOptionAttachments(boardAttachment),
 			slack.MsgOptionTS(ev.TimeStamp))
 	}
 }
 
+// turnText announces the player to move next, along with the opening played so far
+func turnText(gm *game.Game) string {
+	text := fmt.Sprintf("<@%v>'s (%v) turn.", gm.TurnPlayer().ID, gm.Turn())
+	if opening, ok := gm.Opening(); ok {
+		text += fmt.Sprintf(" _%v (%v)_", opening.Name, opening.ECO)
+	}
+	return text
+}
+
 func (s SlackHandler) displayEndGame(gm *game.Game, ev *slackevents.AppMentionEvent) {
+	archived, err := gm.Archive(s.teamID)
+	if err != nil {
+		log.Println(err)
+	} else if err := s.ArchiveStorage.StoreArchivedGame(archived); err != nil {
+		log.Printf("Failed to archive game %v: %v\n", gm.ID, err)
+	} else if gm.Rated() {
+		// coached games stay out of the team's opening tree and puzzles
+		if s.Explorer != nil {
+			if err := s.Explorer.Add(archived); err != nil {
+				log.Printf("Failed to index game %v: %v\n", gm.ID, err)
+			}
+		}
+		if s.PuzzleGenerator != nil && s.PuzzleStorage != nil {
+			go s.generatePuzzles(archived)
+		}
+	}
+	if s.Reviewer != nil {
+		go s.postReview(gm, ev.Channel, ev.TimeStamp)
+	}
+	if s.coveredByTablebase(gm) {
+		go s.annotateTablebase(gm.ID, gm.FEN(), chessTurn(gm), ev.Channel, ev.TimeStamp)
+	}
 	pgnAttachment := slack.Attachment{
 		Title:     "Analysis",
 		TitleLink: s.Hostname + "/analyze?game_id=" + gm.ID,
 		Text:      gm.Export(),
 	}
-	link, _ := s.LinkRenderer.CreateLink(gm)
-	boardAttachment := slack.Attachment{
-		Text:     gm.LastMove().String(),
-		ImageURL: link.String(),
+	boardAttachment := s.preferencesFor(ev.User).gameBoardAttachment(slack.Attachment{
+		Text: gm.LastMove().String(),
+	}, s.LinkRenderer, s.SlackClient, gm, rendering.Annotations{})
+	attachments := []slack.Attachment{boardAttachment, pgnAttachment}
+	if len(s.AnalysisProviders) > 1 {
+		attachments = append(attachments, s.analysisProvidersAttachment(gm))
+	}
+	if scoresheetLink, err := s.ScoresheetLink.CreateLink(gm, "pdf", s.scoresheetOptions(gm)); err == nil {
+		attachments = append(attachments, slack.Attachment{
+			Title:     "Scoresheet (PDF)",
+			TitleLink: scoresheetLink.String(),
+		})
+	}
+	if replayLink, err := s.ReplayLink.CreateLink(gm); err == nil {
+		attachments = append(attachments, slack.Attachment{
+			Title:    "Replay",
+			ImageURL: replayLink.String(),
+		})
 	}
 	s.SlackClient.PostMessage(
 		ev.Channel,
 		slack.MsgOptionText(gm.ResultText(), false),
 		slack.MsgOptionTS(ev.TimeStamp),
-		slack.MsgOptionAttachments(boardAttachment, pgnAttachment))
-	// @todo persist record to some incremental storage (redis, etc)
+		slack.MsgOptionAttachments(attachments...))
+}
+
+// analysisProvidersAttachment links to an analysis of a game by each provider, for players who prefer one
+func (s SlackHandler) analysisProvidersAttachment(gm *game.Game) slack.Attachment {
+	links := make([]string, 0, len(s.AnalysisProviders))
+	for _, provider := range s.AnalysisProviders {
+		query := url.Values{}
+		query.Set("game_id", gm.ID)
+		query.Set("provider", provider)
+		links = append(links, fmt.Sprintf("<%v/analyze?%v|%v>", s.Hostname, query.Encode(), provider))
+	}
+	return slack.Attachment{
+		Text: "Analyze with " + strings.Join(links, " · "),
+	}
 }
 
 func (s SlackHandler) handleChallengeCommand(gameID string, command *ChallengeCommand, ev *slackevents.AppMentionEvent) {
@@ -222,11 +449,20 @@ func (s SlackHandler) handleChallengeCommand(gameID string, command *ChallengeCo
 		ChallengedID: command.ChallengedID,
 		GameID:       gameID,
 		ChannelID:    ev.Channel,
+		Coach:        command.Coach,
+	}
+	if challenge.Coach && s.Coach == nil {
+		s.sendError(gameID, ev.Channel, "Coached games need a chess engine, which isn't configured.")
+		return
 	}
 	s.ChallengeStorage.StoreChallenge(challenge)
+	invitation := fmt.Sprintf("<@%v> has challenged you to a game of chess!", ev.User)
+	if challenge.Coach {
+		invitation = fmt.Sprintf("<@%v> has challenged you to a coached game of chess! You can both ask me for hints, and the game is unrated.", ev.User)
+	}
 	s.SlackClient.PostMessage(
 		channel.ID,
-		slack.MsgOptionText(fmt.Sprintf("<@%v> has challenged you to a game of chess!", ev.User), false),
+		slack.MsgOptionText(invitation, false),
 		slack.MsgOptionAttachments(slack.Attachment{
 			Text:       "Do you accept?",
 			Fallback:   "Unable to accept the challenge.",
@@ -263,6 +499,10 @@ func (s SlackHandler) handleResignCommand(gameID string, ev *slackevents.AppMent
 		return
 	}
 	gm.Resign(*player)
+	if err := s.GameStorage.StoreGame(gameID, gm); err != nil {
+		s.sendError(gameID, ev.Channel, err.Error())
+		return
+	}
 	s.displayEndGame(gm, ev)
 }
 
@@ -314,14 +554,13 @@ func (s SlackHandler) handleTakebackCommand(gameID string, ev *slackevents.AppMe
 		s.sendError(gameID, ev.Channel, fmt.Sprintf("Take back request failed: %v", err))
 		return
 	}
-	link, _ := s.LinkRenderer.CreateLink(gm)
 	boardAttachment := slack.Attachment{
-		ImageURL: link.String(),
-		Color:    colorToHex[gm.Turn()],
+		Color: colorToHex[gm.Turn()],
 	}
 	if chessMove != nil {
 		boardAttachment.Text = chessMove.String()
 	}
+	boardAttachment = s.preferencesFor(gm.TurnPlayer().ID).gameBoardAttachment(boardAttachment, s.LinkRenderer, s.SlackClient, gm, rendering.Annotations{})
 	if err := s.GameStorage.StoreGame(gameID, gm); err != nil {
 		s.sendError(gameID, ev.Channel, err.Error())
 		return
@@ -333,6 +572,422 @@ func (s SlackHandler) handleTakebackCommand(gameID string, ev *slackevents.AppMe
 		slack.MsgOptionTS(ev.TimeStamp))
 }
 
+func (s SlackHandler) handleExportCommand(command *ExportCommand, ev *slackevents.AppMentionEvent) {
+	link, err := s.ExportLink.CreateLink(game.ArchiveFilter{
+		TeamID:   s.teamID,
+		PlayerID: command.PlayerID,
+		From:     command.From,
+		To:       command.To,
+	})
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	s.SlackClient.PostEphemeral(
+		ev.Channel,
+		ev.User,
+		slack.MsgOptionTS(ev.ThreadTimeStamp),
+		slack.MsgOptionAttachments(slack.Attachment{
+			Title:     "Completed games (PGN)",
+			TitleLink: link.String(),
+			Text:      "Open the export in any chess database software.",
+		}))
+}
+
+// maxOpeningRecords is the number of openings listed by the openings command
+const maxOpeningRecords = 10
+
+func (s SlackHandler) handleOpeningsCommand(command *OpeningsCommand, ev *slackevents.AppMentionEvent) {
+	playerID := command.PlayerID
+	if playerID == "" {
+		playerID = ev.User
+	}
+	repertoire := openings.NewRepertoire()
+	err := s.ArchiveStorage.RetrieveArchivedGames(game.ArchiveFilter{
+		TeamID:   s.teamID,
+		PlayerID: playerID,
+	}, func(archived *game.ArchivedGame) error {
+		opening, ok := openings.ClassifyPGN(game.StripNAGs(archived.PGN))
+		if !ok {
+			return nil
+		}
+		color := chess.Black
+		if archived.WhiteID == playerID {
+			color = chess.White
+		}
+		repertoire.Add(opening, color, archived.Result)
+		return nil
+	})
+	if err != nil {
+		log.Println(err)
+		s.sendError(ev.ThreadTimeStamp, ev.Channel, "Unable to retrieve completed games.")
+		return
+	}
+	records := repertoire.Records()
+	if len(records) == 0 {
+		s.sendError(ev.ThreadTimeStamp, ev.Channel, fmt.Sprintf("<@%v> has no completed games with a known opening yet.", playerID))
+		return
+	}
+	if len(records) > maxOpeningRecords {
+		records = records[:maxOpeningRecords]
+	}
+	lines := []string{}
+	for _, record := range records {
+		lines = append(lines, fmt.Sprintf(
+			"*%v* %v as %v: %v played, %v won, %v drawn, %v lost (%.0f%%)",
+			record.Opening.ECO, record.Opening.Name, record.Color.Name(),
+			record.Games, record.Wins, record.Draws, record.Losses, record.Score()*100,
+		))
+	}
+	s.SlackClient.PostEphemeral(
+		ev.Channel,
+		ev.User,
+		slack.MsgOptionTS(ev.ThreadTimeStamp),
+		slack.MsgOptionAttachments(slack.Attachment{
+			Title:      "Most played openings",
+			Text:       fmt.Sprintf("<@%v>\n%v", playerID, strings.Join(lines, "\n")),
+			MarkdownIn: []string{"text"},
+		}))
+}
+
+// maxExploredContinuations is the number of continuations listed by the explore command
+const maxExploredContinuations = 8
+
+func (s SlackHandler) handleExploreCommand(gameID string, command *ExploreCommand, ev *slackevents.AppMentionEvent) {
+	if s.Explorer == nil {
+		s.sendError(gameID, ev.Channel, "The opening explorer is not available.")
+		return
+	}
+	var position *chess.Position
+	var link *url.URL
+	var err error
+	if command.FEN != "" {
+		if position, err = explorer.PositionFromFEN(command.FEN); err != nil {
+			s.sendError(gameID, ev.Channel, "That doesn't look like a valid FEN.")
+			return
+		}
+		link, err = s.ExploreLink.CreateFENLink(s.teamID, command.FEN)
+	} else {
+		var positions []*chess.Position
+		if positions, err = explorer.PositionsFromMoves(command.Moves); err != nil {
+			s.sendError(gameID, ev.Channel, fmt.Sprintf("Unable to play the moves: %v", err))
+			return
+		}
+		position = positions[len(positions)-1]
+		link, err = s.ExploreLink.CreateLink(s.teamID, command.Moves)
+	}
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	continuations := s.Explorer.Continuations(s.teamID, position)
+	preferences := s.preferencesFor(ev.User)
+	boardLink, err := s.LinkRenderer.WithOptions(preferences.RenderOptions()).CreatePositionLink(position.String(), explorer.ContinuationArrows(position, continuations))
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	lines := []string{}
+	for i, continuation := range continuations {
+		if i == maxExploredContinuations {
+			break
+		}
+		lines = append(lines, fmt.Sprintf(
+			"*%v* %v games: White %.0f%%, draw %.0f%%, Black %.0f%%",
+			continuation.Move, continuation.Games,
+			continuation.WhitePercent(), continuation.DrawPercent(), continuation.BlackPercent(),
+		))
+	}
+	if len(lines) == 0 {
+		lines = append(lines, "No games of the team reached this position.")
+	}
+	board, _ := rendering.NewTextRendererFromFEN(position.String())
+	s.SlackClient.PostMessage(
+		ev.Channel,
+		slack.MsgOptionTS(gameID),
+		slack.MsgOptionAttachments(preferences.boardAttachment(slack.Attachment{
+			Title:      "Opening explorer",
+			TitleLink:  link.String(),
+			Text:       strings.Join(lines, "\n"),
+			MarkdownIn: []string{"text"},
+		}, boardLink, board, position.Turn() == chess.Black)))
+}
+
+func (s SlackHandler) handleAliasCommand(gameID string, command *AliasCommand, ev *slackevents.AppMentionEvent) {
+	imp := importer.NewImporter(s.PlayerAliasStorage, s.ArchiveStorage)
+	if !s.isWorkspaceAdmin(ev.User) {
+		if command.PlayerID != ev.User {
+			s.sendError(gameID, ev.Channel, "You can only map player names to yourself. Ask a workspace admin to map names for others.")
+			return
+		}
+		if mapped, err := imp.MappedPlayer(s.teamID, command.Name); err == nil && mapped != ev.User {
+			s.sendError(gameID, ev.Channel, fmt.Sprintf("\"%v\" is already mapped to <@%v>. Ask a workspace admin to change it.", command.Name, mapped))
+			return
+		}
+	}
+	if err := imp.MapPlayer(s.teamID, command.Name, command.PlayerID); err != nil {
+		log.Println(err)
+		s.sendError(gameID, ev.Channel, "Unable to save the player alias.")
+		return
+	}
+	s.sendError(gameID, ev.Channel, fmt.Sprintf("Imported games played by \"%v\" will be recorded for <@%v>.", command.Name, command.PlayerID))
+}
+
+// isWorkspaceAdmin determines whether a user is an admin or owner of the workspace
+func (s SlackHandler) isWorkspaceAdmin(userID string) bool {
+	if s.SlackClient == nil {
+		return false
+	}
+	user, err := s.SlackClient.GetUserInfo(userID)
+	if err != nil {
+		log.Println(err)
+		return false
+	}
+	return user.IsAdmin || user.IsOwner
+}
+
+func (s SlackHandler) handleShowCommand(gameID string, command *ShowCommand, ev *slackevents.AppMentionEvent) {
+	preferences := s.preferencesFor(ev.User)
+	boardAttachment := slack.Attachment{
+		Fallback: "Annotated board",
+	}
+	if command.FEN == "" {
+		gm, err := s.GameStorage.RetrieveGame(gameID)
+		if err != nil {
+			s.sendError(gameID, ev.Channel, "There is no game in this thread to show.")
+			return
+		}
+		boardAttachment = preferences.gameBoardAttachment(boardAttachment, s.LinkRenderer, s.SlackClient, gm, command.Annotations)
+	} else {
+		link, err := s.LinkRenderer.WithOptions(preferences.RenderOptions()).CreatePositionLink(command.FEN, command.Annotations)
+		if err != nil {
+			s.sendError(gameID, ev.Channel, "That doesn't look like a valid FEN.")
+			return
+		}
+		board, _ := rendering.NewTextRendererFromFEN(command.FEN)
+		boardAttachment = preferences.boardAttachment(boardAttachment, link, board, link.Query().Get("inverted") == "true")
+	}
+	s.SlackClient.PostMessage(
+		ev.Channel,
+		slack.MsgOptionTS(gameID),
+		slack.MsgOptionAttachments(boardAttachment))
+}
+
+func (s SlackHandler) handlePositionCommand(gameID string, command *PositionCommand, ev *slackevents.AppMentionEvent) {
+	gm, err := s.GameStorage.RetrieveGame(gameID)
+	if err != nil {
+		s.sendError(gameID, ev.Channel, "There is no game in this thread to show.")
+		return
+	}
+	plies := gm.Plies()
+	if command.Ply > len(plies) {
+		s.sendError(gameID, ev.Channel, fmt.Sprintf("This game only has %v plies.", len(plies)))
+		return
+	}
+	preferences := s.preferencesFor(ev.User)
+	link, err := s.PositionLink.WithOptions(preferences.RenderOptions()).CreateLink(gm, command.Ply)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	fen, text := gm.StartingFEN(), "Starting position"
+	var played game.Ply
+	if command.Ply > 0 {
+		played = plies[command.Ply-1]
+		fen = played.FEN
+		separator := "."
+		if command.Ply%2 == 0 {
+			separator = "..."
+		}
+		text = fmt.Sprintf("Position after %v%v %v%v", (command.Ply+1)/2, separator, played.Move, played.Annotation.Glyph())
+		if played.Annotation.Comment != "" {
+			text += ": " + played.Annotation.Comment
+		}
+	}
+	board, err := rendering.NewTextRendererFromFEN(fen)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	if played.Move != nil {
+		board.SetLastMove(played.Move.S1(), played.Move.S2())
+		board.SetCheckTile(played.CheckedKing)
+	}
+	s.SlackClient.PostMessage(
+		ev.Channel,
+		slack.MsgOptionTS(gameID),
+		slack.MsgOptionAttachments(preferences.boardAttachment(slack.Attachment{
+			Text: text,
+		}, link, board, strings.Fields(fen)[1] == "b")))
+}
+
+func (s SlackHandler) handleScoresheetCommand(gameID string, command *ScoresheetCommand, ev *slackevents.AppMentionEvent) {
+	gm, err := s.GameStorage.RetrieveGame(gameID)
+	if err != nil {
+		s.sendError(gameID, ev.Channel, "There is no game in this thread to record.")
+		return
+	}
+	plies := len(gm.Plies())
+	options := s.scoresheetOptions(gm)
+	for _, ply := range command.Diagrams {
+		if ply > plies {
+			s.sendError(gameID, ev.Channel, fmt.Sprintf("This game only has %v plies.", plies))
+			return
+		}
+	}
+	options.Diagrams = command.Diagrams
+	pdfLink, err := s.ScoresheetLink.CreateLink(gm, "pdf", options)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	pngLink, _ := s.ScoresheetLink.CreateLink(gm, "png", options)
+	s.SlackClient.PostEphemeral(
+		ev.Channel,
+		ev.User,
+		slack.MsgOptionTS(gameID),
+		slack.MsgOptionAttachments(slack.Attachment{
+			Title:     "Scoresheet (PDF)",
+			TitleLink: pdfLink.String(),
+			Text:      fmt.Sprintf("Also available as an <%v|image>.", pngLink.String()),
+		}))
+}
+
+// scoresheetOptions names the players of a game on its scoresheet by their Slack display names
+func (s SlackHandler) scoresheetOptions(gm *game.Game) rendering.ScoresheetOptions {
+	return rendering.ScoresheetOptions{
+		White: displayName(s.SlackClient, gm.Players[game.White].ID),
+		Black: displayName(s.SlackClient, gm.Players[game.Black].ID),
+	}
+}
+
+func (s SlackHandler) handleSettingsCommand(gameID string, command *SettingsCommand, ev *slackevents.AppMentionEvent) {
+	if s.PreferenceStorage == nil {
+		s.sendError(gameID, ev.Channel, "Display settings are not available.")
+		return
+	}
+	preferences, _ := s.PreferenceStorage.RetrievePreferences(s.teamID, ev.User)
+	text := "Your boards are shown with "
+	if command.Setting != "" {
+		var err error
+		if preferences, err = preferences.Apply(command); err != nil {
+			s.sendError(gameID, ev.Channel, fmt.Sprintf("Unable to change that setting: %v.", err))
+			return
+		}
+		if err := s.PreferenceStorage.StorePreferences(s.teamID, ev.User, preferences); err != nil {
+			log.Println(err)
+			s.sendError(gameID, ev.Channel, "Unable to save your settings.")
+			return
+		}
+		text = "Saved! Your boards will be shown with "
+	}
+	startingFEN := chess.NewGame().FEN()
+	link, _ := s.LinkRenderer.WithOptions(preferences.RenderOptions()).CreatePositionLink(startingFEN, rendering.Annotations{})
+	board, _ := rendering.NewTextRendererFromFEN(startingFEN)
+	s.SlackClient.PostEphemeral(
+		ev.Channel,
+		ev.User,
+		slack.MsgOptionTS(ev.ThreadTimeStamp),
+		slack.MsgOptionText(text+preferences.String()+".", false),
+		slack.MsgOptionAttachments(preferences.boardAttachment(slack.Attachment{
+			Text: fmt.Sprintf(
+				"Themes: %v\nPieces: %v\nSizes: %v to %v\nText: %v, %v or off\nChange a setting by saying \"settings theme green\", or \"settings reset\" to go back to the defaults.",
+				strings.Join(rendering.ThemeNames(), ", "),
+				strings.Join(rendering.PieceSets(""), ", "),
+				rendering.MinBoardSize,
+				rendering.MaxBoardSize,
+				rendering.UnicodeTextStyle,
+				rendering.ASCIITextStyle,
+			),
+		}, link, board, false)))
+}
+
+// preferencesFor retrieves the display preferences of a user
+func (s SlackHandler) preferencesFor(userID string) DisplayPreferences {
+	return retrievePreferences(s.PreferenceStorage, s.teamID, userID)
+}
+
+func (s SlackHandler) handleImportUpload(ev *slackevents.MessageEvent) {
+	botToken, err := s.AuthStorage.GetAuthToken(s.teamID)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	imp := importer.NewImporter(s.PlayerAliasStorage, s.ArchiveStorage)
+	for _, file := range ev.Files {
+		if strings.HasSuffix(strings.ToLower(file.Name), ".csv") {
+			s.handlePuzzleImport(file, botToken, ev)
+			continue
+		}
+		report, err := importFile(imp, s.teamID, ev.User, file, botToken)
+		if err != nil {
+			log.Printf("Failed to import %v: %v\n", file.Name, err)
+			s.sendError(ev.ThreadTimeStamp, ev.Channel, importErrorText(file, err))
+			continue
+		}
+		skipped := make([]string, 0, len(report.Skipped))
+		for _, skippedGame := range report.Skipped {
+			skipped = append(skipped, skippedGame.Error())
+		}
+		s.SlackClient.PostMessage(
+			ev.Channel,
+			slack.MsgOptionText(fmt.Sprintf("Imported %v games from %v.", report.Imported, file.Name), false),
+			slack.MsgOptionAttachments(slack.Attachment{
+				Title: fmt.Sprintf("%v games skipped", len(report.Skipped)),
+				Text:  strings.Join(skipped, "\n"),
+			}))
+	}
+	if s.Explorer != nil {
+		if err := s.Explorer.Rebuild(s.ArchiveStorage, s.teamID); err != nil {
+			log.Printf("Failed to index imported games: %v\n", err)
+		}
+	}
+}
+
+// importFile downloads a file shared with the bot and imports the games of the PGN played by the user who shared it
+func importFile(imp *importer.Importer, teamID string, userID string, file slackevents.File, botToken string) (*importer.Report, error) {
+	var report *importer.Report
+	err := downloadFile(file, botToken, func(r io.Reader) (err error) {
+		report, err = imp.ImportPlayedBy(teamID, userID, r)
+		return err
+	})
+	return report, err
+}
+
+// downloadFile downloads a file shared with the bot and hands its contents to read.
+// Files larger than maxImportSize are rejected before anything is read, rather than importing part of them.
+func downloadFile(file slackevents.File, botToken string, read func(io.Reader) error) error {
+	req, err := http.NewRequest(http.MethodGet, file.URLPrivateDownload, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Authorization", "Bearer "+botToken)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("server responded with an unexpected status code to our request: %v", resp.StatusCode)
+	}
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxImportSize+1))
+	if err != nil {
+		return err
+	}
+	if len(data) > maxImportSize {
+		return errFileTooLarge
+	}
+	return read(bytes.NewReader(data))
+}
+
+// importErrorText describes a failure to import a file to the user who shared it
+func importErrorText(file slackevents.File, err error) string {
+	if err == errFileTooLarge {
+		return fmt.Sprintf("Unable to import %v, files larger than %v MB aren't accepted.", file.Name, maxImportSize>>20)
+	}
+	return fmt.Sprintf("Unable to import %v.", file.Name)
+}
+
 func getHelpAttachments() []slack.Attachment {
 	return []slack.Attachment{
 		{
@@ -343,6 +998,62 @@ func getHelpAttachments() []slack.Attachment {
 			Title: "Making a move",
 			Text:  "To make a move playing, mention @chessbot and say \"d2d4\" which are the grid position of the piece you wish to move and the destination.",
 		},
+		{
+			Title: "Showing a position",
+			Text:  "To discuss a position, mention @chessbot and say \"show game arrows e2e4,g1f3\" or \"show <FEN> arrows e2e4:red highlights d5 glyphs e4:!!\".",
+		},
+		{
+			Title: "Reviewing a game",
+			Text:  "To show the board after any ply of the game in a thread, mention @chessbot and say \"position 23\". Ply 0 is the starting position.",
+		},
+		{
+			Title: "Annotating moves",
+			Text:  "To comment on the last move of the game in a thread, mention @chessbot and say \"annotate !? an interesting try\". Add a ply to annotate an earlier move: \"annotate 12 ?? missed Nf5 here\". Glyphs are drawn on the boards of those positions and annotations are included in the exported PGN.",
+		},
+		{
+			Title: "Printing a game",
+			Text:  "To get a printable scoresheet of the game in a thread, mention @chessbot and say \"scoresheet\". Add plies to include diagrams of those positions: \"scoresheet 12 24\".",
+		},
+		{
+			Title: "Openings",
+			Text:  "The opening of a game is named as it is played. To see the openings you play most and how they went, mention @chessbot and say \"openings\", or \"openings @player\" for someone else.",
+		},
+		{
+			Title: "Opening explorer",
+			Text:  "To see how the team has played a position, mention @chessbot and say \"explore\" followed by moves (\"explore e4 c5 Nf3\") or a FEN. The most played moves are listed with how the games ended, along with a link to browse further.",
+		},
+		{
+			Title: "Puzzle of the day",
+			Text:  "Tactical moments from finished games are posted as a daily puzzle. To have it posted in a channel, mention @chessbot there and say \"puzzle of the day here\", or \"puzzle of the day off\" to stop. To answer, mention @chessbot in the puzzle's thread with your move (\"Qxf7#\" or \"h5f7\"). Solve the puzzle on consecutive days to build a streak.",
+		},
+		{
+			Title: "Playing puzzles",
+			Text:  "To play a puzzle matched to your puzzle rating, mention @chessbot and say \"puzzle\". Reply in its thread with your moves (\"Qxf7#\" or \"h5f7\") and I'll play the other side. Puzzles can be imported by sending me a CSV file in the Lichess puzzle format in a direct message with the comment \"import\".",
+		},
+		{
+			Title: "Analysis boards",
+			Text:  "To try out lines without changing a game, mention @chessbot in its thread and say \"analyze here\". The game is copied to an analysis board in a new thread, where anyone can reply with moves, \"back\" to take one back and \"pgn\" to export every variation tried.",
+		},
+		{
+			Title: "Coached games",
+			Text:  "To play a coached game, say \"challenge @player coach\". On your turn, mention @chessbot in the game thread and say \"hint\" to be shown which piece to move, and again for where to move it. Hints are recorded in the exported PGN and coached games are unrated.",
+		},
+		{
+			Title: "Endgame tablebase",
+			Text:  "With 7 pieces or fewer left, mention @chessbot in a game thread and say \"tablebase\" for the outcome with perfect play and the best move. When both players say \"tablebase adjudicate\", the game ends with that outcome.",
+		},
+		{
+			Title: "Display settings",
+			Text:  "To change how boards are shown to you, mention @chessbot and say \"settings\" to see the available themes and piece sets, then \"settings theme green\", \"settings pieces unicode\", \"settings size 768\", \"settings coordinates off\" or \"settings frame on\" to show the players, captured pieces and move number around the board. For boards as text, say \"settings text unicode\" (or ascii), \"settings describe on\" to list the pieces for screen readers and \"settings image off\" to hide the image.",
+		},
+		{
+			Title: "Exporting games",
+			Text:  "To download completed games as PGN, mention @chessbot and say \"export\". Optionally add a player and a date range: \"export @player 2019-01-01 2019-12-31\".",
+		},
+		{
+			Title: "Importing games",
+			Text:  "To import finished games, map your name in the PGN to yourself by mentioning @chessbot and saying \"alias Smith, John @you\", then send me the PGN file in a direct message with the comment \"import\". Only the games you played are imported.",
+		},
 		{
 			Pretext:   "For additional help visit our website.",
 			Title:     "ChessBot Help",
@@ -2,33 +2,71 @@ package integration
 
 import (
 	"fmt"
+	"sync"
 )
 
-// MemoryStore implements the Auth storage interfaces and holds all state in memory
+// MemoryStore implements the Auth and Preference storage interfaces and holds all state in memory
 // Once the MemoryStore instance is released, all data in that storage is lost
 type MemoryStore struct {
+	mu             sync.RWMutex
 	authorizations map[string]string
+	preferences    map[string]DisplayPreferences
 }
 
 // NewMemoryStore returns a MemoryStore pointer
 func NewMemoryStore() *MemoryStore {
 	store := MemoryStore{
 		authorizations: make(map[string]string, 10),
+		preferences:    make(map[string]DisplayPreferences),
 	}
 	return &store
 }
 
 // StoreAuthToken stores the oauth token granted by slack user for a given team ID
 func (m *MemoryStore) StoreAuthToken(teamID string, oauthToken string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	m.authorizations[teamID] = oauthToken
 	return nil
 }
 
 // GetAuthToken retrieves an oauth token for use with slack given a team ID
 func (m *MemoryStore) GetAuthToken(teamID string) (string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
 	token, ok := m.authorizations[teamID]
 	if !ok {
 		return "", fmt.Errorf("Auth token not found for team %v", teamID)
 	}
 	return token, nil
 }
+
+// AuthTeamIDs lists every team ID that has an oauth token stored
+func (m *MemoryStore) AuthTeamIDs() ([]string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	teamIDs := make([]string, 0, len(m.authorizations))
+	for teamID := range m.authorizations {
+		teamIDs = append(teamIDs, teamID)
+	}
+	return teamIDs, nil
+}
+
+// StorePreferences stores the display preferences of a user within a team
+func (m *MemoryStore) StorePreferences(teamID string, userID string, preferences DisplayPreferences) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.preferences[teamID+"\x00"+userID] = preferences
+	return nil
+}
+
+// RetrievePreferences retrieves the display preferences of a user within a team
+func (m *MemoryStore) RetrievePreferences(teamID string, userID string) (DisplayPreferences, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	preferences, ok := m.preferences[teamID+"\x00"+userID]
+	if !ok {
+		return DisplayPreferences{}, fmt.Errorf("Preferences not found for user %v", userID)
+	}
+	return preferences, nil
+}
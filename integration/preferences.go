@@ -0,0 +1,221 @@
+package integration
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/cjsaylor/chessbot/game"
+	"github.com/cjsaylor/chessbot/rendering"
+	"github.com/nlopes/slack"
+)
+
+// DisplayPreferences are a user's choices of how boards are rendered for them
+type DisplayPreferences struct {
+	Theme           string
+	PieceSet        string
+	BoardSize       int
+	HideCoordinates bool
+	Framed          bool
+	// TextStyle shows boards as text in the unicode or ascii style alongside (or instead of) images when set
+	TextStyle string
+	// HideImage shows boards only as text
+	HideImage bool
+	// Describe lists the pieces of each side for screen readers
+	Describe bool
+}
+
+// RenderOptions converts the preferences to board rendering options
+func (p DisplayPreferences) RenderOptions() rendering.Options {
+	return rendering.Options{
+		Theme:           p.Theme,
+		PieceSet:        p.PieceSet,
+		BoardSize:       p.BoardSize,
+		HideCoordinates: p.HideCoordinates,
+		Framed:          p.Framed,
+	}
+}
+
+// retrievePreferences gets the display preferences of a user.
+// Users without preferences (or without a preference storage configured) get the defaults.
+func retrievePreferences(storage PreferenceStorage, teamID string, userID string) DisplayPreferences {
+	if storage == nil {
+		return DisplayPreferences{}
+	}
+	preferences, err := storage.RetrievePreferences(teamID, userID)
+	if err != nil {
+		return DisplayPreferences{}
+	}
+	return preferences
+}
+
+// showsText determines if boards are shown as text, either alongside or in place of images
+func (p DisplayPreferences) showsText() bool {
+	return p.TextStyle != "" || p.HideImage || p.Describe
+}
+
+// boardAttachment shows a board as an image, as text or both, keeping any text of the attachment (such as the last move) first
+func (p DisplayPreferences) boardAttachment(attachment slack.Attachment, link *url.URL, board *rendering.TextRenderer, inverted bool) slack.Attachment {
+	if !p.HideImage {
+		attachment.ImageURL = link.String()
+	}
+	if !p.showsText() {
+		return attachment
+	}
+	text := []string{}
+	if attachment.Text != "" {
+		text = append(text, attachment.Text)
+	}
+	if p.TextStyle != "" || !p.Describe {
+		text = append(text, "```\n"+board.Render(rendering.TextOptions{
+			Style:           p.TextStyle,
+			HideCoordinates: p.HideCoordinates,
+			Inverted:        inverted,
+		})+"```")
+	}
+	if p.Describe {
+		text = append(text, board.Describe())
+	}
+	attachment.Text = strings.Join(text, "\n")
+	attachment.Fallback = board.Describe()
+	attachment.MarkdownIn = []string{"text"}
+	return attachment
+}
+
+// gameBoardAttachment shows the current board of a game as preferred, seen from the side to move
+func (p DisplayPreferences) gameBoardAttachment(attachment slack.Attachment, linkRenderer rendering.RenderLink, client *slack.Client, gm *game.Game, annotations rendering.Annotations) slack.Attachment {
+	link, _ := framedLinkRenderer(linkRenderer.WithOptions(p.RenderOptions()), client, gm).CreateAnnotatedLink(gm, annotations)
+	board, err := rendering.NewTextRendererFromGame(gm)
+	if err != nil {
+		log.Println(err)
+		attachment.ImageURL = link.String()
+		return attachment
+	}
+	return p.boardAttachment(attachment, link, board, gm.Turn() == game.Black)
+}
+
+// framedLinkRenderer adds the display names of the players of a game to links of framed boards.
+// Names are only resolved through Slack when the link renderer's options are framed.
+func framedLinkRenderer(linkRenderer rendering.RenderLink, client *slack.Client, gm *game.Game) rendering.RenderLink {
+	if !linkRenderer.Options().Framed {
+		return linkRenderer
+	}
+	return linkRenderer.WithFrame(rendering.Frame{
+		White: displayName(client, gm.Players[game.White].ID),
+		Black: displayName(client, gm.Players[game.Black].ID),
+	})
+}
+
+// displayName resolves the name a Slack user is shown as, falling back to their user ID
+func displayName(client *slack.Client, userID string) string {
+	if client == nil {
+		return userID
+	}
+	user, err := client.GetUserInfo(userID)
+	if err != nil {
+		log.Println(err)
+		return userID
+	}
+	for _, name := range []string{user.Profile.DisplayName, user.RealName, user.Name} {
+		if name != "" {
+			return name
+		}
+	}
+	return userID
+}
+
+// Apply changes a single preference named by the settings command
+func (p DisplayPreferences) Apply(command *SettingsCommand) (DisplayPreferences, error) {
+	switch command.Setting {
+	case "theme":
+		p.Theme = strings.ToLower(command.Value)
+	case "pieces":
+		p.PieceSet = command.Value
+	case "size":
+		size, err := strconv.Atoi(strings.TrimSuffix(command.Value, "px"))
+		if err != nil {
+			return p, fmt.Errorf("board size must be a number of pixels")
+		}
+		p.BoardSize = size
+	case "coordinates":
+		show, err := parseSwitch(command)
+		if err != nil {
+			return p, err
+		}
+		p.HideCoordinates = !show
+	case "frame":
+		framed, err := parseSwitch(command)
+		if err != nil {
+			return p, err
+		}
+		p.Framed = framed
+	case "text":
+		switch style := strings.ToLower(command.Value); style {
+		case rendering.UnicodeTextStyle, rendering.ASCIITextStyle:
+			p.TextStyle = style
+		case "off":
+			p.TextStyle = ""
+		default:
+			return p, fmt.Errorf("text must be %v, %v or off", rendering.UnicodeTextStyle, rendering.ASCIITextStyle)
+		}
+	case "image":
+		show, err := parseSwitch(command)
+		if err != nil {
+			return p, err
+		}
+		p.HideImage = !show
+	case "describe":
+		describe, err := parseSwitch(command)
+		if err != nil {
+			return p, err
+		}
+		p.Describe = describe
+	case "reset":
+		p = DisplayPreferences{}
+	}
+	return p, p.RenderOptions().Validate()
+}
+
+// parseSwitch parses the value of a setting that is either on or off
+func parseSwitch(command *SettingsCommand) (bool, error) {
+	switch strings.ToLower(command.Value) {
+	case "on", "show", "true":
+		return true, nil
+	case "off", "hide", "false":
+		return false, nil
+	}
+	return false, fmt.Errorf("%v must be on or off", command.Setting)
+}
+
+// String describes the preferences as shown to the user
+func (p DisplayPreferences) String() string {
+	options := p.RenderOptions()
+	theme, pieces, size := options.Theme, options.PieceSet, options.BoardSize
+	if theme == "" {
+		theme = "brown"
+	}
+	if pieces == "" {
+		pieces = rendering.ClassicPieceSet
+	}
+	if size == 0 {
+		size = rendering.DefaultBoardSize
+	}
+	coordinates := switchText(!p.HideCoordinates)
+	text := p.TextStyle
+	if text == "" {
+		text = "off"
+	}
+	return fmt.Sprintf(
+		"theme %v, pieces %v, size %vpx, coordinates %v, frame %v, text %v, image %v, describe %v",
+		theme, pieces, size, coordinates, switchText(p.Framed), text, switchText(!p.HideImage), switchText(p.Describe),
+	)
+}
+
+func switchText(on bool) string {
+	if on {
+		return "on"
+	}
+	return "off"
+}
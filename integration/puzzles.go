@@ -0,0 +1,383 @@
+package integration
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/cjsaylor/chessbot/game"
+	"github.com/cjsaylor/chessbot/puzzles"
+	"github.com/cjsaylor/chessbot/rendering"
+	"github.com/nlopes/slack"
+	"github.com/nlopes/slack/slackevents"
+)
+
+// mentionPattern matches user mentions such as the bot's own at the start of an app mention
+var mentionPattern = regexp.MustCompile("<@[\\w|\\d]+>")
+
+// PuzzlePoster posts the puzzle of the day to the channel chosen by every team at a configured hour (UTC)
+type PuzzlePoster struct {
+	AuthStorage   AuthStorage
+	PuzzleStorage puzzles.PuzzleStorage
+	LinkRenderer  rendering.RenderLink
+	Hour          int
+}
+
+// Run checks whether the puzzle of the day is due every interval, forever
+func (p PuzzlePoster) Run(interval time.Duration) {
+	for now := range time.Tick(interval) {
+		if err := p.PostDue(now); err != nil {
+			log.Printf("Failed to post the puzzle of the day: %v\n", err)
+		}
+	}
+}
+
+// PostDue posts the next unposted puzzle of every team that hasn't had a puzzle of the day posted today, once the hour has come.
+// Teams without a puzzle channel are skipped, as are those waiting to retry after failing to post.
+func (p PuzzlePoster) PostDue(now time.Time) error {
+	now = now.UTC()
+	if now.Hour() < p.Hour {
+		return nil
+	}
+	lister, ok := p.AuthStorage.(AuthTeamLister)
+	if !ok {
+		return errors.New("auth storage does not support listing teams")
+	}
+	teamIDs, err := lister.AuthTeamIDs()
+	if err != nil {
+		return err
+	}
+	for _, teamID := range teamIDs {
+		channel, err := p.PuzzleStorage.RetrievePuzzleChannel(teamID)
+		if err != nil || !channel.Due(now) {
+			continue
+		}
+		puzzle, err := p.PuzzleStorage.RetrieveNextPuzzle(teamID)
+		if err != nil {
+			log.Printf("Failed to find the puzzle of the day of team %v: %v\n", teamID, err)
+			continue
+		}
+		if puzzle == nil {
+			continue
+		}
+		token, err := p.AuthStorage.GetAuthToken(teamID)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		if err := p.post(slack.New(token), channel.ChannelID, puzzle, now); err != nil {
+			log.Printf("Failed to post the puzzle of the day of team %v: %v\n", teamID, err)
+			channel = channel.Failed(now)
+		} else {
+			channel = channel.Posted(now)
+		}
+		if err := p.PuzzleStorage.StorePuzzleChannel(channel); err != nil {
+			log.Println(err)
+		}
+	}
+	return nil
+}
+
+func (p PuzzlePoster) post(client *slack.Client, channelID string, puzzle *puzzles.Puzzle, now time.Time) error {
+	attachment, err := puzzleAttachment(p.LinkRenderer, DisplayPreferences{}, puzzle)
+	if err != nil {
+		return err
+	}
+	channelID, threadID, err := client.PostMessage(
+		channelID,
+		slack.MsgOptionText(fmt.Sprintf("*Puzzle of the day*: %v to play. Mention me with your move in this thread, you get a single attempt.", puzzle.Color()), false),
+		slack.MsgOptionAttachments(attachment))
+	if err != nil {
+		return err
+	}
+	puzzle.PostedAt = now
+	if err := p.PuzzleStorage.StorePuzzle(puzzle); err != nil {
+		return err
+	}
+	return p.PuzzleStorage.StoreDailyPuzzle(&puzzles.DailyPuzzle{
+		TeamID:    puzzle.TeamID,
+		ChannelID: channelID,
+		ThreadID:  threadID,
+		PuzzleID:  puzzle.ID,
+		PostedAt:  now,
+	})
+}
+
+// puzzleAttachment shows the starting position of a puzzle from the solver's side
+func puzzleAttachment(linkRenderer rendering.RenderLink, preferences DisplayPreferences, puzzle *puzzles.Puzzle) (slack.Attachment, error) {
+	link, err := linkRenderer.WithOptions(preferences.RenderOptions()).CreatePositionLink(puzzle.FEN, rendering.Annotations{})
+	if err != nil {
+		return slack.Attachment{}, err
+	}
+	board, err := rendering.NewTextRendererFromFEN(puzzle.FEN)
+	if err != nil {
+		return slack.Attachment{}, err
+	}
+	return preferences.boardAttachment(slack.Attachment{
+		Fallback: "Puzzle",
+	}, link, board, strings.Fields(puzzle.FEN)[1] == "b"), nil
+}
+
+// generatePuzzles finds puzzles in a completed game and stores them for the puzzle of the day.
+// Evaluating every position of a game takes a while, so it is meant to run in the background.
+func (s SlackHandler) generatePuzzles(archived *game.ArchivedGame) {
+	found, err := s.PuzzleGenerator.Find(archived)
+	if err != nil {
+		log.Printf("Failed to find puzzles in game %v: %v\n", archived.GameID, err)
+		return
+	}
+	for _, puzzle := range found {
+		if err := s.PuzzleStorage.StorePuzzle(puzzle); err != nil {
+			log.Printf("Failed to store puzzle %v: %v\n", puzzle.ID, err)
+		}
+	}
+}
+
+// handlePuzzleAnswer checks a mention in the thread of a puzzle of the day as an answer.
+// It returns false when the mention isn't a move, so it can be handled as a command instead.
+func (s SlackHandler) handlePuzzleAnswer(daily *puzzles.DailyPuzzle, ev *slackevents.AppMentionEvent) bool {
+	puzzle, err := s.PuzzleStorage.RetrievePuzzle(daily.PuzzleID)
+	if err != nil {
+		log.Println(err)
+		return false
+	}
+	fields := strings.Fields(mentionPattern.ReplaceAllString(ev.Text, ""))
+	if len(fields) == 0 {
+		return false
+	}
+	correct, err := puzzle.CheckAnswer(fields[0])
+	if err != nil {
+		return false
+	}
+	if !daily.Answer(ev.User) {
+		s.SlackClient.PostEphemeral(ev.Channel, ev.User, slack.MsgOptionTS(daily.ThreadID), slack.MsgOptionText("You already answered this puzzle.", false))
+		return true
+	}
+	if err := s.PuzzleStorage.StoreDailyPuzzle(daily); err != nil {
+		log.Println(err)
+		return true
+	}
+	if !correct {
+		solution, _ := puzzle.SolutionText()
+		s.SlackClient.PostEphemeral(ev.Channel, ev.User, slack.MsgOptionTS(daily.ThreadID), slack.MsgOptionText(fmt.Sprintf("Not quite, the solution is %v", solution), false))
+		return true
+	}
+	streak, err := s.PuzzleStorage.RetrieveStreak(daily.TeamID, ev.User)
+	if err != nil {
+		streak = puzzles.Streak{TeamID: daily.TeamID, UserID: ev.User}
+	}
+	streak = streak.Record(daily.PostedAt)
+	if err := s.PuzzleStorage.StoreStreak(streak); err != nil {
+		log.Println(err)
+	}
+	s.SlackClient.PostMessage(
+		ev.Channel,
+		slack.MsgOptionTS(daily.ThreadID),
+		slack.MsgOptionText(fmt.Sprintf("<@%v> solved the puzzle! Streak: %v (best %v)", ev.User, pluralDays(streak.Current), pluralDays(streak.Best)), false))
+	return true
+}
+
+func pluralDays(days int) string {
+	if days == 1 {
+		return "1 day"
+	}
+	return fmt.Sprintf("%v days", days)
+}
+
+// handleDailyPuzzleCommand sets the channel of the mention as the one the team's puzzle of the day is posted to, or stops posting it
+func (s SlackHandler) handleDailyPuzzleCommand(gameID string, command *DailyPuzzleCommand, ev *slackevents.AppMentionEvent) {
+	if s.PuzzleStorage == nil {
+		s.sendError(gameID, ev.Channel, "Puzzles aren't available.")
+		return
+	}
+	if s.PuzzleGenerator == nil && !command.Stop {
+		s.sendError(gameID, ev.Channel, "The puzzle of the day needs a chess engine to find puzzles, which isn't configured.")
+		return
+	}
+	channel := puzzles.PuzzleChannel{TeamID: s.teamID, ChannelID: ev.Channel}
+	if current, err := s.PuzzleStorage.RetrievePuzzleChannel(s.teamID); err == nil {
+		// moving the puzzle of the day to another channel doesn't post it twice on the day
+		channel.PostedAt = current.PostedAt
+	}
+	text := "The puzzle of the day will be posted in this channel."
+	if command.Stop {
+		channel.ChannelID = ""
+		text = "The puzzle of the day won't be posted anymore."
+	}
+	if err := s.PuzzleStorage.StorePuzzleChannel(channel); err != nil {
+		log.Println(err)
+		s.sendError(gameID, ev.Channel, "Unable to change where the puzzle of the day is posted.")
+		return
+	}
+	_, _, err := s.SlackClient.PostMessage(ev.Channel, slack.MsgOptionText(text, false), slack.MsgOptionTS(gameID))
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+// botMentionPattern captures the ID of the bot mentioned at the start of an app mention
+var botMentionPattern = regexp.MustCompile("^<@([\\w\\d]+)>")
+
+// handlePuzzleCommand starts a puzzle matched to the user's rating in a new thread
+func (s SlackHandler) handlePuzzleCommand(gameID string, ev *slackevents.AppMentionEvent) {
+	if s.PuzzleStorage == nil {
+		s.sendError(gameID, ev.Channel, "Puzzles aren't available.")
+		return
+	}
+	rating, err := s.PuzzleStorage.RetrievePlayerRating(s.teamID, ev.User)
+	if err != nil {
+		rating = puzzles.NewPlayerRating(s.teamID, ev.User)
+	}
+	puzzle, err := puzzles.Select(s.PuzzleStorage, s.teamID, ev.User, rating.Rating)
+	if err == puzzles.ErrNoPuzzles {
+		s.sendError(gameID, ev.Channel, "There are no puzzles left for you to play. Puzzles are found in finished games, or can be imported.")
+		return
+	}
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	gm, err := puzzle.Game("", game.Player{ID: ev.User}, game.Player{ID: botID(ev)})
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	boardAttachment := s.preferencesFor(ev.User).gameBoardAttachment(slack.Attachment{
+		Fallback: "Puzzle",
+		Color:    colorToHex[gm.Turn()],
+	}, s.LinkRenderer, s.SlackClient, gm, rendering.Annotations{})
+	channelID, threadID, err := s.SlackClient.PostMessage(
+		ev.Channel,
+		slack.MsgOptionText(fmt.Sprintf(
+			"<@%v>'s puzzle (rated %v): %v to play. Reply in this thread with your moves. Your puzzle rating is %v.",
+			ev.User, puzzle.Rating, gm.Turn(), rating.Rating,
+		), false),
+		slack.MsgOptionAttachments(boardAttachment))
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	err = s.PuzzleStorage.StoreAttempt(&puzzles.Attempt{
+		TeamID:    s.teamID,
+		ChannelID: channelID,
+		ThreadID:  threadID,
+		UserID:    ev.User,
+		PuzzleID:  puzzle.ID,
+		StartedAt: time.Now(),
+	})
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+// handlePuzzleMove checks a mention in the thread of a puzzle attempt as the solver's next move,
+// replying with the opponent's move from the solution until the puzzle is solved or failed.
+// It returns false when the mention isn't a move, so it can be handled as a command instead.
+func (s SlackHandler) handlePuzzleMove(attempt *puzzles.Attempt, ev *slackevents.AppMentionEvent) bool {
+	if attempt.Finished() || !looksLikeMove(ev.Text) {
+		return false
+	}
+	if ev.User != attempt.UserID {
+		s.SlackClient.PostEphemeral(ev.Channel, ev.User, slack.MsgOptionTS(attempt.ThreadID), slack.MsgOptionText(
+			fmt.Sprintf("This is <@%v>'s puzzle. Mention me and say \"puzzle\" to play one yourself.", attempt.UserID), false))
+		return true
+	}
+	puzzle, err := s.PuzzleStorage.RetrievePuzzle(attempt.PuzzleID)
+	if err != nil {
+		log.Println(err)
+		return false
+	}
+	fields := strings.Fields(mentionPattern.ReplaceAllString(ev.Text, ""))
+	correct, err := attempt.Play(puzzle, fields[0])
+	if err != nil {
+		s.sendError(attempt.ThreadID, ev.Channel, "That move isn't legal in this position.")
+		return true
+	}
+	text := fmt.Sprintf("Correct! Your move, <@%v>.", attempt.UserID)
+	if attempt.Finished() {
+		rating, err := s.PuzzleStorage.RetrievePlayerRating(s.teamID, attempt.UserID)
+		if err != nil {
+			rating = puzzles.NewPlayerRating(s.teamID, attempt.UserID)
+		}
+		updated := rating.Record(puzzle, correct)
+		if err := s.PuzzleStorage.StorePlayerRating(updated); err != nil {
+			log.Println(err)
+		}
+		if err := s.PuzzleStorage.StorePuzzle(puzzle); err != nil {
+			log.Println(err)
+		}
+		text = fmt.Sprintf("Solved! Your puzzle rating is %v (%+d).", updated.Rating, updated.Rating-rating.Rating)
+		if !correct {
+			solution, _ := puzzle.SolutionText()
+			text = fmt.Sprintf("Not quite, the solution is %v. Your puzzle rating is %v (%+d).", solution, updated.Rating, updated.Rating-rating.Rating)
+		}
+	}
+	if err := s.PuzzleStorage.StoreAttempt(attempt); err != nil {
+		log.Println(err)
+		return true
+	}
+	gm, err := puzzle.Game(attempt.ThreadID, game.Player{ID: attempt.UserID}, game.Player{ID: botID(ev)}, attempt.Moves...)
+	if err != nil {
+		log.Println(err)
+		return true
+	}
+	attachment := slack.Attachment{
+		Color: colorToHex[gm.Turn()],
+	}
+	if lastMove := gm.LastMove(); lastMove != nil {
+		attachment.Text = lastMove.String()
+	}
+	s.SlackClient.PostMessage(
+		ev.Channel,
+		slack.MsgOptionText(text, false),
+		slack.MsgOptionAttachments(s.preferencesFor(ev.User).gameBoardAttachment(attachment, s.LinkRenderer, s.SlackClient, gm, rendering.Annotations{})),
+		slack.MsgOptionTS(attempt.ThreadID))
+	return true
+}
+
+// handlePuzzleImport imports puzzles from a CSV file shared with the bot
+func (s SlackHandler) handlePuzzleImport(file slackevents.File, botToken string, ev *slackevents.MessageEvent) {
+	if s.PuzzleStorage == nil {
+		s.sendError(ev.ThreadTimeStamp, ev.Channel, "Puzzles aren't available.")
+		return
+	}
+	var report *puzzles.ImportReport
+	err := downloadFile(file, botToken, func(r io.Reader) (err error) {
+		report, err = puzzles.ImportCSV(s.PuzzleStorage, s.teamID, r)
+		return err
+	})
+	if err != nil {
+		log.Printf("Failed to import %v: %v\n", file.Name, err)
+		s.sendError(ev.ThreadTimeStamp, ev.Channel, importErrorText(file, err))
+		return
+	}
+	skipped := make([]string, 0, len(report.Skipped))
+	for _, skippedPuzzle := range report.Skipped {
+		skipped = append(skipped, skippedPuzzle.Error())
+	}
+	s.SlackClient.PostMessage(
+		ev.Channel,
+		slack.MsgOptionText(fmt.Sprintf("Imported %v puzzles from %v.", report.Imported, file.Name), false),
+		slack.MsgOptionAttachments(slack.Attachment{
+			Title: fmt.Sprintf("%v puzzles skipped", len(report.Skipped)),
+			Text:  strings.Join(skipped, "\n"),
+		}))
+}
+
+// looksLikeMove determines if a mention is a move rather than another command
+func looksLikeMove(text string) bool {
+	matched := slackCommandParser.ParseInput(text)
+	return (matched.Type == Move || matched.Type == Unknown) && len(strings.Fields(mentionPattern.ReplaceAllString(text, ""))) > 0
+}
+
+// botID is the ID of the bot as mentioned at the start of an app mention
+func botID(ev *slackevents.AppMentionEvent) string {
+	if matches := botMentionPattern.FindStringSubmatch(ev.Text); matches != nil {
+		return matches[1]
+	}
+	return ""
+}
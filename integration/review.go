@@ -0,0 +1,79 @@
+package integration
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/cjsaylor/chessbot/analysis"
+	"github.com/cjsaylor/chessbot/game"
+	"github.com/cjsaylor/chessbot/rendering"
+	"github.com/nlopes/slack"
+)
+
+// postReview reviews a completed game and posts the review in the game's thread.
+// Evaluating every position of a game takes a while, so it is meant to run in the background.
+func (s SlackHandler) postReview(gm *game.Game, channel string, threadTS string) {
+	review, err := s.Reviewer.Review(gm)
+	if err != nil {
+		log.Printf("Failed to review game %v: %v\n", gm.ID, err)
+		return
+	}
+	options := []slack.MsgOption{
+		slack.MsgOptionText(reviewText(gm, review), false),
+		slack.MsgOptionTS(threadTS),
+	}
+	if critical, ok := review.Critical(); ok {
+		attachment, err := s.criticalMomentAttachment(gm, critical)
+		if err != nil {
+			log.Println(err)
+		} else {
+			options = append(options, slack.MsgOptionAttachments(attachment))
+		}
+	}
+	s.SlackClient.PostMessage(channel, options...)
+}
+
+// reviewText lists the opening, the accuracy of each player and the largest mistakes of a game
+func reviewText(gm *game.Game, review *analysis.Review) string {
+	lines := []string{"*Game review*"}
+	if review.HasOpening {
+		lines = append(lines, fmt.Sprintf("Opening: _%v (%v)_", review.Opening.Name, review.Opening.ECO))
+	}
+	for _, color := range []game.Color{game.White, game.Black} {
+		lines = append(lines, fmt.Sprintf("<@%v> (%v): %.1f%% accuracy", gm.Players[color].ID, color, review.Accuracy[color]))
+	}
+	if len(review.Mistakes) == 0 {
+		lines = append(lines, "No mistakes were found.")
+	}
+	for _, mistake := range review.Mistakes {
+		lines = append(lines, fmt.Sprintf("• %v%v (%v → %v), better was %v", mistake.MoveNumber(), mistake.PlayedSAN+mistake.Glyph(), mistake.Before, mistake.After, mistake.BestSAN))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// criticalMomentAttachment renders the position of the worst mistake of a game,
+// with the played move in red and the better move in green.
+func (s SlackHandler) criticalMomentAttachment(gm *game.Game, critical analysis.Mistake) (slack.Attachment, error) {
+	annotations := rendering.Annotations{
+		Arrows: []rendering.Arrow{
+			{From: critical.Played.S1(), To: critical.Played.S2(), Color: "red"},
+			{From: critical.Best.S1(), To: critical.Best.S2(), Color: "green"},
+		},
+		Glyphs: []rendering.Glyph{
+			{Square: critical.Played.S2(), Symbol: critical.Glyph()},
+		},
+	}
+	preferences := s.preferencesFor(gm.Players[critical.Color].ID)
+	link, err := s.LinkRenderer.WithOptions(preferences.RenderOptions()).CreatePositionLink(critical.FEN, annotations)
+	if err != nil {
+		return slack.Attachment{}, err
+	}
+	board, err := rendering.NewTextRendererFromFEN(critical.FEN)
+	if err != nil {
+		return slack.Attachment{}, err
+	}
+	return preferences.boardAttachment(slack.Attachment{
+		Title: "Critical moment: " + critical.MoveNumber() + critical.PlayedSAN + critical.Glyph(),
+	}, link, board, critical.Color == game.Black), nil
+}
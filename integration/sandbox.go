@@ -0,0 +1,152 @@
+package integration
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/cjsaylor/chessbot/game"
+	"github.com/cjsaylor/chessbot/rendering"
+	"github.com/cjsaylor/chessbot/sandbox"
+	"github.com/nlopes/slack"
+	"github.com/nlopes/slack/slackevents"
+	"github.com/notnil/chess"
+)
+
+// handleAnalyzeCommand forks the game of a thread into an analysis board played in a new thread of the channel
+func (s SlackHandler) handleAnalyzeCommand(gameID string, ev *slackevents.AppMentionEvent) {
+	if s.BoardStorage == nil {
+		s.sendError(gameID, ev.Channel, "Analysis boards aren't available.")
+		return
+	}
+	gm, err := s.GameStorage.RetrieveGame(gameID)
+	if err != nil {
+		s.sendError(gameID, ev.Channel, "There is no game in this thread to analyze.")
+		return
+	}
+	board := sandbox.NewBoard(gm)
+	board.TeamID = s.teamID
+	board.CreatedAt = time.Now()
+	attachment, err := s.analysisBoardAttachment(board, ev.User)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	channelID, threadID, err := s.SlackClient.PostMessage(
+		ev.Channel,
+		slack.MsgOptionText(fmt.Sprintf(
+			"Analysis board of <@%v> and <@%v>'s game. Anyone can reply in this thread with moves to try, \"back\" to take one back and \"pgn\" to export every variation. The game itself doesn't change.",
+			board.WhiteID, board.BlackID,
+		), false),
+		slack.MsgOptionAttachments(attachment))
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	board.ChannelID = channelID
+	board.ThreadID = threadID
+	if err := s.BoardStorage.StoreBoard(board); err != nil {
+		log.Println(err)
+		return
+	}
+	text := "I've started an analysis board of this game in the channel."
+	if link, err := s.SlackClient.GetPermalink(&slack.PermalinkParameters{Channel: channelID, Ts: threadID}); err == nil {
+		text = fmt.Sprintf("I've started an <%v|analysis board> of this game.", link)
+	}
+	s.SlackClient.PostMessage(ev.Channel, slack.MsgOptionText(text, false), slack.MsgOptionTS(gameID))
+}
+
+// handleBoardMention plays a move on the analysis board of a thread, takes one back or exports its variations.
+// It returns false when the mention is none of these, so it can be handled as a command instead.
+func (s SlackHandler) handleBoardMention(board *sandbox.Board, ev *slackevents.AppMentionEvent) bool {
+	fields := strings.Fields(mentionPattern.ReplaceAllString(ev.Text, ""))
+	if len(fields) == 0 {
+		return false
+	}
+	switch strings.ToLower(fields[0]) {
+	case "pgn", "export":
+		pgn, err := board.PGN()
+		if err != nil {
+			log.Println(err)
+			return true
+		}
+		s.SlackClient.PostMessage(ev.Channel, slack.MsgOptionText("```"+pgn+"```", false), slack.MsgOptionTS(board.ThreadID))
+		return true
+	case "back", "undo", "takeback":
+		if err := board.Back(); err != nil {
+			s.sendError(board.ThreadID, ev.Channel, "The board is already at the starting position.")
+			return true
+		}
+	default:
+		if !looksLikeMove(ev.Text) {
+			return false
+		}
+		if _, err := board.Play(fields[0]); err != nil {
+			s.sendError(board.ThreadID, ev.Channel, "That move isn't legal in this position.")
+			return true
+		}
+	}
+	if err := s.BoardStorage.StoreBoard(board); err != nil {
+		log.Println(err)
+		return true
+	}
+	attachment, err := s.analysisBoardAttachment(board, ev.User)
+	if err != nil {
+		log.Println(err)
+		return true
+	}
+	s.SlackClient.PostMessage(ev.Channel, slack.MsgOptionAttachments(attachment), slack.MsgOptionTS(board.ThreadID))
+	return true
+}
+
+// analysisBoardAttachment shows the position of an analysis board, with the line leading to it and the other moves tried instead of the last one
+func (s SlackHandler) analysisBoardAttachment(board *sandbox.Board, userID string) (slack.Attachment, error) {
+	position, err := board.Position()
+	if err != nil {
+		return slack.Attachment{}, err
+	}
+	annotations := rendering.Annotations{}
+	lastMove, err := board.LastMove()
+	if err != nil {
+		return slack.Attachment{}, err
+	}
+	if lastMove != nil {
+		annotations.Highlights = []rendering.Highlight{
+			{Square: lastMove.S1(), Color: "yellow"},
+			{Square: lastMove.S2(), Color: "yellow"},
+		}
+	}
+	text, err := board.MoveText()
+	if err != nil {
+		return slack.Attachment{}, err
+	}
+	if text == "" {
+		text = "Starting position"
+	}
+	alternatives, err := board.Alternatives()
+	if err != nil {
+		return slack.Attachment{}, err
+	}
+	if len(alternatives) > 0 {
+		text += fmt.Sprintf("\nAlso tried here: %v", strings.Join(alternatives, ", "))
+	}
+	preferences := s.preferencesFor(userID)
+	fen := position.String()
+	link, err := s.LinkRenderer.WithOptions(preferences.RenderOptions()).CreatePositionLink(fen, annotations)
+	if err != nil {
+		return slack.Attachment{}, err
+	}
+	textBoard, err := rendering.NewTextRendererFromFEN(fen)
+	if err != nil {
+		return slack.Attachment{}, err
+	}
+	color := colorToHex[game.White]
+	if position.Turn() == chess.Black {
+		color = colorToHex[game.Black]
+	}
+	return preferences.boardAttachment(slack.Attachment{
+		Text:  text,
+		Color: color,
+	}, link, textBoard, position.Turn() == chess.Black), nil
+}
@@ -2,6 +2,7 @@ package integration
 
 import (
 	"database/sql"
+	"encoding/json"
 
 	// import sqlite package for use with the sql interface
 	_ "github.com/mattn/go-sqlite3"
@@ -14,6 +15,15 @@ const authTableCreation = `
 	);
 `
 
+const preferencesTableCreation = `
+	CREATE TABLE IF NOT EXISTS user_preferences (
+		team_id text NOT NULL,
+		user_id text NOT NULL,
+		preferences text NOT NULL,
+		PRIMARY KEY (team_id, user_id)
+	);
+`
+
 // SqliteStore is an implementation of GameStorage and ChallengeStorage interfaces that persists using sqlite3
 type SqliteStore struct {
 	path string
@@ -21,7 +31,7 @@ type SqliteStore struct {
 }
 
 // NewSqliteStore creates (if not exists) the DB file and structure at the path specified
-// It implements the AuthStorage interface and is intended as a suitable
+// It implements the AuthStorage and PreferenceStorage interfaces and is intended as a suitable
 // perminent storage of oauth tokens
 func NewSqliteStore(path string) (*SqliteStore, error) {
 	store := SqliteStore{
@@ -31,9 +41,14 @@ func NewSqliteStore(path string) (*SqliteStore, error) {
 	if err != nil {
 		return nil, err
 	}
+	// sqlite only supports a single writer, serialize access to avoid locking errors
+	db.SetMaxOpenConns(1)
 	if _, err = db.Exec(authTableCreation); err != nil {
 		return nil, err
 	}
+	if _, err = db.Exec(preferencesTableCreation); err != nil {
+		return nil, err
+	}
 	store.db = db
 	return &store, nil
 }
@@ -58,3 +73,50 @@ func (s *SqliteStore) GetAuthToken(teamID string) (string, error) {
 	err := row.Scan(&token)
 	return token, err
 }
+
+// AuthTeamIDs lists every team ID that has an oauth token stored
+func (s *SqliteStore) AuthTeamIDs() ([]string, error) {
+	rows, err := s.db.Query("select id from authorizations")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	teamIDs := []string{}
+	for rows.Next() {
+		var teamID string
+		if err := rows.Scan(&teamID); err != nil {
+			return nil, err
+		}
+		teamIDs = append(teamIDs, teamID)
+	}
+	return teamIDs, rows.Err()
+}
+
+// StorePreferences stores the display preferences of a user within a team
+// Preferences are stored as JSON so new preferences don't require a schema change
+func (s *SqliteStore) StorePreferences(teamID string, userID string, preferences DisplayPreferences) error {
+	data, err := json.Marshal(preferences)
+	if err != nil {
+		return err
+	}
+	stmt, _ := s.db.Prepare(`
+		insert into user_preferences (team_id, user_id, preferences) values (?, ?, ?)
+		on conflict(team_id, user_id) do update set preferences = ?
+	`)
+	defer stmt.Close()
+	_, err = stmt.Exec(teamID, userID, string(data), string(data))
+	return err
+}
+
+// RetrievePreferences retrieves the display preferences of a user within a team
+func (s *SqliteStore) RetrievePreferences(teamID string, userID string) (DisplayPreferences, error) {
+	stmt, _ := s.db.Prepare("select preferences from user_preferences where team_id = ? and user_id = ?")
+	defer stmt.Close()
+	var data string
+	var preferences DisplayPreferences
+	if err := stmt.QueryRow(teamID, userID).Scan(&data); err != nil {
+		return preferences, err
+	}
+	err := json.Unmarshal([]byte(data), &preferences)
+	return preferences, err
+}
@@ -5,3 +5,14 @@ type AuthStorage interface {
 	StoreAuthToken(ID string, token string) error
 	GetAuthToken(ID string) (string, error)
 }
+
+// AuthTeamLister is implemented by auth storage that can list the team IDs it holds tokens for
+type AuthTeamLister interface {
+	AuthTeamIDs() ([]string, error)
+}
+
+// PreferenceStorage interface guarentees implemented methods for per-user display preference storage
+type PreferenceStorage interface {
+	StorePreferences(teamID string, userID string, preferences DisplayPreferences) error
+	RetrievePreferences(teamID string, userID string) (DisplayPreferences, error)
+}
@@ -0,0 +1,26 @@
+package integration_test
+
+import (
+	"testing"
+
+	"github.com/cjsaylor/chessbot/integration"
+	"github.com/cjsaylor/chessbot/storagetest"
+	"github.com/cjsaylor/chessbot/storagetest/integrationtest"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestStoreConformance(t *testing.T) {
+	storagetest.RunStores(t, storagetest.Stores{
+		Memory: func() interface{} { return integration.NewMemoryStore() },
+		Sqlite: func(path string) (interface{}, error) { return integration.NewSqliteStore(path) },
+		Bolt:   func(db *bolt.DB) (interface{}, error) { return integration.NewBoltStore(db) },
+	}, func(t *testing.T, open storagetest.Opener) {
+		integrationtest.Run(t, func(t *testing.T) integrationtest.Backend {
+			store := open(t)
+			return integrationtest.Backend{
+				AuthStorage:       store.(integration.AuthStorage),
+				PreferenceStorage: store.(integration.PreferenceStorage),
+			}
+		})
+	})
+}
@@ -0,0 +1,126 @@
+package integration
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/cjsaylor/chessbot/game"
+	"github.com/cjsaylor/chessbot/rendering"
+	"github.com/cjsaylor/chessbot/tablebase"
+	"github.com/nlopes/slack"
+	"github.com/nlopes/slack/slackevents"
+	"github.com/notnil/chess"
+)
+
+func (s SlackHandler) handleTablebaseCommand(gameID string, command *TablebaseCommand, ev *slackevents.AppMentionEvent) {
+	if s.Tablebase == nil {
+		s.sendError(gameID, ev.Channel, "Endgame tablebases aren't configured.")
+		return
+	}
+	gm, err := s.GameStorage.RetrieveGame(gameID)
+	if err != nil {
+		s.sendError(gameID, ev.Channel, "There is no game in this thread to probe.")
+		return
+	}
+	if gm.Outcome() != chess.NoOutcome {
+		s.sendError(gameID, ev.Channel, "This game is already over.")
+		return
+	}
+	result, err := s.Tablebase.Probe(gm.FEN())
+	if err == tablebase.ErrTooManyPieces {
+		s.sendError(gameID, ev.Channel, fmt.Sprintf("Tablebases only cover positions with %v pieces or fewer.", tablebase.MaxPieces))
+		return
+	}
+	if err != nil {
+		log.Printf("Failed to probe the tablebase for game %v: %v\n", gameID, err)
+		s.sendError(gameID, ev.Channel, "I couldn't find this position in the tablebase.")
+		return
+	}
+	verdict := result.Describe(chessTurn(gm))
+	if command.Adjudicate {
+		s.handleAdjudication(gm, verdict, result, ev)
+		return
+	}
+	text := "Tablebase: " + verdict + "."
+	annotations := rendering.Annotations{}
+	if result.BestMove != "" {
+		text += " Best move: " + result.BestMove
+		if move, err := (chess.AlgebraicNotation{}).Decode(positionOf(gm), result.BestMove); err == nil {
+			annotations.Arrows = []rendering.Arrow{{From: move.S1(), To: move.S2(), Color: "green"}}
+		}
+	}
+	boardAttachment := s.preferencesFor(ev.User).gameBoardAttachment(slack.Attachment{}, s.LinkRenderer, s.SlackClient, gm, annotations)
+	s.SlackClient.PostMessage(
+		ev.Channel,
+		slack.MsgOptionText(text, false),
+		slack.MsgOptionAttachments(boardAttachment),
+		slack.MsgOptionTS(ev.TimeStamp))
+}
+
+// handleAdjudication records a player's agreement to end the game with its tablebase verdict, and ends it once both players agree
+func (s SlackHandler) handleAdjudication(gm *game.Game, verdict string, result tablebase.Result, ev *slackevents.AppMentionEvent) {
+	player, err := gm.PlayerByID(ev.User)
+	if err != nil {
+		s.sendError(gm.ID, ev.Channel, "I couldn't find you as part of this game.")
+		return
+	}
+	if gm.AgreeToAdjudicate(player.ID) < len(gm.Players) {
+		if err := s.GameStorage.StoreGame(gm.ID, gm); err != nil {
+			s.sendError(gm.ID, ev.Channel, err.Error())
+			return
+		}
+		s.SlackClient.PostMessage(
+			ev.Channel,
+			slack.MsgOptionText(fmt.Sprintf("<@%v>, <@%v> agrees to end the game by the tablebase: %v. Mention me with `tablebase adjudicate` to agree.", gm.OtherPlayer(player).ID, player.ID, verdict), false),
+			slack.MsgOptionTS(ev.TimeStamp))
+		return
+	}
+	gm.Adjudicate(result.Outcome(chessTurn(gm)))
+	if err := s.GameStorage.StoreGame(gm.ID, gm); err != nil {
+		s.sendError(gm.ID, ev.Channel, err.Error())
+		return
+	}
+	s.displayEndGame(gm, ev)
+}
+
+// annotateTablebase tags a completed game with the tablebase verdict of its final position, when it is covered by the tablebases.
+// The verdict is posted to the thread of the game and its archived record is updated.
+func (s SlackHandler) annotateTablebase(gameID string, fen string, turn chess.Color, channel string, threadTS string) {
+	result, err := s.Tablebase.Probe(fen)
+	if err != nil {
+		log.Printf("Failed to probe the tablebase for game %v: %v\n", gameID, err)
+		return
+	}
+	verdict := result.Describe(turn)
+	gm, err := s.GameStorage.RetrieveGame(gameID)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	gm.Annotate("Tablebase", verdict)
+	if err := s.GameStorage.StoreGame(gameID, gm); err != nil {
+		log.Printf("Failed to store the tablebase verdict of game %v: %v\n", gameID, err)
+		return
+	}
+	if err := game.UpdateArchive(s.ArchiveStorage, s.teamID, gm); err != nil {
+		log.Printf("Failed to archive the tablebase verdict of game %v: %v\n", gameID, err)
+	}
+	s.SlackClient.PostMessage(channel, slack.MsgOptionText("Tablebase: "+verdict+".", false), slack.MsgOptionTS(threadTS))
+}
+
+// coveredByTablebase determines whether the final position of a game can be probed in the tablebases
+func (s SlackHandler) coveredByTablebase(gm *game.Game) bool {
+	return s.Tablebase != nil && tablebase.Pieces(gm.FEN()) <= tablebase.MaxPieces && len(gm.ValidMoves()) > 0
+}
+
+func chessTurn(gm *game.Game) chess.Color {
+	if gm.Turn() == game.Black {
+		return chess.Black
+	}
+	return chess.White
+}
+
+func positionOf(gm *game.Game) *chess.Position {
+	option, _ := chess.FEN(gm.FEN())
+	return chess.NewGame(option).Position()
+}
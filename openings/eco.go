@@ -0,0 +1,1246 @@
+// Code generated by gen.go from data/*.tsv; DO NOT EDIT.
+
+package openings
+
+// book maps the key of every position in the opening database to the opening it is named after.
+// Names follow the "Opening: Variation" convention, and the last line reaching a position determines its name.
+var book = map[string]Opening{
+	"rnbqkbnr/pppppppp/8/8/1P6/8/P1PPPPPP/RNBQKBNR b KQkq -":               {ECO: "A00", Name: "Polish Opening", Moves: "b4"},
+	"rnbqkbnr/pppppppp/8/8/6P1/8/PPPPPP1P/RNBQKBNR b KQkq -":               {ECO: "A00", Name: "Grob Opening", Moves: "g4"},
+	"rnbqkbnr/pppppppp/8/8/8/4P3/PPPP1PPP/RNBQKBNR b KQkq -":               {ECO: "A00", Name: "Van't Kruijs Opening", Moves: "e3"},
+	"rnbqkbnr/pppppppp/8/8/8/3P4/PPP1PPPP/RNBQKBNR b KQkq -":               {ECO: "A00", Name: "Mieses Opening", Moves: "d3"},
+	"rnbqkbnr/pppppppp/8/8/8/2P5/PP1PPPPP/RNBQKBNR b KQkq -":               {ECO: "A00", Name: "Saragossa Opening", Moves: "c3"},
+	"rnbqkbnr/pppppppp/8/8/8/6P1/PPPPPP1P/RNBQKBNR b KQkq -":               {ECO: "A00", Name: "Hungarian Opening", Moves: "g3"},
+	"rnbqkbnr/pppppppp/8/8/8/7P/PPPPPPP1/RNBQKBNR b KQkq -":                {ECO: "A00", Name: "Clemenz Opening", Moves: "h3"},
+	"rnbqkbnr/pppppppp/8/8/P7/8/1PPPPPPP/RNBQKBNR b KQkq -":                {ECO: "A00", Name: "Ware Opening", Moves: "a4"},
+	"rnbqkbnr/pppppppp/8/8/8/N7/PPPPPPPP/R1BQKBNR b KQkq -":                {ECO: "A00", Name: "Sodium Attack", Moves: "Na3"},
+	"rnbqkbnr/pppppppp/8/8/8/7N/PPPPPPPP/RNBQKB1R b KQkq -":                {ECO: "A00", Name: "Amar Opening", Moves: "Nh3"},
+	"rnbqkbnr/pppppppp/8/8/8/P7/1PPPPPPP/RNBQKBNR b KQkq -":                {ECO: "A00", Name: "Anderssen's Opening", Moves: "a3"},
+	"rnbqkbnr/pppppppp/8/8/8/5P2/PPPPP1PP/RNBQKBNR b KQkq -":               {ECO: "A00", Name: "Barnes Opening", Moves: "f3"},
+	"rnbqkbnr/pppppppp/8/8/7P/8/PPPPPPP1/RNBQKBNR b KQkq -":                {ECO: "A00", Name: "Kádas Opening", Moves: "h4"},
+	"rnbqkbnr/pp1ppppp/2p5/8/1P6/8/P1PPPPPP/RNBQKBNR w KQkq -":             {ECO: "A00", Name: "Polish Opening: Outflank Variation", Moves: "b4 c6"},
+	"rn1qkbnr/ppp1pppp/8/3p4/2P3b1/8/PP1PPPBP/RNBQK1NR b KQkq -":           {ECO: "A00", Name: "Grob Opening: Grob Gambit", Moves: "g4 d5 Bg2 Bxg4 c4"},
+	"rnbqkbnr/pppp1ppp/8/8/4p2N/6P1/PPPPPP1P/RNBQKB1R b KQkq -":            {ECO: "A00", Name: "Hungarian Opening: Reversed Norwegian Defense", Moves: "g3 e5 Nf3 e4 Nh4"},
+	"rnbqkbnr/pppppppp/8/8/8/1P6/P1PPPPPP/RNBQKBNR b KQkq -":               {ECO: "A01", Name: "Nimzo-Larsen Attack", Moves: "b3"},
+	"rnbqkbnr/ppp1pppp/8/3p4/8/1P6/P1PPPPPP/RNBQKBNR w KQkq -":             {ECO: "A01", Name: "Nimzo-Larsen Attack: Classical Variation", Moves: "b3 d5"},
+	"rnbqkbnr/pppp1ppp/8/4p3/8/1P6/P1PPPPPP/RNBQKBNR w KQkq -":             {ECO: "A01", Name: "Nimzo-Larsen Attack: Modern Variation", Moves: "b3 e5"},
+	"rnbqkb1r/pppppppp/5n2/8/8/1P6/P1PPPPPP/RNBQKBNR w KQkq -":             {ECO: "A01", Name: "Nimzo-Larsen Attack: Indian Variation", Moves: "b3 Nf6"},
+	"rnbqkbnr/pppppppp/8/8/5P2/8/PPPPP1PP/RNBQKBNR b KQkq -":               {ECO: "A02", Name: "Bird Opening", Moves: "f4"},
+	"rnbqkbnr/pppp1ppp/8/4p3/5P2/8/PPPPP1PP/RNBQKBNR w KQkq -":             {ECO: "A02", Name: "Bird Opening: From's Gambit", Moves: "f4 e5"},
+	"rnbqkbnr/ppppp1pp/8/5p2/4PP2/8/PPPP2PP/RNBQKBNR b KQkq -":             {ECO: "A02", Name: "Bird Opening: Swiss Gambit", Moves: "f4 f5 e4"},
+	"rnbqkbnr/ppp1pppp/8/3p4/5P2/8/PPPPP1PP/RNBQKBNR w KQkq -":             {ECO: "A03", Name: "Bird Opening: Dutch Variation", Moves: "f4 d5"},
+	"rnbqkb1r/pp2pppp/5n2/2pp4/5P2/4PN2/PPPP2PP/RNBQKB1R w KQkq -":         {ECO: "A03", Name: "Bird Opening: Lasker Variation", Moves: "f4 d5 Nf3 Nf6 e3 c5"},
+	"rnbqkbnr/pppppppp/8/8/8/5N2/PPPPPPPP/RNBQKB1R b KQkq -":               {ECO: "A04", Name: "Zukertort Opening", Moves: "Nf3"},
+	"rnbqkbnr/pp1ppppp/8/2p5/8/5N2/PPPPPPPP/RNBQKB1R w KQkq -":             {ECO: "A04", Name: "Zukertort Opening: Sicilian Invitation", Moves: "Nf3 c5"},
+	"rnbqkbnr/ppppp1pp/8/5p2/8/5N2/PPPPPPPP/RNBQKB1R w KQkq -":             {ECO: "A04", Name: "Zukertort Opening: Dutch Variation", Moves: "Nf3 f5"},
+	"rnbqkbnr/pppppp1p/6p1/8/8/5N2/PPPPPPPP/RNBQKB1R w KQkq -":             {ECO: "A04", Name: "Zukertort Opening: Kingside Fianchetto", Moves: "Nf3 g6"},
+	"rnbqkb1r/pppppppp/5n2/8/8/5N2/PPPPPPPP/RNBQKB1R w KQkq -":             {ECO: "A05", Name: "Zukertort Opening: Symmetrical Variation", Moves: "Nf3 Nf6"},
+	"rnbqkb1r/pppppppp/5n2/8/8/5NP1/PPPPPP1P/RNBQKB1R b KQkq -":            {ECO: "A05", Name: "King's Indian Attack", Moves: "Nf3 Nf6 g3"},
+	"rnbqkbnr/ppp1pppp/8/3p4/8/5N2/PPPPPPPP/RNBQKB1R w KQkq -":             {ECO: "A06", Name: "Zukertort Opening: Queen's Gambit Invitation", Moves: "Nf3 d5"},
+	"rnbqkbnr/ppp1pppp/8/3p4/8/1P3N2/P1PPPPPP/RNBQKB1R b KQkq -":           {ECO: "A06", Name: "Réti Opening: Nimzo-Larsen Variation", Moves: "Nf3 d5 b3"},
+	"rnbqkbnr/ppp1pppp/8/3p4/8/3P1N2/PPP1PPPP/RNBQKB1R b KQkq -":           {ECO: "A06", Name: "Zukertort Opening: Old Indian Attack", Moves: "Nf3 d5 d3"},
+	"rnbqkbnr/ppp1pppp/8/3p4/8/5NP1/PPPPPP1P/RNBQKB1R b KQkq -":            {ECO: "A07", Name: "King's Indian Attack", Moves: "Nf3 d5 g3"},
+	"r2qkbnr/pppnpppp/8/3p4/6b1/5NP1/PPPPPPBP/RNBQK2R w KQkq -":            {ECO: "A07", Name: "King's Indian Attack: Keres Variation", Moves: "Nf3 d5 g3 Bg4 Bg2 Nd7"},
+	"rnbqkbnr/pp2pppp/8/2pp4/8/5NP1/PPPPPPBP/RNBQK2R b KQkq -":             {ECO: "A08", Name: "King's Indian Attack: French Variation", Moves: "Nf3 d5 g3 c5 Bg2"},
+	"r1bqk2r/pp2bppp/2n1pn2/2pp4/4P3/3P1NP1/PPPN1PBP/R1BQ1RK1 b kq -":      {ECO: "A08", Name: "King's Indian Attack: Sicilian Variation", Moves: "Nf3 d5 g3 c5 Bg2 Nc6 O-O e6 d3 Nf6 Nbd2 Be7 e4"},
+	"rnbqkbnr/ppp1pppp/8/3p4/2P5/5N2/PP1PPPPP/RNBQKB1R b KQkq -":           {ECO: "A09", Name: "Réti Opening", Moves: "Nf3 d5 c4"},
+	"rnbqkbnr/ppp1pppp/8/8/2Pp4/5N2/PP1PPPPP/RNBQKB1R w KQkq -":            {ECO: "A09", Name: "Réti Opening: Advance Variation", Moves: "Nf3 d5 c4 d4"},
+	"rnbqkbnr/ppp1pppp/8/8/2p5/5N2/PP1PPPPP/RNBQKB1R w KQkq -":             {ECO: "A09", Name: "Réti Opening: Réti Accepted", Moves: "Nf3 d5 c4 dxc4"},
+	"rnbqkbnr/pppppppp/8/8/2P5/8/PP1PPPPP/RNBQKBNR b KQkq -":               {ECO: "A10", Name: "English Opening", Moves: "c4"},
+	"rnbqkbnr/pppppp1p/6p1/8/2P5/8/PP1PPPPP/RNBQKBNR w KQkq -":             {ECO: "A10", Name: "English Opening: Great Snake Variation", Moves: "c4 g6"},
+	"rnbqkbnr/ppppp1pp/8/5p2/2P5/8/PP1PPPPP/RNBQKBNR w KQkq -":             {ECO: "A10", Name: "English Opening: Anglo-Dutch Variation", Moves: "c4 f5"},
+	"rnbqkbnr/p1pppppp/8/1p6/2P5/8/PP1PPPPP/RNBQKBNR w KQkq -":             {ECO: "A10", Name: "English Opening: Jaenisch Gambit", Moves: "c4 b5"},
+	"rnbqkbnr/pp1ppppp/2p5/8/2P5/8/PP1PPPPP/RNBQKBNR w KQkq -":             {ECO: "A11", Name: "English Opening: Caro-Kann Defensive System", Moves: "c4 c6"},
+	"rnbqkbnr/pp2pppp/2p5/3p4/2P5/1P3N2/P2PPPPP/RNBQKB1R b KQkq -":         {ECO: "A11", Name: "English Opening: Caro-Kann Defensive System, Bled Variation", Moves: "c4 c6 Nf3 d5 b3"},
+	"rn1qkb1r/pp2pppp/2p2n2/3p1b2/2P5/1P3NP1/P2PPP1P/RNBQKB1R w KQkq -":    {ECO: "A12", Name: "English Opening: Caro-Kann Defensive System, Bogoljubov Variation", Moves: "c4 c6 Nf3 d5 b3 Nf6 g3 Bf5"},
+	"rnbqkbnr/pppp1ppp/4p3/8/2P5/8/PP1PPPPP/RNBQKBNR w KQkq -":             {ECO: "A13", Name: "English Opening: Agincourt Defense", Moves: "c4 e6"},
+	"rnbqkb1r/ppp2ppp/4pn2/3p4/2P5/5NP1/PP1PPP1P/RNBQKB1R w KQkq -":        {ECO: "A13", Name: "English Opening: Agincourt Defense, Catalan Defense", Moves: "c4 e6 Nf3 d5 g3 Nf6"},
+	"rnbqkb1r/2pp1ppp/p3pn2/1p6/2P5/5NP1/PP1PPPBP/RNBQK2R w KQkq -":        {ECO: "A13", Name: "English Opening: Romanishin Gambit", Moves: "c4 e6 Nf3 Nf6 g3 a6 Bg2 b5"},
+	"rnbqk2r/ppp1bppp/4pn2/3p4/2P5/5NP1/PP1PPPBP/RNBQ1RK1 b kq -":          {ECO: "A14", Name: "English Opening: Agincourt Defense, Neo-Catalan Declined", Moves: "c4 e6 Nf3 d5 g3 Nf6 Bg2 Be7 O-O"},
+	"rnbqkb1r/pppppppp/5n2/8/2P5/8/PP1PPPPP/RNBQKBNR w KQkq -":             {ECO: "A15", Name: "English Opening: Anglo-Indian Defense", Moves: "c4 Nf6"},
+	"rnbqkb1r/pppppp1p/5np1/8/2P5/5N2/PP1PPPPP/RNBQKB1R w KQkq -":          {ECO: "A15", Name: "English Opening: Anglo-Indian Defense, King's Indian Formation", Moves: "c4 Nf6 Nf3 g6"},
+	"rnbqkb1r/pppppppp/5n2/8/2P5/2N5/PP1PPPPP/R1BQKBNR b KQkq -":           {ECO: "A16", Name: "English Opening: Anglo-Indian Defense, Queen's Knight Variation", Moves: "c4 Nf6 Nc3"},
+	"rnbqkb1r/ppp1pppp/5n2/3p4/2P5/2N5/PP1PPPPP/R1BQKBNR w KQkq -":         {ECO: "A16", Name: "English Opening: Anglo-Grünfeld Defense", Moves: "c4 Nf6 Nc3 d5"},
+	"rnbqkb1r/pppp1ppp/4pn2/8/2P5/2N5/PP1PPPPP/R1BQKBNR w KQkq -":          {ECO: "A17", Name: "English Opening: Anglo-Indian Defense, Hedgehog System", Moves: "c4 Nf6 Nc3 e6"},
+	"rnbqk2r/pppp1ppp/4pn2/8/1bP5/2N2N2/PP1PPPPP/R1BQKB1R w KQkq -":        {ECO: "A17", Name: "English Opening: Anglo-Indian Defense, Nimzo-English", Moves: "c4 Nf6 Nc3 e6 Nf3 Bb4"},
+	"rnbqkb1r/pppp1ppp/4pn2/8/2P1P3/2N5/PP1P1PPP/R1BQKBNR b KQkq -":        {ECO: "A18", Name: "English Opening: Mikenas-Carls Variation", Moves: "c4 Nf6 Nc3 e6 e4"},
+	"rnbqkb1r/ppp2ppp/4pn2/3pP3/2P5/2N5/PP1P1PPP/R1BQKBNR b KQkq -":        {ECO: "A18", Name: "English Opening: Mikenas-Carls Variation, Flohr Variation", Moves: "c4 Nf6 Nc3 e6 e4 d5 e5"},
+	"rnbqkb1r/pp1p1ppp/4pn2/2p5/2P1P3/2N5/PP1P1PPP/R1BQKBNR w KQkq -":      {ECO: "A19", Name: "English Opening: Mikenas-Carls Variation, Sicilian Variation", Moves: "c4 Nf6 Nc3 e6 e4 c5"},
+	"rnbqkbnr/pppp1ppp/8/4p3/2P5/8/PP1PPPPP/RNBQKBNR w KQkq -":             {ECO: "A20", Name: "English Opening: King's English Variation", Moves: "c4 e5"},
+	"rnbqkbnr/pppp1ppp/8/4p3/2P5/5N2/PP1PPPPP/RNBQKB1R b KQkq -":           {ECO: "A20", Name: "English Opening: King's English Variation, Nimzowitsch Variation", Moves: "c4 e5 Nf3"},
+	"rnbqkbnr/pppp1ppp/8/4p3/2P5/2N5/PP1PPPPP/R1BQKBNR b KQkq -":           {ECO: "A21", Name: "English Opening: King's English Variation, Reversed Sicilian", Moves: "c4 e5 Nc3"},
+	"rnbqkbnr/pp3ppp/2pp4/4p3/2P5/2N3P1/PP1PPP1P/R1BQKBNR w KQkq -":        {ECO: "A21", Name: "English Opening: King's English Variation, Keres Defense", Moves: "c4 e5 Nc3 d6 g3 c6"},
+	"rnbqkb1r/pppp1ppp/5n2/4p3/2P5/2N5/PP1PPPPP/R1BQKBNR w KQkq -":         {ECO: "A22", Name: "English Opening: King's English Variation, Two Knights Variation", Moves: "c4 e5 Nc3 Nf6"},
+	"rnbqk2r/pppp1ppp/5n2/4p3/1bP5/2N3P1/PP1PPP1P/R1BQKBNR w KQkq -":       {ECO: "A22", Name: "English Opening: King's English Variation, Bremen-Hort Variation", Moves: "c4 e5 Nc3 Nf6 g3 Bb4"},
+	"rnbqkb1r/pp1p1ppp/2p2n2/4p3/2P5/2N3P1/PP1PPP1P/R1BQKBNR w KQkq -":     {ECO: "A23", Name: "English Opening: King's English Variation, Two Knights Variation, Keres Variation", Moves: "c4 e5 Nc3 Nf6 g3 c6"},
+	"rnbqkb1r/pppp1p1p/5np1/4p3/2P5/2N3P1/PP1PPP1P/R1BQKBNR w KQkq -":      {ECO: "A24", Name: "English Opening: King's English Variation, Bremen System with ...g6", Moves: "c4 e5 Nc3 Nf6 g3 g6"},
+	"r1bqkbnr/pppp1ppp/2n5/4p3/2P5/2N5/PP1PPPPP/R1BQKBNR w KQkq -":         {ECO: "A25", Name: "English Opening: King's English Variation, Closed System", Moves: "c4 e5 Nc3 Nc6"},
+	"r1bqk1nr/pppp1pbp/2n3p1/4p3/2P5/2N3P1/PP1PPPBP/R1BQK1NR w KQkq -":     {ECO: "A25", Name: "English Opening: King's English Variation, Reversed Closed Sicilian", Moves: "c4 e5 Nc3 Nc6 g3 g6 Bg2 Bg7"},
+	"r1bqk1nr/ppp2pbp/2np2p1/4p3/2P1P3/2NP2P1/PP3PBP/R1BQK1NR b KQkq -":    {ECO: "A26", Name: "English Opening: King's English Variation, Botvinnik System", Moves: "c4 e5 Nc3 Nc6 g3 g6 Bg2 Bg7 d3 d6 e4"},
+	"r1bqk1nr/ppp2pbp/2np2p1/4p3/2P5/2NP2P1/PP2PPBP/R1BQK1NR w KQkq -":     {ECO: "A26", Name: "English Opening: King's English Variation, Closed System", Moves: "c4 e5 Nc3 Nc6 g3 g6 Bg2 Bg7 d3 d6"},
+	"r1bqkbnr/pppp1ppp/2n5/4p3/2P5/2N2N2/PP1PPPPP/R1BQKB1R b KQkq -":       {ECO: "A27", Name: "English Opening: King's English Variation, Three Knights System", Moves: "c4 e5 Nc3 Nc6 Nf3"},
+	"r1bqkb1r/pppp1ppp/2n2n2/4p3/2P5/2N2N2/PP1PPPPP/R1BQKB1R w KQkq -":     {ECO: "A28", Name: "English Opening: King's English Variation, Four Knights Variation", Moves: "c4 e5 Nc3 Nc6 Nf3 Nf6"},
+	"r1bqkb1r/pppp1ppp/2n2n2/4p3/2P5/2NP1N2/PP2PPPP/R1BQKB1R b KQkq -":     {ECO: "A28", Name: "English Opening: King's English Variation, Four Knights Variation, Flexible Line", Moves: "c4 e5 Nc3 Nc6 Nf3 Nf6 d3"},
+	"r1bqkb1r/pppp1ppp/2n2n2/4p3/2P5/2N1PN2/PP1P1PPP/R1BQKB1R b KQkq -":    {ECO: "A28", Name: "English Opening: King's English Variation, Four Knights Variation, Quiet Line", Moves: "c4 e5 Nc3 Nc6 Nf3 Nf6 e3"},
+	"r1bqkb1r/pppp1ppp/2n2n2/4p3/2P5/2N2NP1/PP1PPP1P/R1BQKB1R b KQkq -":    {ECO: "A29", Name: "English Opening: King's English Variation, Four Knights Variation, Fianchetto Line", Moves: "c4 e5 Nc3 Nc6 Nf3 Nf6 g3"},
+	"rnbqkbnr/pp1ppppp/8/2p5/2P5/8/PP1PPPPP/RNBQKBNR w KQkq -":             {ECO: "A30", Name: "English Opening: Symmetrical Variation", Moves: "c4 c5"},
+	"rn1qk2r/pb1pbppp/1p2pn2/2p5/2P5/2N2NP1/PP1PPPBP/R1BQ1RK1 w kq -":      {ECO: "A30", Name: "English Opening: Symmetrical Variation, Hedgehog Defense", Moves: "c4 c5 Nf3 Nf6 g3 b6 Bg2 Bb7 O-O e6 Nc3 Be7"},
+	"rnbqkb1r/pp1ppppp/5n2/2p5/2PP4/5N2/PP2PPPP/RNBQKB1R b KQkq -":         {ECO: "A31", Name: "English Opening: Symmetrical Variation, Anti-Benoni Variation", Moves: "c4 c5 Nf3 Nf6 d4"},
+	"rnbqkb1r/pp1p1ppp/4pn2/8/2PN4/8/PP2PPPP/RNBQKB1R w KQkq -":            {ECO: "A32", Name: "English Opening: Symmetrical Variation, Anti-Benoni Variation, Spielmann Defense", Moves: "c4 c5 Nf3 Nf6 d4 cxd4 Nxd4 e6"},
+	"r1bqkb1r/pp1p1ppp/2n1pn2/8/2PN4/2N5/PP2PPPP/R1BQKB1R w KQkq -":        {ECO: "A33", Name: "English Opening: Symmetrical Variation, Anti-Benoni Variation, Geller Variation", Moves: "c4 c5 Nf3 Nf6 d4 cxd4 Nxd4 e6 Nc3 Nc6"},
+	"rnbqkbnr/pp1ppppp/8/2p5/2P5/2N5/PP1PPPPP/R1BQKBNR b KQkq -":           {ECO: "A34", Name: "English Opening: Symmetrical Variation, Normal Variation", Moves: "c4 c5 Nc3"},
+	"rnbqkb1r/ppn1pppp/8/2p5/8/2N3P1/PP1PPPBP/R1BQK1NR w KQkq -":           {ECO: "A34", Name: "English Opening: Symmetrical Variation, Rubinstein Variation", Moves: "c4 c5 Nc3 Nf6 g3 d5 cxd5 Nxd5 Bg2 Nc7"},
+	"r1bqkbnr/pp1ppppp/2n5/2p5/2P5/2N5/PP1PPPPP/R1BQKBNR w KQkq -":         {ECO: "A35", Name: "English Opening: Symmetrical Variation, Two Knights Variation", Moves: "c4 c5 Nc3 Nc6"},
+	"r1bqk1nr/pp1pppbp/2n3p1/2p5/2P1P3/2N3P1/PP1P1PBP/R1BQK1NR b KQkq -":   {ECO: "A36", Name: "English Opening: Symmetrical Variation, Botvinnik System", Moves: "c4 c5 Nc3 Nc6 g3 g6 Bg2 Bg7 e4"},
+	"r1bqk1nr/pp1pppbp/2n3p1/2p5/2P5/2N3P1/PP1PPPBP/R1BQK1NR w KQkq -":     {ECO: "A36", Name: "English Opening: Symmetrical Variation, Symmetrical Variation", Moves: "c4 c5 Nc3 Nc6 g3 g6 Bg2 Bg7"},
+	"r1bqk1nr/pp1pppbp/2n3p1/2p5/2P5/2N2NP1/PP1PPPBP/R1BQK2R b KQkq -":     {ECO: "A37", Name: "English Opening: Symmetrical Variation, Two Knights Line", Moves: "c4 c5 Nc3 Nc6 g3 g6 Bg2 Bg7 Nf3"},
+	"r1bqk2r/pp1pppbp/2n2np1/2p5/2P5/2N2NP1/PP1PPPBP/R1BQK2R w KQkq -":     {ECO: "A38", Name: "English Opening: Symmetrical Variation, Full Symmetry Line", Moves: "c4 c5 Nc3 Nc6 g3 g6 Bg2 Bg7 Nf3 Nf6"},
+	"r1bq1rk1/pp1pppbp/2n2np1/2p5/2PP4/2N2NP1/PP2PPBP/R1BQ1RK1 b - -":      {ECO: "A39", Name: "English Opening: Symmetrical Variation, Mecking Variation", Moves: "c4 c5 Nc3 Nc6 g3 g6 Bg2 Bg7 Nf3 Nf6 O-O O-O d4"},
+	"rnbqkbnr/pppppppp/8/8/3P4/8/PPP1PPPP/RNBQKBNR b KQkq -":               {ECO: "A40", Name: "Queen's Pawn Game", Moves: "d4"},
+	"rnbqkbnr/pppp1ppp/8/4p3/3P4/8/PPP1PPPP/RNBQKBNR w KQkq -":             {ECO: "A40", Name: "Englund Gambit", Moves: "d4 e5"},
+	"rnbqkbnr/pppppp1p/6p1/8/3P4/8/PPP1PPPP/RNBQKBNR w KQkq -":             {ECO: "A40", Name: "Modern Defense", Moves: "d4 g6"},
+	"rnbqkbnr/pppp1ppp/4p3/8/3P4/8/PPP1PPPP/RNBQKBNR w KQkq -":             {ECO: "A40", Name: "Horwitz Defense", Moves: "d4 e6"},
+	"rnbqkbnr/p1pppppp/8/1p6/3P4/8/PPP1PPPP/RNBQKBNR w KQkq -":             {ECO: "A40", Name: "Polish Defense", Moves: "d4 b5"},
+	"rnbqk1nr/pppp1ppp/4p3/8/1bPP4/8/PP2PPPP/RNBQKBNR w KQkq -":            {ECO: "A40", Name: "Queen's Pawn Game: Keres Defense", Moves: "d4 e6 c4 Bb4+"},
+	"rnbqk1nr/ppppbppp/4p3/8/2PP4/8/PP1BPPPP/RN1QKBNR w KQkq -":            {ECO: "A40", Name: "Kangaroo Defense", Moves: "d4 e6 c4 Bb4+ Bd2 Be7"},
+	"rnbqkbnr/ppp1pppp/3p4/8/3P4/8/PPP1PPPP/RNBQKBNR w KQkq -":             {ECO: "A41", Name: "Queen's Pawn Game: Modern Defense", Moves: "d4 d6"},
+	"rn1qkbnr/ppp1pppp/3p4/8/3P2b1/5N2/PPP1PPPP/RNBQKB1R w KQkq -":         {ECO: "A41", Name: "Old Indian Defense: Tartakower-Indian", Moves: "d4 d6 Nf3 Bg4"},
+	"rnbqkbnr/ppp2ppp/3p4/4p3/2PP4/8/PP2PPPP/RNBQKBNR w KQkq -":            {ECO: "A41", Name: "Rat Defense: English Rat", Moves: "d4 d6 c4 e5"},
+	"rnbqk1nr/ppp1ppbp/3p2p1/8/2PPP3/2N5/PP3PPP/R1BQKBNR b KQkq -":         {ECO: "A42", Name: "Modern Defense: Averbakh System", Moves: "d4 d6 c4 g6 Nc3 Bg7 e4"},
+	"r1bqk1nr/ppp1ppbp/2np2p1/8/2PPP3/2N5/PP3PPP/R1BQKBNR w KQkq -":        {ECO: "A42", Name: "Modern Defense: Averbakh System, Kotov Variation", Moves: "d4 d6 c4 g6 Nc3 Bg7 e4 Nc6"},
+	"rnbqkbnr/pp1ppppp/8/2p5/3P4/8/PPP1PPPP/RNBQKBNR w KQkq -":             {ECO: "A43", Name: "Benoni Defense: Old Benoni", Moves: "d4 c5"},
+	"rnbqkb1r/pp2pp1p/3p1np1/2pP4/4P3/2N5/PPP2PPP/R1BQKBNR w KQkq -":       {ECO: "A43", Name: "Benoni Defense: Old Benoni, Schmid Variation", Moves: "d4 c5 d5 Nf6 Nc3 d6 e4 g6"},
+	"rnbqkbnr/pp1p1ppp/8/2pPp3/8/8/PPP1PPPP/RNBQKBNR w KQkq e6":            {ECO: "A44", Name: "Old Benoni Defense", Moves: "d4 c5 d5 e5"},
+	"rnbqkbnr/pp3ppp/3p4/2pPp3/4P3/8/PPP2PPP/RNBQKBNR w KQkq -":            {ECO: "A44", Name: "Benoni Defense: Semi-Benoni", Moves: "d4 c5 d5 e5 e4 d6"},
+	"rnbqkb1r/pppppppp/5n2/8/3P4/8/PPP1PPPP/RNBQKBNR w KQkq -":             {ECO: "A45", Name: "Indian Defense", Moves: "d4 Nf6"},
+	"rnbqkb1r/pppppppp/5n2/6B1/3P4/8/PPP1PPPP/RN1QKBNR b KQkq -":           {ECO: "A45", Name: "Trompowsky Attack", Moves: "d4 Nf6 Bg5"},
+	"rnbqkb1r/pppppppp/5n2/8/3PP3/8/PPP2PPP/RNBQKBNR b KQkq -":             {ECO: "A45", Name: "Indian Defense: Omega Gambit", Moves: "d4 Nf6 e4"},
+	"rnbqkb1r/pppppppp/8/8/3Pn2B/8/PPP1PPPP/RN1QKBNR b KQkq -":             {ECO: "A45", Name: "Trompowsky Attack: Edge Variation", Moves: "d4 Nf6 Bg5 Ne4 Bh4"},
+	"rnbqkb1r/pppppppp/5n2/8/3P4/5N2/PPP1PPPP/RNBQKB1R b KQkq -":           {ECO: "A46", Name: "Indian Defense: Knights Variation", Moves: "d4 Nf6 Nf3"},
+	"rnbqkb1r/pppp1ppp/4pn2/6B1/3P4/5N2/PPP1PPPP/RN1QKB1R b KQkq -":        {ECO: "A46", Name: "Torre Attack", Moves: "d4 Nf6 Nf3 e6 Bg5"},
+	"rnbqkb1r/pppp1ppp/4pn2/8/3P1B2/5N2/PPP1PPPP/RN1QKB1R b KQkq -":        {ECO: "A46", Name: "London System", Moves: "d4 Nf6 Nf3 e6 Bf4"},
+	"rnbqkb1r/pp1ppppp/5n2/2p5/3P4/5N2/PPP1PPPP/RNBQKB1R w KQkq -":         {ECO: "A46", Name: "Indian Defense: Spielmann-Indian", Moves: "d4 Nf6 Nf3 c5"},
+	"rnbqkb1r/ppp1pppp/3p1n2/8/3P4/5N2/PPP1PPPP/RNBQKB1R w KQkq -":         {ECO: "A46", Name: "Indian Defense: Wade-Tartakower Defense", Moves: "d4 Nf6 Nf3 d6"},
+	"rnbqkb1r/p1pppppp/1p3n2/8/3P4/5N2/PPP1PPPP/RNBQKB1R w KQkq -":         {ECO: "A47", Name: "Queen's Indian Defense", Moves: "d4 Nf6 Nf3 b6"},
+	"rn1qkb1r/pb1ppppp/1p3n2/2p5/3P4/5NP1/PPP1PPBP/RNBQK2R w KQkq -":       {ECO: "A47", Name: "Queen's Indian Defense: Marienbad System", Moves: "d4 Nf6 Nf3 b6 g3 Bb7 Bg2 c5"},
+	"rnbqkb1r/pppppp1p/5np1/8/3P4/5N2/PPP1PPPP/RNBQKB1R w KQkq -":          {ECO: "A48", Name: "East Indian Defense", Moves: "d4 Nf6 Nf3 g6"},
+	"rnbqkb1r/pppppp1p/5np1/8/3P1B2/5N2/PPP1PPPP/RN1QKB1R b KQkq -":        {ECO: "A48", Name: "London System", Moves: "d4 Nf6 Nf3 g6 Bf4"},
+	"rnbqkb1r/pppppp1p/5np1/8/3P4/4PN2/PPP2PPP/RNBQKB1R b KQkq -":          {ECO: "A48", Name: "East Indian Defense: Colle System", Moves: "d4 Nf6 Nf3 g6 e3"},
+	"rnbqkb1r/pppppp1p/5np1/6B1/3P4/5N2/PPP1PPPP/RN1QKB1R b KQkq -":        {ECO: "A48", Name: "Torre Attack: Fianchetto Defense", Moves: "d4 Nf6 Nf3 g6 Bg5"},
+	"rnbqkb1r/pppppp1p/5np1/8/3P4/5NP1/PPP1PP1P/RNBQKB1R b KQkq -":         {ECO: "A49", Name: "Indian Defense: Przepiórka Variation", Moves: "d4 Nf6 Nf3 g6 g3"},
+	"rnbqkb1r/pppppppp/5n2/8/2PP4/8/PP2PPPP/RNBQKBNR b KQkq -":             {ECO: "A50", Name: "Indian Defense: Normal Variation", Moves: "d4 Nf6 c4"},
+	"rnbqkb1r/pppppp1p/5n2/6p1/2PP4/8/PP2PPPP/RNBQKBNR w KQkq -":           {ECO: "A50", Name: "Indian Defense: Medusa Gambit", Moves: "d4 Nf6 c4 g5"},
+	"r1bqkb1r/pppppppp/2n2n2/8/2PP4/8/PP2PPPP/RNBQKBNR w KQkq -":           {ECO: "A50", Name: "Mexican Defense", Moves: "d4 Nf6 c4 Nc6"},
+	"rnbqkb1r/p1pppppp/1p3n2/8/2PP4/8/PP2PPPP/RNBQKBNR w KQkq -":           {ECO: "A50", Name: "Queen's Indian Accelerated", Moves: "d4 Nf6 c4 b6"},
+	"rnbqkb1r/pppp1ppp/5n2/4p3/2PP4/8/PP2PPPP/RNBQKBNR w KQkq -":           {ECO: "A51", Name: "Budapest Defense", Moves: "d4 Nf6 c4 e5"},
+	"rnbqkb1r/pppp1ppp/8/4P3/2P1n3/8/PP2PPPP/RNBQKBNR w KQkq -":            {ECO: "A51", Name: "Budapest Defense: Fajarowicz Variation", Moves: "d4 Nf6 c4 e5 dxe5 Ne4"},
+	"rnbqkb1r/pppp1ppp/8/4P3/2P3n1/5N2/PP2PPPP/RNBQKB1R b KQkq -":          {ECO: "A52", Name: "Budapest Defense: Adler Variation", Moves: "d4 Nf6 c4 e5 dxe5 Ng4 Nf3"},
+	"rnbqkb1r/pppp1ppp/8/4P3/2P2Bn1/8/PP2PPPP/RN1QKBNR b KQkq -":           {ECO: "A52", Name: "Budapest Defense: Rubinstein Variation", Moves: "d4 Nf6 c4 e5 dxe5 Ng4 Bf4"},
+	"rnbqkb1r/pppp1ppp/8/4P3/2P1P1n1/8/PP3PPP/RNBQKBNR b KQkq -":           {ECO: "A52", Name: "Budapest Defense: Alekhine Variation", Moves: "d4 Nf6 c4 e5 dxe5 Ng4 e4"},
+	"rnbqkb1r/ppp1pppp/3p1n2/8/2PP4/8/PP2PPPP/RNBQKBNR w KQkq -":           {ECO: "A53", Name: "Old Indian Defense", Moves: "d4 Nf6 c4 d6"},
+	"rn1qkb1r/ppp1pppp/3p1n2/5b2/2PP4/2N5/PP2PPPP/R1BQKBNR w KQkq -":       {ECO: "A53", Name: "Old Indian Defense: Janowski Variation", Moves: "d4 Nf6 c4 d6 Nc3 Bf5"},
+	"rnbqkb1r/ppp2ppp/3p1n2/4p3/2PP4/2N2N2/PP2PPPP/R1BQKB1R b KQkq -":      {ECO: "A54", Name: "Old Indian Defense: Two Knights Variation", Moves: "d4 Nf6 c4 d6 Nc3 e5 Nf3"},
+	"r1bqkb1r/pppn1ppp/3p1n2/4p3/2PP4/2NBP3/PP3PPP/R1BQK1NR b KQkq -":      {ECO: "A54", Name: "Old Indian Defense: Dus-Khotimirsky Variation", Moves: "d4 Nf6 c4 d6 Nc3 e5 e3 Nbd7 Bd3"},
+	"r1bqkb1r/pppn1ppp/3p1n2/4p3/2PPP3/2N2N2/PP3PPP/R1BQKB1R b KQkq -":     {ECO: "A55", Name: "Old Indian Defense: Normal Variation", Moves: "d4 Nf6 c4 d6 Nc3 e5 Nf3 Nbd7 e4"},
+	"rnbqkb1r/pp1ppppp/5n2/2p5/2PP4/8/PP2PPPP/RNBQKBNR w KQkq -":           {ECO: "A56", Name: "Benoni Defense", Moves: "d4 Nf6 c4 c5"},
+	"rnbqkb1r/pp1p1ppp/5n2/2pPp3/2P5/8/PP2PPPP/RNBQKBNR w KQkq e6":         {ECO: "A56", Name: "Benoni Defense: Czech Benoni Defense", Moves: "d4 Nf6 c4 c5 d5 e5"},
+	"rnbqkb1r/pp1ppppp/8/2pP4/2P1n3/8/PP2PPPP/RNBQKBNR w KQkq -":           {ECO: "A56", Name: "Vulture Defense", Moves: "d4 Nf6 c4 c5 d5 Ne4"},
+	"rnbqkb1r/p2ppppp/5n2/1ppP4/2P5/8/PP2PPPP/RNBQKBNR w KQkq -":           {ECO: "A57", Name: "Benko Gambit", Moves: "d4 Nf6 c4 c5 d5 b5"},
+	"rnbqkb1r/p2ppppp/5n2/1ppP4/2P5/5N2/PP2PPPP/RNBQKB1R b KQkq -":         {ECO: "A57", Name: "Benko Gambit Declined: Main Line", Moves: "d4 Nf6 c4 c5 d5 b5 Nf3"},
+	"rnbqkb1r/3ppppp/p4n2/1PpP4/8/2N5/PP2PPPP/R1BQKBNR b KQkq -":           {ECO: "A57", Name: "Benko Gambit: Zaitsev System", Moves: "d4 Nf6 c4 c5 d5 b5 cxb5 a6 Nc3"},
+	"rnbqkb1r/3ppppp/P4n2/2pP4/8/8/PP2PPPP/RNBQKBNR b KQkq -":              {ECO: "A58", Name: "Benko Gambit Accepted", Moves: "d4 Nf6 c4 c5 d5 b5 cxb5 a6 bxa6"},
+	"rn1qkb1r/4pppp/b2p1n2/2pP4/8/2N5/PP2PPPP/R1BQKBNR w KQkq -":           {ECO: "A58", Name: "Benko Gambit Accepted: Fully Accepted Variation", Moves: "d4 Nf6 c4 c5 d5 b5 cxb5 a6 bxa6 Bxa6 Nc3 d6"},
+	"rn1qkb1r/4pppp/3p1n2/2pP4/4P3/2N5/PP3PPP/R1BQ1KNR b kq -":             {ECO: "A59", Name: "Benko Gambit Accepted: King Walk Variation", Moves: "d4 Nf6 c4 c5 d5 b5 cxb5 a6 bxa6 Bxa6 Nc3 d6 e4 Bxf1 Kxf1"},
+	"rnbqkb1r/pp1p1ppp/4pn2/2pP4/2P5/8/PP2PPPP/RNBQKBNR w KQkq -":          {ECO: "A60", Name: "Benoni Defense: Modern Variation", Moves: "d4 Nf6 c4 c5 d5 e6"},
+	"rnbqk2r/pp1p1ppp/3b1n2/2pP4/8/2N5/PP2PPPP/R1BQKBNR w KQkq -":          {ECO: "A60", Name: "Benoni Defense: Snake Variation", Moves: "d4 Nf6 c4 c5 d5 e6 Nc3 exd5 cxd5 Bd6"},
+	"rnbqkb1r/pp3p1p/3p1np1/2pP4/8/2N2N2/PP2PPPP/R1BQKB1R w KQkq -":        {ECO: "A61", Name: "Benoni Defense: Modern Variation", Moves: "d4 Nf6 c4 c5 d5 e6 Nc3 exd5 cxd5 d6 Nf3 g6"},
+	"rnbqkb1r/pp3p1p/3p1np1/2pP2B1/8/2N2N2/PP2PPPP/R2QKB1R b KQkq -":       {ECO: "A61", Name: "Benoni Defense: Uhlmann Variation", Moves: "d4 Nf6 c4 c5 d5 e6 Nc3 exd5 cxd5 d6 Nf3 g6 Bg5"},
+	"rnbqkb1r/pp3p1p/3p1np1/2pP4/8/2N5/PP1NPPPP/R1BQKB1R b KQkq -":         {ECO: "A61", Name: "Benoni Defense: Knight's Tour Variation", Moves: "d4 Nf6 c4 c5 d5 e6 Nc3 exd5 cxd5 d6 Nf3 g6 Nd2"},
+	"rnbq1rk1/pp3pbp/3p1np1/2pP4/8/2N2NP1/PP2PPBP/R1BQ1RK1 b - -":          {ECO: "A62", Name: "Benoni Defense: Fianchetto Variation", Moves: "d4 Nf6 c4 c5 d5 e6 Nc3 exd5 cxd5 d6 Nf3 g6 g3 Bg7 Bg2 O-O O-O"},
+	"r1bq1rk1/pp1n1pbp/3p1np1/2pP4/8/2N2NP1/PP2PPBP/R1BQ1RK1 w - -":        {ECO: "A63", Name: "Benoni Defense: Fianchetto Variation, Hastings Defense", Moves: "d4 Nf6 c4 c5 d5 e6 Nc3 exd5 cxd5 d6 Nf3 g6 g3 Bg7 Bg2 O-O O-O Nbd7"},
+	"r1bqr1k1/1p1n1pbp/p2p1np1/2pP4/P7/2N3P1/1P1NPPBP/R1BQ1RK1 w - -":      {ECO: "A64", Name: "Benoni Defense: Fianchetto Variation, Hastings Defense, Main Line", Moves: "d4 Nf6 c4 c5 d5 e6 Nc3 exd5 cxd5 d6 Nf3 g6 g3 Bg7 Bg2 O-O O-O Nbd7 Nd2 a6 a4 Re8"},
+	"rnbqkb1r/pp3ppp/3p1n2/2pP4/4P3/2N5/PP3PPP/R1BQKBNR b KQkq -":          {ECO: "A65", Name: "Benoni Defense: King's Pawn Line", Moves: "d4 Nf6 c4 c5 d5 e6 Nc3 exd5 cxd5 d6 e4"},
+	"rnbqkb1r/pp3p1p/3p1np1/2pP4/4P3/2N5/PP3PPP/R1BQKBNR w KQkq -":         {ECO: "A65", Name: "Benoni Defense: Six Pawns Attack", Moves: "d4 Nf6 c4 c5 d5 e6 Nc3 exd5 cxd5 d6 e4 g6"},
+	"rnbqkb1r/pp3p1p/3p1np1/2pP4/4PP2/2N5/PP4PP/R1BQKBNR b KQkq -":         {ECO: "A66", Name: "Benoni Defense: Pawn Storm Variation", Moves: "d4 Nf6 c4 c5 d5 e6 Nc3 exd5 cxd5 d6 e4 g6 f4"},
+	"rnbqk2r/pp3pbp/3p1np1/2pPP3/5P2/2N5/PP4PP/R1BQKBNR b KQkq -":          {ECO: "A66", Name: "Benoni Defense: Mikenas Variation", Moves: "d4 Nf6 c4 c5 d5 e6 Nc3 exd5 cxd5 d6 e4 g6 f4 Bg7 e5"},
+	"rnbqk2r/pp3pbp/3p1np1/1BpP4/4PP2/2N5/PP4PP/R1BQK1NR b KQkq -":         {ECO: "A67", Name: "Benoni Defense: Taimanov Variation", Moves: "d4 Nf6 c4 c5 d5 e6 Nc3 exd5 cxd5 d6 e4 g6 f4 Bg7 Bb5+"},
+	"rnbqk2r/pp1n1pbp/3p2p1/1BpP4/P3PP2/2N5/1P4PP/R1BQK1NR b KQkq -":       {ECO: "A67", Name: "Benoni Defense: Taimanov Variation, Main Line", Moves: "d4 Nf6 c4 c5 d5 e6 Nc3 exd5 cxd5 d6 e4 g6 f4 Bg7 Bb5+ Nfd7 a4"},
+	"rnbq1rk1/pp3pbp/3p1np1/2pP4/4PP2/2N2N2/PP4PP/R1BQKB1R w KQ -":         {ECO: "A68", Name: "Benoni Defense: Four Pawns Attack", Moves: "d4 Nf6 c4 c5 d5 e6 Nc3 exd5 cxd5 d6 e4 g6 f4 Bg7 Nf3 O-O"},
+	"rnbqr1k1/pp3pbp/3p1np1/2pP4/4PP2/2N2N2/PP2B1PP/R1BQK2R w KQ -":        {ECO: "A69", Name: "Benoni Defense: Four Pawns Attack, Main Line", Moves: "d4 Nf6 c4 c5 d5 e6 Nc3 exd5 cxd5 d6 e4 g6 f4 Bg7 Nf3 O-O Be2 Re8"},
+	"rnbqkb1r/pp3p1p/3p1np1/2pP4/4P3/2N2N2/PP3PPP/R1BQKB1R b KQkq -":       {ECO: "A70", Name: "Benoni Defense: Classical Variation", Moves: "d4 Nf6 c4 c5 d5 e6 Nc3 exd5 cxd5 d6 e4 g6 Nf3"},
+	"rnbqk2r/pp3pbp/3p1np1/2pP4/4PB2/2N2N2/PP3PPP/R2QKB1R b KQkq -":        {ECO: "A70", Name: "Benoni Defense: Classical Variation, New York Variation", Moves: "d4 Nf6 c4 c5 d5 e6 Nc3 exd5 cxd5 d6 e4 g6 Nf3 Bg7 Bf4"},
+	"rnbqk2r/pp3pbp/3p1np1/2pP2B1/4P3/2N2N2/PP3PPP/R2QKB1R b KQkq -":       {ECO: "A71", Name: "Benoni Defense: Classical Variation, Averbakh-Grivas Attack", Moves: "d4 Nf6 c4 c5 d5 e6 Nc3 exd5 cxd5 d6 e4 g6 Nf3 Bg7 Bg5"},
+	"rnbq1rk1/pp3pbp/3p1np1/2pP4/4P3/2N2N2/PP2BPPP/R1BQK2R w KQ -":         {ECO: "A72", Name: "Benoni Defense: Classical Variation, 8.Be2", Moves: "d4 Nf6 c4 c5 d5 e6 Nc3 exd5 cxd5 d6 e4 g6 Nf3 Bg7 Be2 O-O"},
+	"rnbq1rk1/pp3pbp/3p1np1/2pP4/4P3/2N2N2/PP2BPPP/R1BQ1RK1 b - -":         {ECO: "A73", Name: "Benoni Defense: Classical Variation, Main Line", Moves: "d4 Nf6 c4 c5 d5 e6 Nc3 exd5 cxd5 d6 e4 g6 Nf3 Bg7 Be2 O-O O-O"},
+	"rnbq1rk1/1p3pbp/p2p1np1/2pP4/P3P3/2N2N2/1P2BPPP/R1BQ1RK1 b - -":       {ECO: "A74", Name: "Benoni Defense: Classical Variation, Full Line", Moves: "d4 Nf6 c4 c5 d5 e6 Nc3 exd5 cxd5 d6 e4 g6 Nf3 Bg7 Be2 O-O O-O a6 a4"},
+	"rn1q1rk1/1p3pbp/p2p1np1/2pP4/P3P1b1/2N2N2/1P2BPPP/R1BQ1RK1 w - -":     {ECO: "A75", Name: "Benoni Defense: Classical Variation, Argentine Counterattack", Moves: "d4 Nf6 c4 c5 d5 e6 Nc3 exd5 cxd5 d6 e4 g6 Nf3 Bg7 Be2 O-O O-O a6 a4 Bg4"},
+	"rnbqr1k1/pp3pbp/3p1np1/2pP4/4P3/2N2N2/PP2BPPP/R1BQ1RK1 w - -":         {ECO: "A76", Name: "Benoni Defense: Classical Variation, Czerniak Defense", Moves: "d4 Nf6 c4 c5 d5 e6 Nc3 exd5 cxd5 d6 e4 g6 Nf3 Bg7 Be2 O-O O-O Re8"},
+	"rnbqr1k1/pp3pbp/3p1np1/2pP4/4P3/2N5/PP1NBPPP/R1BQ1RK1 b - -":          {ECO: "A77", Name: "Benoni Defense: Classical Variation, Czerniak Defense, Tal Line", Moves: "d4 Nf6 c4 c5 d5 e6 Nc3 exd5 cxd5 d6 e4 g6 Nf3 Bg7 Be2 O-O O-O Re8 Nd2"},
+	"r1bqr1k1/pp3pbp/n2p1np1/2pP4/4P3/2N5/PP1NBPPP/R1BQ1RK1 w - -":         {ECO: "A78", Name: "Benoni Defense: Classical Variation, Czerniak Defense, 10...Na6", Moves: "d4 Nf6 c4 c5 d5 e6 Nc3 exd5 cxd5 d6 e4 g6 Nf3 Bg7 Be2 O-O O-O Re8 Nd2 Na6"},
+	"r1bqr1k1/pp3pbp/n2p1np1/2pP4/4P3/2N2P2/PP1NB1PP/R1BQ1RK1 b - -":       {ECO: "A79", Name: "Benoni Defense: Classical Variation, Czerniak Defense, 11.f3", Moves: "d4 Nf6 c4 c5 d5 e6 Nc3 exd5 cxd5 d6 e4 g6 Nf3 Bg7 Be2 O-O O-O Re8 Nd2 Na6 f3"},
+	"rnbqkbnr/ppppp1pp/8/5p2/3P4/8/PPP1PPPP/RNBQKBNR w KQkq -":             {ECO: "A80", Name: "Dutch Defense", Moves: "d4 f5"},
+	"rnbqkbnr/ppppp1pp/8/5p2/3P4/3Q4/PPP1PPPP/RNB1KBNR b KQkq -":           {ECO: "A80", Name: "Dutch Defense: Manhattan Gambit", Moves: "d4 f5 Qd3"},
+	"rnbqkbnr/ppppp1pp/8/5p2/3P4/7P/PPP1PPP1/RNBQKBNR b KQkq -":            {ECO: "A80", Name: "Dutch Defense: Korchnoi Attack", Moves: "d4 f5 h3"},
+	"rnbqkbnr/ppp1p1pp/3p4/5p2/3P2P1/3Q4/PPP1PP1P/RNB1KBNR b KQkq -":       {ECO: "A80", Name: "Dutch Defense: Alapin Variation", Moves: "d4 f5 Qd3 d6 g4"},
+	"rnbqkbnr/ppppp1pp/8/5p2/3P4/6P1/PPP1PP1P/RNBQKBNR b KQkq -":           {ECO: "A81", Name: "Dutch Defense: Fianchetto Attack", Moves: "d4 f5 g3"},
+	"rnbqkb1r/pppp2pp/4pn2/5p2/3P4/6PN/PPP1PPBP/RNBQK2R b KQkq -":          {ECO: "A81", Name: "Dutch Defense: Blackburne Variation", Moves: "d4 f5 g3 Nf6 Bg2 e6 Nh3"},
+	"rnbqkbnr/ppppp1pp/8/5p2/3PP3/8/PPP2PPP/RNBQKBNR b KQkq -":             {ECO: "A82", Name: "Dutch Defense: Staunton Gambit", Moves: "d4 f5 e4"},
+	"rnbqkbnr/ppp1p1pp/3p4/5p2/3PP3/8/PPP2PPP/RNBQKBNR w KQkq -":           {ECO: "A82", Name: "Dutch Defense: Balogh Defense", Moves: "d4 f5 e4 d6"},
+	"rnbqkb1r/ppppp1pp/5n2/6B1/3Pp3/2N5/PPP2PPP/R2QKBNR b KQkq -":          {ECO: "A83", Name: "Dutch Defense: Staunton Gambit Accepted", Moves: "d4 f5 e4 fxe4 Nc3 Nf6 Bg5"},
+	"rnbqkb1r/ppppp2p/5np1/6B1/3Pp3/2N2P2/PPP3PP/R2QKBNR b KQkq -":         {ECO: "A83", Name: "Dutch Defense: Staunton Gambit, Lasker Variation", Moves: "d4 f5 e4 fxe4 Nc3 Nf6 Bg5 g6 f3"},
+	"rnbqkbnr/ppppp1pp/8/5p2/2PP4/8/PP2PPPP/RNBQKBNR b KQkq -":             {ECO: "A84", Name: "Dutch Defense: Normal Variation", Moves: "d4 f5 c4"},
+	"rnbqkb1r/ppppp1pp/5n2/5p2/2PP4/2N5/PP2PPPP/R1BQKBNR b KQkq -":         {ECO: "A85", Name: "Dutch Defense: Queen's Knight Variation", Moves: "d4 f5 c4 Nf6 Nc3"},
+	"rnbqkb1r/pppp2pp/4pn2/5p2/2PP4/2N5/PPQ1PPPP/R1B1KBNR b KQkq -":        {ECO: "A85", Name: "Dutch Defense: Rubinstein Variation", Moves: "d4 f5 c4 e6 Nc3 Nf6 Qc2"},
+	"rnbqkb1r/ppppp1pp/5n2/5p2/2PP4/6P1/PP2PP1P/RNBQKBNR b KQkq -":         {ECO: "A86", Name: "Dutch Defense: Fianchetto Variation", Moves: "d4 f5 c4 Nf6 g3"},
+	"rnbqk2r/ppppp1bp/5np1/5p2/2PP4/6PN/PP2PPBP/RNBQK2R b KQkq -":          {ECO: "A86", Name: "Dutch Defense: Leningrad Variation, Karlsbad Variation", Moves: "d4 f5 c4 Nf6 g3 g6 Bg2 Bg7 Nh3"},
+	"rnbqk2r/ppppp1bp/5np1/5p2/2PP4/5NP1/PP2PPBP/RNBQK2R b KQkq -":         {ECO: "A87", Name: "Dutch Defense: Leningrad Variation", Moves: "d4 f5 c4 Nf6 g3 g6 Bg2 Bg7 Nf3"},
+	"rnbq1rk1/ppp1p1bp/3p1np1/5p2/2PP4/5NP1/PP2PPBP/RNBQ1RK1 w - -":        {ECO: "A87", Name: "Dutch Defense: Leningrad Variation, Main Line", Moves: "d4 f5 c4 Nf6 g3 g6 Bg2 Bg7 Nf3 O-O O-O d6"},
+	"rnbq1rk1/pp2p1bp/2pp1np1/5p2/2PP4/2N2NP1/PP2PPBP/R1BQ1RK1 w - -":      {ECO: "A88", Name: "Dutch Defense: Leningrad Variation, Warsaw Variation", Moves: "d4 f5 c4 Nf6 g3 g6 Bg2 Bg7 Nf3 O-O O-O d6 Nc3 c6"},
+	"r1bq1rk1/ppp1p1bp/2np1np1/5p2/2PP4/2N2NP1/PP2PPBP/R1BQ1RK1 w - -":     {ECO: "A89", Name: "Dutch Defense: Leningrad Variation, Matulović Variation", Moves: "d4 f5 c4 Nf6 g3 g6 Bg2 Bg7 Nf3 O-O O-O d6 Nc3 Nc6"},
+	"rnbqkb1r/pppp2pp/4pn2/5p2/2PP4/6P1/PP2PPBP/RNBQK1NR b KQkq -":         {ECO: "A90", Name: "Dutch Defense: Classical Variation", Moves: "d4 f5 c4 Nf6 g3 e6 Bg2"},
+	"rnbqkb1r/ppp3pp/4pn2/3p1p2/2PP4/6P1/PP2PPBP/RNBQK1NR w KQkq -":        {ECO: "A90", Name: "Dutch Defense: Stonewall Variation", Moves: "d4 f5 c4 Nf6 g3 e6 Bg2 d5"},
+	"rnbqk2r/pppp2pp/4pn2/5p2/1bPP4/6P1/PP2PPBP/RNBQK1NR w KQkq -":         {ECO: "A90", Name: "Dutch Defense: Nimzo-Dutch Variation", Moves: "d4 f5 c4 Nf6 g3 e6 Bg2 Bb4+"},
+	"rnbqk2r/ppppb1pp/4pn2/5p2/2PP4/6PN/PP2PPBP/RNBQK2R b KQkq -":          {ECO: "A91", Name: "Dutch Defense: Blackburne Variation", Moves: "d4 f5 c4 Nf6 g3 e6 Bg2 Be7 Nh3"},
+	"rnbqk2r/ppppb1pp/4pn2/5p2/2PP4/6P1/PP2PPBP/RNBQK1NR w KQkq -":         {ECO: "A92", Name: "Dutch Defense: Classical Variation", Moves: "d4 f5 c4 Nf6 g3 e6 Bg2 Be7"},
+	"rnbq1rk1/ppp1b1pp/4pn2/3p1p2/2PP4/5NP1/PP2PPBP/RNBQ1RK1 w - -":        {ECO: "A92", Name: "Dutch Defense: Stonewall Variation", Moves: "d4 f5 c4 Nf6 g3 e6 Bg2 Be7 Nf3 O-O O-O d5"},
+	"rnbq1rk1/ppp1b1pp/4pn2/3p1p2/2PP4/1P3NP1/P3PPBP/RNBQ1RK1 b - -":       {ECO: "A93", Name: "Dutch Defense: Stonewall Variation, Botvinnik Variation", Moves: "d4 f5 c4 Nf6 g3 e6 Bg2 Be7 Nf3 O-O O-O d5 b3"},
+	"rnbq1rk1/pp2b1pp/2p1pn2/3p1p2/2PP4/BP3NP1/P3PPBP/RN1Q1RK1 b - -":      {ECO: "A94", Name: "Dutch Defense: Stonewall Variation, Modern Variation", Moves: "d4 f5 c4 Nf6 g3 e6 Bg2 Be7 Nf3 O-O O-O d5 b3 c6 Ba3"},
+	"rnbq1rk1/pp2b1pp/2p1pn2/3p1p2/2PP4/2N2NP1/PP2PPBP/R1BQ1RK1 w - -":     {ECO: "A95", Name: "Dutch Defense: Stonewall Variation, Main Line", Moves: "d4 f5 c4 Nf6 g3 e6 Bg2 Be7 Nf3 O-O O-O d5 Nc3 c6"},
+	"rnbq1rk1/ppp1b1pp/3ppn2/5p2/2PP4/5NP1/PP2PPBP/RNBQ1RK1 w - -":         {ECO: "A96", Name: "Dutch Defense: Classical Variation, Main Line", Moves: "d4 f5 c4 Nf6 g3 e6 Bg2 Be7 Nf3 O-O O-O d6"},
+	"rnb1qrk1/ppp1b1pp/3ppn2/5p2/2PP4/2N2NP1/PP2PPBP/R1BQ1RK1 w - -":       {ECO: "A97", Name: "Dutch Defense: Ilyin-Zhenevsky Variation", Moves: "d4 f5 c4 Nf6 g3 e6 Bg2 Be7 Nf3 O-O O-O d6 Nc3 Qe8"},
+	"rnb1qrk1/ppp1b1pp/3ppn2/5p2/2PP4/2N2NP1/PPQ1PPBP/R1B2RK1 b - -":       {ECO: "A98", Name: "Dutch Defense: Ilyin-Zhenevsky Variation, Alatortsev-Lisitsyn Line", Moves: "d4 f5 c4 Nf6 g3 e6 Bg2 Be7 Nf3 O-O O-O d6 Nc3 Qe8 Qc2"},
+	"rnb1qrk1/ppp1b1pp/3ppn2/5p2/2PP4/1PN2NP1/P3PPBP/R1BQ1RK1 b - -":       {ECO: "A99", Name: "Dutch Defense: Ilyin-Zhenevsky Variation, Modern Main Line", Moves: "d4 f5 c4 Nf6 g3 e6 Bg2 Be7 Nf3 O-O O-O d6 Nc3 Qe8 b3"},
+	"rnbqkbnr/pppppppp/8/8/4P3/8/PPPP1PPP/RNBQKBNR b KQkq -":               {ECO: "B00", Name: "King's Pawn Game", Moves: "e4"},
+	"r1bqkbnr/pppppppp/2n5/8/4P3/8/PPPP1PPP/RNBQKBNR w KQkq -":             {ECO: "B00", Name: "Nimzowitsch Defense", Moves: "e4 Nc6"},
+	"rnbqkbnr/p1pppppp/1p6/8/4P3/8/PPPP1PPP/RNBQKBNR w KQkq -":             {ECO: "B00", Name: "Owen Defense", Moves: "e4 b6"},
+	"rnbqkbnr/1ppppppp/p7/8/4P3/8/PPPP1PPP/RNBQKBNR w KQkq -":              {ECO: "B00", Name: "St. George Defense", Moves: "e4 a6"},
+	"rnbqkbnr/pppppp1p/8/6p1/4P3/8/PPPP1PPP/RNBQKBNR w KQkq -":             {ECO: "B00", Name: "Borg Defense", Moves: "e4 g5"},
+	"rnbqkbnr/ppppppp1/7p/8/4P3/8/PPPP1PPP/RNBQKBNR w KQkq -":              {ECO: "B00", Name: "Carr Defense", Moves: "e4 h6"},
+	"r1bqkbnr/pppppppp/2n5/8/4P3/5N2/PPPP1PPP/RNBQKB1R b KQkq -":           {ECO: "B00", Name: "Nimzowitsch Defense: Declined Variation", Moves: "e4 Nc6 Nf3"},
+	"r1bqkbnr/ppp1pppp/2n5/3p4/3PP3/8/PPP2PPP/RNBQKBNR w KQkq -":           {ECO: "B00", Name: "Nimzowitsch Defense: Scandinavian Variation", Moves: "e4 Nc6 d4 d5"},
+	"r1bqkbnr/ppp1pppp/2np4/8/4P3/5N2/PPPP1PPP/RNBQKB1R w KQkq -":          {ECO: "B00", Name: "Nimzowitsch Defense: Williams Variation", Moves: "e4 Nc6 Nf3 d6"},
+	"r1bqk1nr/1ppnppbp/p2p2p1/8/3PP3/2N1B3/PPPQ1PPP/R3KBNR w KQkq -":       {ECO: "B00", Name: "Hippopotamus Defense", Moves: "e4 g6 d4 Bg7 Nc3 d6 Be3 a6 Qd2 Nd7"},
+	"rnbqkbnr/ppp1pppp/8/3p4/4P3/8/PPPP1PPP/RNBQKBNR w KQkq -":             {ECO: "B01", Name: "Scandinavian Defense", Moves: "e4 d5"},
+	"rnb1kbnr/ppp1pppp/8/3q4/8/8/PPPP1PPP/RNBQKBNR w KQkq -":               {ECO: "B01", Name: "Scandinavian Defense: Mieses-Kotroc Variation", Moves: "e4 d5 exd5 Qxd5"},
+	"rnb1kbnr/ppp1pppp/8/q7/8/2N5/PPPP1PPP/R1BQKBNR w KQkq -":              {ECO: "B01", Name: "Scandinavian Defense: Main Line", Moves: "e4 d5 exd5 Qxd5 Nc3 Qa5"},
+	"rnbqkbnr/ppp1pppp/8/8/8/2N5/PPPP1PPP/R1BQKBNR w KQkq -":               {ECO: "B01", Name: "Scandinavian Defense: Valencian Variation", Moves: "e4 d5 exd5 Qxd5 Nc3 Qd8"},
+	"rnbqkb1r/ppp1pppp/5n2/3P4/8/8/PPPP1PPP/RNBQKBNR w KQkq -":             {ECO: "B01", Name: "Scandinavian Defense: Modern Variation", Moves: "e4 d5 exd5 Nf6"},
+	"rnbqkb1r/ppp2ppp/4pn2/3P4/2P5/8/PP1P1PPP/RNBQKBNR w KQkq -":           {ECO: "B01", Name: "Scandinavian Defense: Icelandic-Palme Gambit", Moves: "e4 d5 exd5 Nf6 c4 e6"},
+	"rn1qkb1r/ppp1pppp/5n2/3P4/3P2b1/8/PPP2PPP/RNBQKBNR w KQkq -":          {ECO: "B01", Name: "Scandinavian Defense: Portuguese Gambit", Moves: "e4 d5 exd5 Nf6 d4 Bg4"},
+	"rnb1kbnr/ppp1pppp/3q4/8/8/2N5/PPPP1PPP/R1BQKBNR w KQkq -":             {ECO: "B01", Name: "Scandinavian Defense: Gubinsky-Melts Defense", Moves: "e4 d5 exd5 Qxd5 Nc3 Qd6"},
+	"rn2kb1r/ppp1pppp/5n2/q4b2/3P4/2N2N2/PPP2PPP/R1BQKB1R w KQkq -":        {ECO: "B01", Name: "Scandinavian Defense: Classical Variation", Moves: "e4 d5 exd5 Qxd5 Nc3 Qa5 d4 Nf6 Nf3 Bf5"},
+	"rnbqkb1r/pppppppp/5n2/8/4P3/8/PPPP1PPP/RNBQKBNR w KQkq -":             {ECO: "B02", Name: "Alekhine Defense", Moves: "e4 Nf6"},
+	"rnbqkb1r/ppp1pppp/5n2/3p4/4P3/2N5/PPPP1PPP/R1BQKBNR w KQkq -":         {ECO: "B02", Name: "Alekhine Defense: Scandinavian Variation", Moves: "e4 Nf6 Nc3 d5"},
+	"rnbqkb1r/pppppppp/1n6/2P1P3/8/8/PP1P1PPP/RNBQKBNR b KQkq -":           {ECO: "B02", Name: "Alekhine Defense: Two Pawns Attack", Moves: "e4 Nf6 e5 Nd5 c4 Nb6 c5"},
+	"rnbqkb1r/pppppppp/5n2/8/4P3/3P4/PPP2PPP/RNBQKBNR b KQkq -":            {ECO: "B02", Name: "Alekhine Defense: Maróczy Variation", Moves: "e4 Nf6 d3"},
+	"rnbqkb1r/pppppppp/8/3nP3/3P4/8/PPP2PPP/RNBQKBNR b KQkq -":             {ECO: "B03", Name: "Alekhine Defense", Moves: "e4 Nf6 e5 Nd5 d4"},
+	"rnbqkb1r/ppp1pppp/1n1p4/4P3/2PP1P2/8/PP4PP/RNBQKBNR b KQkq -":         {ECO: "B03", Name: "Alekhine Defense: Four Pawns Attack", Moves: "e4 Nf6 e5 Nd5 d4 d6 c4 Nb6 f4"},
+	"rnbqkb1r/ppp1pppp/1n1P4/8/2PP4/8/PP3PPP/RNBQKBNR b KQkq -":            {ECO: "B03", Name: "Alekhine Defense: Exchange Variation", Moves: "e4 Nf6 e5 Nd5 d4 d6 c4 Nb6 exd6"},
+	"r2qkb1r/ppp2ppp/1nn1p3/4Pb2/2PP4/2N1BN2/PP4PP/R2QKB1R b KQkq -":       {ECO: "B03", Name: "Alekhine Defense: Four Pawns Attack, Main Line", Moves: "e4 Nf6 e5 Nd5 d4 d6 c4 Nb6 f4 dxe5 fxe5 Nc6 Be3 Bf5 Nc3 e6 Nf3"},
+	"rnbqkb1r/ppp1pppp/3p4/3nP3/3P4/5N2/PPP2PPP/RNBQKB1R b KQkq -":         {ECO: "B04", Name: "Alekhine Defense: Modern Variation", Moves: "e4 Nf6 e5 Nd5 d4 d6 Nf3"},
+	"rnbqkb1r/ppp1pppp/8/3np3/3P4/5N2/PPP2PPP/RNBQKB1R w KQkq -":           {ECO: "B04", Name: "Alekhine Defense: Modern Variation, Larsen Variation", Moves: "e4 Nf6 e5 Nd5 d4 d6 Nf3 dxe5"},
+	"rnbqkb1r/ppp1pppp/1n1p4/4P3/3P4/5N2/PPP2PPP/RNBQKB1R w KQkq -":        {ECO: "B04", Name: "Alekhine Defense: Modern Variation, Schmid Variation", Moves: "e4 Nf6 e5 Nd5 d4 d6 Nf3 Nb6"},
+	"rn1qkb1r/ppp1pppp/3p4/3nP3/3P2b1/5N2/PPP2PPP/RNBQKB1R w KQkq -":       {ECO: "B05", Name: "Alekhine Defense: Modern Variation, Main Line", Moves: "e4 Nf6 e5 Nd5 d4 d6 Nf3 Bg4"},
+	"rn1qkb1r/pp2pppp/2pp4/3nP3/3P2b1/5N2/PPP1BPPP/RNBQK2R w KQkq -":       {ECO: "B05", Name: "Alekhine Defense: Modern Variation, Flohr Variation", Moves: "e4 Nf6 e5 Nd5 d4 d6 Nf3 Bg4 Be2 c6"},
+	"rnbqkbnr/pppppp1p/6p1/8/4P3/8/PPPP1PPP/RNBQKBNR w KQkq -":             {ECO: "B06", Name: "Modern Defense", Moves: "e4 g6"},
+	"rnbqk1nr/ppppppbp/6p1/8/3PP3/8/PPP2PPP/RNBQKBNR w KQkq -":             {ECO: "B06", Name: "Modern Defense: Standard Line", Moves: "e4 g6 d4 Bg7"},
+	"rnbqk1nr/ppppppbp/6p1/8/3PPP2/8/PPP3PP/RNBQKBNR b KQkq -":             {ECO: "B06", Name: "Modern Defense: Three Pawns Attack", Moves: "e4 g6 d4 Bg7 f4"},
+	"r1bqk1nr/ppp2pbp/2np2p1/4p3/2PPP3/2N1B3/PP2NPPP/R2QKB1R b KQkq -":     {ECO: "B06", Name: "Modern Defense: Pseudo-Austrian Attack", Moves: "e4 g6 d4 Bg7 c4 d6 Nc3 Nc6 Be3 e5 Nge2"},
+	"rnbqk1nr/ppp1ppbp/3p2p1/8/3PP3/2N5/PPP2PPP/R1BQKBNR w KQkq -":         {ECO: "B06", Name: "Robatsch Defense: Pirc Variation", Moves: "e4 g6 d4 Bg7 Nc3 d6"},
+	"rnbqk1nr/pp2ppb1/2p3p1/3pP2p/3P1P2/2N5/PPP3PP/R1BQKBNR w KQkq -":      {ECO: "B06", Name: "Modern Defense: Gurgenidze Defense", Moves: "e4 g6 d4 Bg7 Nc3 c6 f4 d5 e5 h5"},
+	"rnbqkbnr/ppp1pppp/3p4/8/4P3/8/PPPP1PPP/RNBQKBNR w KQkq -":             {ECO: "B07", Name: "Pirc Defense", Moves: "e4 d6"},
+	"rnbqkb1r/ppp1pppp/3p1n2/8/3PP3/2N5/PPP2PPP/R1BQKBNR b KQkq -":         {ECO: "B07", Name: "Pirc Defense", Moves: "e4 d6 d4 Nf6 Nc3"},
+	"rnbqkb1r/ppp1pp1p/3p1np1/8/3PP3/2N5/PPP2PPP/R1BQKBNR w KQkq -":        {ECO: "B07", Name: "Pirc Defense: Main Line", Moves: "e4 d6 d4 Nf6 Nc3 g6"},
+	"rnbqkb1r/pp2pp1p/2pp1np1/8/3PP3/2N1B3/PPPQ1PPP/R3KBNR b KQkq -":       {ECO: "B07", Name: "Pirc Defense: 150 Attack", Moves: "e4 d6 d4 Nf6 Nc3 g6 Be3 c6 Qd2"},
+	"rnbqkb1r/ppp1pp1p/3p1np1/6B1/3PP3/2N5/PPP2PPP/R2QKBNR b KQkq -":       {ECO: "B07", Name: "Pirc Defense: Byrne Variation", Moves: "e4 d6 d4 Nf6 Nc3 g6 Bg5"},
+	"rnbqkb1r/ppp1pp1p/3p1np1/8/3PP3/2N5/PPP1BPPP/R1BQK1NR b KQkq -":       {ECO: "B07", Name: "Pirc Defense: Classical Variation, Quiet System", Moves: "e4 d6 d4 Nf6 Nc3 g6 Be2"},
+	"rnbqkb1r/ppp1pp1p/3p1np1/8/3PP3/2N2N2/PPP2PPP/R1BQKB1R b KQkq -":      {ECO: "B08", Name: "Pirc Defense: Classical Variation", Moves: "e4 d6 d4 Nf6 Nc3 g6 Nf3"},
+	"rnbqk2r/ppp1ppbp/3p1np1/8/3PP3/2N2N1P/PPP2PP1/R1BQKB1R b KQkq -":      {ECO: "B08", Name: "Pirc Defense: Classical Variation, Schlechter Variation", Moves: "e4 d6 d4 Nf6 Nc3 g6 Nf3 Bg7 h3"},
+	"rnbq1rk1/ppp1ppbp/3p1np1/8/3PP3/2N2N2/PPP1BPPP/R1BQ1RK1 b - -":        {ECO: "B08", Name: "Pirc Defense: Classical Variation, Main Line", Moves: "e4 d6 d4 Nf6 Nc3 g6 Nf3 Bg7 Be2 O-O O-O"},
+	"rnbqkb1r/ppp1pp1p/3p1np1/8/3PPP2/2N5/PPP3PP/R1BQKBNR b KQkq -":        {ECO: "B09", Name: "Pirc Defense: Austrian Attack", Moves: "e4 d6 d4 Nf6 Nc3 g6 f4"},
+	"rnbq1rk1/ppp1ppbp/3p1np1/8/3PPP2/2NB1N2/PPP3PP/R1BQK2R b KQ -":        {ECO: "B09", Name: "Pirc Defense: Austrian Attack, Weiss Variation", Moves: "e4 d6 d4 Nf6 Nc3 g6 f4 Bg7 Nf3 O-O Bd3"},
+	"rnbqk2r/pp2ppbp/3p1np1/2p5/3PPP2/2N2N2/PPP3PP/R1BQKB1R w KQkq -":      {ECO: "B09", Name: "Pirc Defense: Austrian Attack, Dragon Formation", Moves: "e4 d6 d4 Nf6 Nc3 g6 f4 Bg7 Nf3 c5"},
+	"rnbqkbnr/pp1ppppp/2p5/8/4P3/8/PPPP1PPP/RNBQKBNR w KQkq -":             {ECO: "B10", Name: "Caro-Kann Defense", Moves: "e4 c6"},
+	"rnbqkbnr/pp2pppp/2p5/3p4/4P3/2N2N2/PPPP1PPP/R1BQKB1R b KQkq -":        {ECO: "B10", Name: "Caro-Kann Defense: Two Knights Attack", Moves: "e4 c6 Nc3 d5 Nf3"},
+	"rnbqkbnr/pp1ppppp/2p5/8/2P1P3/8/PP1P1PPP/RNBQKBNR b KQkq -":           {ECO: "B10", Name: "Caro-Kann Defense: Accelerated Panov Attack", Moves: "e4 c6 c4"},
+	"rnbqkbnr/pp1ppppp/2p5/8/4P3/3P4/PPP2PPP/RNBQKBNR b KQkq -":            {ECO: "B10", Name: "Caro-Kann Defense: Breyer Variation", Moves: "e4 c6 d3"},
+	"rn1qkbnr/pp2pppp/2p5/3p4/4P1b1/2N2N2/PPPP1PPP/R1BQKB1R w KQkq -":      {ECO: "B11", Name: "Caro-Kann Defense: Two Knights Attack, Mindeno Variation", Moves: "e4 c6 Nc3 d5 Nf3 Bg4"},
+	"rn1qkbnr/pp2pppp/2p5/3p4/4P3/2N2Q1P/PPPP1PP1/R1B1KB1R b KQkq -":       {ECO: "B11", Name: "Caro-Kann Defense: Two Knights Attack, Mindeno Variation, Exchange Line", Moves: "e4 c6 Nc3 d5 Nf3 Bg4 h3 Bxf3 Qxf3"},
+	"rnbqkbnr/pp1ppppp/2p5/8/3PP3/8/PPP2PPP/RNBQKBNR b KQkq -":             {ECO: "B12", Name: "Caro-Kann Defense", Moves: "e4 c6 d4"},
+	"rnbqkbnr/pp2pppp/2p5/3p4/3PP3/8/PPP2PPP/RNBQKBNR w KQkq -":            {ECO: "B12", Name: "Caro-Kann Defense", Moves: "e4 c6 d4 d5"},
+	"rnbqkbnr/pp2pppp/2p5/3pP3/3P4/8/PPP2PPP/RNBQKBNR b KQkq -":            {ECO: "B12", Name: "Caro-Kann Defense: Advance Variation", Moves: "e4 c6 d4 d5 e5"},
+	"rn1qkbnr/pp3ppp/2p1p3/3pPb2/3P4/5N2/PPP1BPPP/RNBQK2R b KQkq -":        {ECO: "B12", Name: "Caro-Kann Defense: Advance Variation, Short Variation", Moves: "e4 c6 d4 d5 e5 Bf5 Nf3 e6 Be2"},
+	"rnbqkbnr/pp2pppp/8/2ppP3/3P4/8/PPP2PPP/RNBQKBNR w KQkq -":             {ECO: "B12", Name: "Caro-Kann Defense: Advance Variation, Botvinnik-Carls Defense", Moves: "e4 c6 d4 d5 e5 c5"},
+	"rn1qkbnr/pp2pppp/2p5/3pPb2/3P2P1/8/PPP2P1P/RNBQKBNR b KQkq -":         {ECO: "B12", Name: "Caro-Kann Defense: Advance Variation, Bayonet Attack", Moves: "e4 c6 d4 d5 e5 Bf5 g4"},
+	"rn1qkbnr/pp2pppp/2p5/3pPb2/3P3P/8/PPP2PP1/RNBQKBNR b KQkq -":          {ECO: "B12", Name: "Caro-Kann Defense: Advance Variation, Tal Variation", Moves: "e4 c6 d4 d5 e5 Bf5 h4"},
+	"rn1qkbnr/pp2pppp/2p5/3pPb2/3P4/2N5/PPP2PPP/R1BQKBNR b KQkq -":         {ECO: "B12", Name: "Caro-Kann Defense: Advance Variation, Van der Wiel Attack", Moves: "e4 c6 d4 d5 e5 Bf5 Nc3"},
+	"rnbqkbnr/pp2pppp/8/3p4/3P4/8/PPP2PPP/RNBQKBNR w KQkq -":               {ECO: "B13", Name: "Caro-Kann Defense: Exchange Variation", Moves: "e4 c6 d4 d5 exd5 cxd5"},
+	"rnbqkbnr/pp2pppp/8/3p4/2PP4/8/PP3PPP/RNBQKBNR b KQkq -":               {ECO: "B13", Name: "Caro-Kann Defense: Panov Attack", Moves: "e4 c6 d4 d5 exd5 cxd5 c4"},
+	"r1bqkb1r/pp2pppp/2n2n2/3p4/3P1B2/2PB4/PP3PPP/RN1QK1NR b KQkq -":       {ECO: "B13", Name: "Caro-Kann Defense: Exchange Variation, Rubinstein Variation", Moves: "e4 c6 d4 d5 exd5 cxd5 Bd3 Nc6 c3 Nf6 Bf4"},
+	"rnbqkb1r/pp3ppp/4pn2/3p4/2PP4/2N5/PP3PPP/R1BQKBNR w KQkq -":           {ECO: "B14", Name: "Caro-Kann Defense: Panov Attack", Moves: "e4 c6 d4 d5 exd5 cxd5 c4 Nf6 Nc3 e6"},
+	"rnbqkb1r/pp2pp1p/5np1/3p4/2PP4/2N5/PP3PPP/R1BQKBNR w KQkq -":          {ECO: "B14", Name: "Caro-Kann Defense: Panov Attack, Fianchetto Defense", Moves: "e4 c6 d4 d5 exd5 cxd5 c4 Nf6 Nc3 g6"},
+	"rnbqkbnr/pp2pppp/2p5/3p4/3PP3/2N5/PPP2PPP/R1BQKBNR b KQkq -":          {ECO: "B15", Name: "Caro-Kann Defense", Moves: "e4 c6 d4 d5 Nc3"},
+	"rnbqkbnr/pp2pppp/2p5/8/3PN3/8/PPP2PPP/R1BQKBNR b KQkq -":              {ECO: "B15", Name: "Caro-Kann Defense: Main Line", Moves: "e4 c6 d4 d5 Nc3 dxe4 Nxe4"},
+	"rnbqkb1r/pp3ppp/2p2p2/8/3P4/8/PPP2PPP/R1BQKBNR w KQkq -":              {ECO: "B15", Name: "Caro-Kann Defense: Tartakower Variation", Moves: "e4 c6 d4 d5 Nc3 dxe4 Nxe4 Nf6 Nxf6+ exf6"},
+	"rnbqkbnr/pp2pp1p/2p3p1/3p4/3PP3/2N5/PPP2PPP/R1BQKBNR w KQkq -":        {ECO: "B15", Name: "Caro-Kann Defense: Gurgenidze System", Moves: "e4 c6 d4 d5 Nc3 g6"},
+	"rnbqkb1r/pp2pppp/2p2n2/8/3PN3/3B4/PPP2PPP/R1BQK1NR b KQkq -":          {ECO: "B15", Name: "Caro-Kann Defense: Alekhine Gambit", Moves: "e4 c6 d4 d5 Nc3 dxe4 Nxe4 Nf6 Bd3"},
+	"rnbqkb1r/pp2pp1p/2p2p2/8/3P4/8/PPP2PPP/R1BQKBNR w KQkq -":             {ECO: "B16", Name: "Caro-Kann Defense: Bronstein-Larsen Variation", Moves: "e4 c6 d4 d5 Nc3 dxe4 Nxe4 Nf6 Nxf6+ gxf6"},
+	"r1bqkbnr/pp1npppp/2p5/8/3PN3/8/PPP2PPP/R1BQKBNR w KQkq -":             {ECO: "B17", Name: "Caro-Kann Defense: Karpov Variation", Moves: "e4 c6 d4 d5 Nc3 dxe4 Nxe4 Nd7"},
+	"r1bqkb1r/pp1npppp/2p2n2/6N1/3P4/3B4/PPP2PPP/R1BQK1NR b KQkq -":        {ECO: "B17", Name: "Caro-Kann Defense: Karpov Variation, Modern Main Line", Moves: "e4 c6 d4 d5 Nc3 dxe4 Nxe4 Nd7 Ng5 Ngf6 Bd3"},
+	"rn1qkbnr/pp2pppp/2p5/5b2/3PN3/8/PPP2PPP/R1BQKBNR w KQkq -":            {ECO: "B18", Name: "Caro-Kann Defense: Classical Variation", Moves: "e4 c6 d4 d5 Nc3 dxe4 Nxe4 Bf5"},
+	"rn1qkbnr/pp2pppp/2p3b1/8/3P4/6N1/PPP2PPP/R1BQKBNR w KQkq -":           {ECO: "B18", Name: "Caro-Kann Defense: Classical Variation, Main Line", Moves: "e4 c6 d4 d5 Nc3 dxe4 Nxe4 Bf5 Ng3 Bg6"},
+	"r2qkbnr/pp1nppp1/2p3bp/7P/3P4/5NN1/PPP2PP1/R1BQKB1R b KQkq -":         {ECO: "B19", Name: "Caro-Kann Defense: Classical Variation, Spassky Variation", Moves: "e4 c6 d4 d5 Nc3 dxe4 Nxe4 Bf5 Ng3 Bg6 h4 h6 Nf3 Nd7 h5"},
+	"rn1qkb1r/pp2ppp1/2p2nbp/8/3P3P/5NN1/PPP2PP1/R1BQKB1R w KQkq -":        {ECO: "B19", Name: "Caro-Kann Defense: Classical Variation, Seirawan Variation", Moves: "e4 c6 d4 d5 Nc3 dxe4 Nxe4 Bf5 Ng3 Bg6 h4 h6 Nf3 Nf6"},
+	"rnbqkbnr/pp1ppppp/8/2p5/4P3/8/PPPP1PPP/RNBQKBNR w KQkq -":             {ECO: "B20", Name: "Sicilian Defense", Moves: "e4 c5"},
+	"rnbqkbnr/pp1ppppp/8/2p5/2B1P3/8/PPPP1PPP/RNBQK1NR b KQkq -":           {ECO: "B20", Name: "Sicilian Defense: Bowdler Attack", Moves: "e4 c5 Bc4"},
+	"rnbqkbnr/pp1ppppp/8/2p5/1P2P3/8/P1PP1PPP/RNBQKBNR b KQkq -":           {ECO: "B20", Name: "Sicilian Defense: Wing Gambit", Moves: "e4 c5 b4"},
+	"rnbqkbnr/pp1ppppp/8/2p5/4P3/1P6/P1PP1PPP/RNBQKBNR b KQkq -":           {ECO: "B20", Name: "Sicilian Defense: Snyder Variation", Moves: "e4 c5 b3"},
+	"rnbqkbnr/pp1ppppp/8/2p5/2P1P3/8/PP1P1PPP/RNBQKBNR b KQkq -":           {ECO: "B20", Name: "Sicilian Defense: Staunton-Cochrane Variation", Moves: "e4 c5 c4"},
+	"rnbqkbnr/pp1ppppp/8/8/3pP3/2P5/PP3PPP/RNBQKBNR b KQkq -":              {ECO: "B21", Name: "Sicilian Defense: Smith-Morra Gambit", Moves: "e4 c5 d4 cxd4 c3"},
+	"rnbqkbnr/pp1ppppp/8/8/4P3/2N5/PP3PPP/R1BQKBNR b KQkq -":               {ECO: "B21", Name: "Sicilian Defense: Smith-Morra Gambit Accepted", Moves: "e4 c5 d4 cxd4 c3 dxc3 Nxc3"},
+	"rnbqkbnr/pp1ppppp/8/2p5/4PP2/8/PPPP2PP/RNBQKBNR b KQkq -":             {ECO: "B21", Name: "Sicilian Defense: McDonnell Attack", Moves: "e4 c5 f4"},
+	"2bqkbnr/r4ppp/p1npp3/1p6/4P3/1BN2N2/PP2QPPP/R1B2RK1 w k -":            {ECO: "B21", Name: "Sicilian Defense: Smith-Morra Gambit, Chicago Defense", Moves: "e4 c5 d4 cxd4 c3 dxc3 Nxc3 Nc6 Nf3 d6 Bc4 e6 O-O a6 Qe2 b5 Bb3 Ra7"},
+	"rnbqkbnr/pp1ppppp/8/2p5/4P3/2P5/PP1P1PPP/RNBQKBNR b KQkq -":           {ECO: "B22", Name: "Sicilian Defense: Alapin Variation", Moves: "e4 c5 c3"},
+	"rnbqkbnr/pp2pppp/8/2pp4/4P3/2P5/PP1P1PPP/RNBQKBNR w KQkq -":           {ECO: "B22", Name: "Sicilian Defense: Alapin Variation, Barmen Defense", Moves: "e4 c5 c3 d5"},
+	"rnbqkb1r/pp1ppppp/5n2/2p5/4P3/2P5/PP1P1PPP/RNBQKBNR w KQkq -":         {ECO: "B22", Name: "Sicilian Defense: Alapin Variation, Smith-Morra Declined", Moves: "e4 c5 c3 Nf6"},
+	"r1bqkb1r/pp1ppppp/1nn5/2p1P3/8/1BP2N2/PP1P1PPP/RNBQK2R b KQkq -":      {ECO: "B22", Name: "Sicilian Defense: Alapin Variation, Stoltz Attack", Moves: "e4 c5 c3 Nf6 e5 Nd5 Nf3 Nc6 Bc4 Nb6 Bb3"},
+	"rnbqkbnr/pp1ppppp/8/2p5/4P3/2N5/PPPP1PPP/R1BQKBNR b KQkq -":           {ECO: "B23", Name: "Sicilian Defense: Closed", Moves: "e4 c5 Nc3"},
+	"r1bqkbnr/pp1ppppp/2n5/2p5/4PP2/2N5/PPPP2PP/R1BQKBNR b KQkq -":         {ECO: "B23", Name: "Sicilian Defense: Grand Prix Attack", Moves: "e4 c5 Nc3 Nc6 f4"},
+	"r1bqkbnr/pp1ppppp/2n5/2p5/4P3/2N5/PPPPNPPP/R1BQKB1R b KQkq -":         {ECO: "B23", Name: "Sicilian Defense: Closed, Chameleon Variation", Moves: "e4 c5 Nc3 Nc6 Nge2"},
+	"r1bqk1nr/pp1p1pbp/2n1p1p1/2p2P2/2B1P3/2N2N2/PPPP2PP/R1BQK2R b KQkq -": {ECO: "B23", Name: "Sicilian Defense: Grand Prix Attack, Schofman Variation", Moves: "e4 c5 Nc3 Nc6 f4 g6 Nf3 Bg7 Bc4 e6 f5"},
+	"r1bqkbnr/pp1ppppp/2n5/2p5/4P3/2N3P1/PPPP1P1P/R1BQKBNR b KQkq -":       {ECO: "B24", Name: "Sicilian Defense: Closed", Moves: "e4 c5 Nc3 Nc6 g3"},
+	"r1bqk1nr/pp1p1pbp/4p1p1/2p5/3nP3/3PB1P1/PPP1NPBP/R2QK1NR b KQkq -":    {ECO: "B24", Name: "Sicilian Defense: Closed, Smyslov Variation", Moves: "e4 c5 Nc3 Nc6 g3 g6 Bg2 Bg7 d3 e6 Be3 Nd4 Nce2"},
+	"r1bqk1nr/pp2ppbp/2np2p1/2p5/4P3/2NP2P1/PPP2PBP/R1BQK1NR w KQkq -":     {ECO: "B25", Name: "Sicilian Defense: Closed", Moves: "e4 c5 Nc3 Nc6 g3 g6 Bg2 Bg7 d3 d6"},
+	"r1bqk1nr/pp3pbp/2np2p1/2p1p3/4PP2/2NP2P1/PPP3BP/R1BQK1NR w KQkq -":    {ECO: "B25", Name: "Sicilian Defense: Closed, Botvinnik Defense", Moves: "e4 c5 Nc3 Nc6 g3 g6 Bg2 Bg7 d3 d6 f4 e5"},
+	"r1bqk1nr/pp2ppbp/2np2p1/2p5/4P3/2NPB1P1/PPP2PBP/R2QK1NR b KQkq -":     {ECO: "B26", Name: "Sicilian Defense: Closed, 6.Be3", Moves: "e4 c5 Nc3 Nc6 g3 g6 Bg2 Bg7 d3 d6 Be3"},
+	"rnbqkbnr/pp1ppppp/8/2p5/4P3/5N2/PPPP1PPP/RNBQKB1R b KQkq -":           {ECO: "B27", Name: "Sicilian Defense", Moves: "e4 c5 Nf3"},
+	"rnbqkbnr/pp1ppp1p/6p1/2p5/4P3/5N2/PPPP1PPP/RNBQKB1R w KQkq -":         {ECO: "B27", Name: "Sicilian Defense: Hyperaccelerated Dragon", Moves: "e4 c5 Nf3 g6"},
+	"rnb1kbnr/ppqppppp/8/2p5/4P3/5N2/PPPP1PPP/RNBQKB1R w KQkq -":           {ECO: "B27", Name: "Sicilian Defense: Quinteros Variation", Moves: "e4 c5 Nf3 Qc7"},
+	"rnbqkbnr/p2ppppp/1p6/2p5/4P3/5N2/PPPP1PPP/RNBQKB1R w KQkq -":          {ECO: "B27", Name: "Sicilian Defense: Katalimov Variation", Moves: "e4 c5 Nf3 b6"},
+	"rnbqkbnr/1p1ppppp/p7/2p5/4P3/5N2/PPPP1PPP/RNBQKB1R w KQkq -":          {ECO: "B28", Name: "Sicilian Defense: O'Kelly Variation", Moves: "e4 c5 Nf3 a6"},
+	"rnbqkbnr/1p1ppppp/p7/2p5/2P1P3/5N2/PP1P1PPP/RNBQKB1R b KQkq -":        {ECO: "B28", Name: "Sicilian Defense: O'Kelly Variation, Maróczy Bind", Moves: "e4 c5 Nf3 a6 c4"},
+	"rnbqkb1r/pp1ppppp/5n2/2p5/4P3/5N2/PPPP1PPP/RNBQKB1R w KQkq -":         {ECO: "B29", Name: "Sicilian Defense: Nimzowitsch Variation", Moves: "e4 c5 Nf3 Nf6"},
+	"r1bqkb1r/pp1p1ppp/2n5/2ppP3/3P4/5N2/PPP2PPP/R1BQKB1R w KQkq -":        {ECO: "B29", Name: "Sicilian Defense: Nimzowitsch Variation, Rubinstein Countergambit", Moves: "e4 c5 Nf3 Nf6 e5 Nd5 Nc3 e6 Nxd5 exd5 d4 Nc6"},
+	"r1bqkbnr/pp1ppppp/2n5/2p5/4P3/5N2/PPPP1PPP/RNBQKB1R w KQkq -":         {ECO: "B30", Name: "Sicilian Defense: Old Sicilian", Moves: "e4 c5 Nf3 Nc6"},
+	"r1bqkbnr/pp1ppppp/2n5/1Bp5/4P3/5N2/PPPP1PPP/RNBQK2R b KQkq -":         {ECO: "B30", Name: "Sicilian Defense: Rossolimo Variation", Moves: "e4 c5 Nf3 Nc6 Bb5"},
+	"r1bqkbnr/pp1p1ppp/2n1p3/1Bp5/4P3/5N2/PPPP1PPP/RNBQK2R w KQkq -":       {ECO: "B30", Name: "Sicilian Defense: Nyezhmetdinov-Rossolimo Attack", Moves: "e4 c5 Nf3 Nc6 Bb5 e6"},
+	"r1bqkbnr/pp1ppp1p/2n3p1/1Bp5/4P3/5N2/PPPP1PPP/RNBQK2R w KQkq -":       {ECO: "B31", Name: "Sicilian Defense: Rossolimo Variation, Fianchetto Variation", Moves: "e4 c5 Nf3 Nc6 Bb5 g6"},
+	"r1bqk1nr/pp1p1pbp/2n3p1/1Bp1p3/1P2P3/5N2/P1PP1PPP/RNBQR1K1 b kq -":    {ECO: "B31", Name: "Sicilian Defense: Rossolimo Variation, Gurgenidze Variation", Moves: "e4 c5 Nf3 Nc6 Bb5 g6 O-O Bg7 Re1 e5 b4"},
+	"r1bqkbnr/pp1ppppp/2n5/8/3NP3/8/PPP2PPP/RNBQKB1R b KQkq -":             {ECO: "B32", Name: "Sicilian Defense: Open", Moves: "e4 c5 Nf3 Nc6 d4 cxd4 Nxd4"},
+	"r1bqkbnr/pp1p1ppp/2n5/4p3/3NP3/8/PPP2PPP/RNBQKB1R w KQkq -":           {ECO: "B32", Name: "Sicilian Defense: Löwenthal Variation", Moves: "e4 c5 Nf3 Nc6 d4 cxd4 Nxd4 e5"},
+	"r1bqkbnr/pp3ppp/2np4/1N2p3/4P3/8/PPP2PPP/RNBQKB1R w KQkq -":           {ECO: "B32", Name: "Sicilian Defense: Kalashnikov Variation", Moves: "e4 c5 Nf3 Nc6 d4 cxd4 Nxd4 e5 Nb5 d6"},
+	"r1b1kbnr/ppqppppp/2n5/8/3NP3/8/PPP2PPP/RNBQKB1R w KQkq -":             {ECO: "B32", Name: "Sicilian Defense: Flohr Variation", Moves: "e4 c5 Nf3 Nc6 d4 cxd4 Nxd4 Qc7"},
+	"r1bqkb1r/pp1ppppp/2n2n2/8/3NP3/2N5/PPP2PPP/R1BQKB1R b KQkq -":         {ECO: "B33", Name: "Sicilian Defense: Four Knights Variation", Moves: "e4 c5 Nf3 Nc6 d4 cxd4 Nxd4 Nf6 Nc3"},
+	"r1bqkb1r/pp1p1ppp/2n2n2/4p3/3NP3/2N5/PPP2PPP/R1BQKB1R w KQkq -":       {ECO: "B33", Name: "Sicilian Defense: Lasker-Pelikan Variation", Moves: "e4 c5 Nf3 Nc6 d4 cxd4 Nxd4 Nf6 Nc3 e5"},
+	"r1bqkb1r/5ppp/p1np1n2/1p2p1B1/4P3/N1N5/PPP2PPP/R2QKB1R w KQkq -":      {ECO: "B33", Name: "Sicilian Defense: Lasker-Pelikan Variation, Sveshnikov Variation", Moves: "e4 c5 Nf3 Nc6 d4 cxd4 Nxd4 Nf6 Nc3 e5 Ndb5 d6 Bg5 a6 Na3 b5"},
+	"r2qkb1r/1p3ppp/p1npbn2/4p1B1/4P3/N1N5/PPP2PPP/R2QKB1R w KQkq -":       {ECO: "B33", Name: "Sicilian Defense: Lasker-Pelikan Variation, Bird Variation", Moves: "e4 c5 Nf3 Nc6 d4 cxd4 Nxd4 Nf6 Nc3 e5 Ndb5 d6 Bg5 a6 Na3 Be6"},
+	"r1bqkbnr/pp1ppp1p/2n3p1/8/3NP3/8/PPP2PPP/RNBQKB1R w KQkq -":           {ECO: "B34", Name: "Sicilian Defense: Accelerated Dragon", Moves: "e4 c5 Nf3 Nc6 d4 cxd4 Nxd4 g6"},
+	"r1bqkbnr/pp1ppp1p/2N3p1/8/4P3/8/PPP2PPP/RNBQKB1R b KQkq -":            {ECO: "B34", Name: "Sicilian Defense: Accelerated Dragon, Exchange Variation", Moves: "e4 c5 Nf3 Nc6 d4 cxd4 Nxd4 g6 Nxc6"},
+	"r1bqkbnr/pp1ppp1p/2n3p1/8/3NP3/2N5/PPP2PPP/R1BQKB1R b KQkq -":         {ECO: "B34", Name: "Sicilian Defense: Accelerated Dragon, Modern Variation", Moves: "e4 c5 Nf3 Nc6 d4 cxd4 Nxd4 g6 Nc3"},
+	"r1bqk2r/pp1pppbp/2n2np1/8/2BNP3/2N1B3/PPP2PPP/R2QK2R b KQkq -":        {ECO: "B35", Name: "Sicilian Defense: Accelerated Dragon, Modern Bc4 Variation", Moves: "e4 c5 Nf3 Nc6 d4 cxd4 Nxd4 g6 Nc3 Bg7 Be3 Nf6 Bc4"},
+	"r1bqkbnr/pp1ppp1p/2n3p1/8/2PNP3/8/PP3PPP/RNBQKB1R b KQkq -":           {ECO: "B36", Name: "Sicilian Defense: Accelerated Dragon, Maróczy Bind", Moves: "e4 c5 Nf3 Nc6 d4 cxd4 Nxd4 g6 c4"},
+	"r1bqkb1r/pp2pp1p/3p1np1/8/2PQP3/2N5/PP3PPP/R1B1KB1R w KQkq -":         {ECO: "B36", Name: "Sicilian Defense: Accelerated Dragon, Gurgenidze Variation", Moves: "e4 c5 Nf3 Nc6 d4 cxd4 Nxd4 g6 c4 Nf6 Nc3 Nxd4 Qxd4 d6"},
+	"r1bqk1nr/pp1pppbp/2n3p1/8/2PNP3/8/PP3PPP/RNBQKB1R w KQkq -":           {ECO: "B37", Name: "Sicilian Defense: Accelerated Dragon, Maróczy Bind, 5...Bg7", Moves: "e4 c5 Nf3 Nc6 d4 cxd4 Nxd4 g6 c4 Bg7"},
+	"r1bqk1nr/pp1pppbp/2n3p1/8/2PNP3/4B3/PP3PPP/RN1QKB1R b KQkq -":         {ECO: "B38", Name: "Sicilian Defense: Accelerated Dragon, Maróczy Bind, 6.Be3", Moves: "e4 c5 Nf3 Nc6 d4 cxd4 Nxd4 g6 c4 Bg7 Be3"},
+	"r1bqk2r/pp1pppbp/2n3p1/8/2PNP1n1/2N1B3/PP3PPP/R2QKB1R w KQkq -":       {ECO: "B39", Name: "Sicilian Defense: Accelerated Dragon, Maróczy Bind, Breyer Variation", Moves: "e4 c5 Nf3 Nc6 d4 cxd4 Nxd4 g6 c4 Bg7 Be3 Nf6 Nc3 Ng4"},
+	"rnbqkbnr/pp1p1ppp/4p3/2p5/4P3/5N2/PPPP1PPP/RNBQKB1R w KQkq -":         {ECO: "B40", Name: "Sicilian Defense: French Variation", Moves: "e4 c5 Nf3 e6"},
+	"r1bqkb1r/pp1p1ppp/2n1pn2/8/3NP3/2N5/PPP2PPP/R1BQKB1R w KQkq -":        {ECO: "B40", Name: "Sicilian Defense: Four Knights Variation", Moves: "e4 c5 Nf3 e6 d4 cxd4 Nxd4 Nf6 Nc3 Nc6"},
+	"rnbqk2r/pp1p1ppp/4pn2/8/1b1NP3/2N5/PPP2PPP/R1BQKB1R w KQkq -":         {ECO: "B40", Name: "Sicilian Defense: Pin Variation", Moves: "e4 c5 Nf3 e6 d4 cxd4 Nxd4 Nf6 Nc3 Bb4"},
+	"rnbqkbnr/pp1p1ppp/4p3/2p5/4P3/2P2N2/PP1P1PPP/RNBQKB1R b KQkq -":       {ECO: "B40", Name: "Sicilian Defense: Delayed Alapin Variation", Moves: "e4 c5 Nf3 e6 c3"},
+	"rnbqkbnr/1p1p1ppp/p3p3/8/3NP3/8/PPP2PPP/RNBQKB1R w KQkq -":            {ECO: "B41", Name: "Sicilian Defense: Kan Variation", Moves: "e4 c5 Nf3 e6 d4 cxd4 Nxd4 a6"},
+	"rnbqkbnr/1p1p1ppp/p3p3/8/2PNP3/8/PP3PPP/RNBQKB1R b KQkq -":            {ECO: "B41", Name: "Sicilian Defense: Kan Variation, Maróczy Bind", Moves: "e4 c5 Nf3 e6 d4 cxd4 Nxd4 a6 c4"},
+	"rnbqkbnr/1p1p1ppp/p3p3/8/3NP3/3B4/PPP2PPP/RNBQK2R b KQkq -":           {ECO: "B42", Name: "Sicilian Defense: Kan Variation, Modern Variation", Moves: "e4 c5 Nf3 e6 d4 cxd4 Nxd4 a6 Bd3"},
+	"rnbqk1nr/1p1p1ppp/p3p3/2b5/3NP3/3B4/PPP2PPP/RNBQK2R w KQkq -":         {ECO: "B42", Name: "Sicilian Defense: Kan Variation, Polugaevsky Variation", Moves: "e4 c5 Nf3 e6 d4 cxd4 Nxd4 a6 Bd3 Bc5"},
+	"rnbqkbnr/1p1p1ppp/p3p3/8/3NP3/2N5/PPP2PPP/R1BQKB1R b KQkq -":          {ECO: "B43", Name: "Sicilian Defense: Kan Variation, Wing Attack", Moves: "e4 c5 Nf3 e6 d4 cxd4 Nxd4 a6 Nc3"},
+	"rnb1kbnr/1pqp1ppp/p3p3/8/3NP3/2N5/PPP2PPP/R1BQKB1R w KQkq -":          {ECO: "B43", Name: "Sicilian Defense: Kan Variation, Knight Variation", Moves: "e4 c5 Nf3 e6 d4 cxd4 Nxd4 a6 Nc3 Qc7"},
+	"r1bqkbnr/pp1p1ppp/2n1p3/8/3NP3/8/PPP2PPP/RNBQKB1R w KQkq -":           {ECO: "B44", Name: "Sicilian Defense: Taimanov Variation", Moves: "e4 c5 Nf3 e6 d4 cxd4 Nxd4 Nc6"},
+	"r1bqkbnr/pp3ppp/2npp3/1N6/4P3/8/PPP2PPP/RNBQKB1R w KQkq -":            {ECO: "B44", Name: "Sicilian Defense: Taimanov Variation, Szén Variation", Moves: "e4 c5 Nf3 e6 d4 cxd4 Nxd4 Nc6 Nb5 d6"},
+	"r1bqkb1r/pp1p1ppp/2N1pn2/8/4P3/2N5/PPP2PPP/R1BQKB1R b KQkq -":         {ECO: "B45", Name: "Sicilian Defense: Four Knights Variation, Exchange Variation", Moves: "e4 c5 Nf3 e6 d4 cxd4 Nxd4 Nc6 Nc3 Nf6 Nxc6"},
+	"r1bqkbnr/1p1p1ppp/p1n1p3/8/3NP3/2N5/PPP2PPP/R1BQKB1R w KQkq -":        {ECO: "B46", Name: "Sicilian Defense: Taimanov Variation", Moves: "e4 c5 Nf3 e6 d4 cxd4 Nxd4 Nc6 Nc3 a6"},
+	"r1bqkbnr/1p1p1ppp/p1n1p3/8/3NP3/2N5/PPP1BPPP/R1BQK2R b KQkq -":        {ECO: "B46", Name: "Sicilian Defense: Taimanov Variation, 5...a6", Moves: "e4 c5 Nf3 e6 d4 cxd4 Nxd4 Nc6 Nc3 a6 Be2"},
+	"r1b1kbnr/ppqp1ppp/2n1p3/8/3NP3/2N5/PPP2PPP/R1BQKB1R w KQkq -":         {ECO: "B47", Name: "Sicilian Defense: Taimanov Variation, Bastrikov Variation", Moves: "e4 c5 Nf3 e6 d4 cxd4 Nxd4 Nc6 Nc3 Qc7"},
+	"r1b1kbnr/1pqp1ppp/p1n1p3/8/3NP3/2N1B3/PPPQ1PPP/R3KB1R b KQkq -":       {ECO: "B47", Name: "Sicilian Defense: Taimanov Variation, Bastrikov Variation, English Attack", Moves: "e4 c5 Nf3 e6 d4 cxd4 Nxd4 Nc6 Nc3 Qc7 Be3 a6 Qd2"},
+	"r1b1kbnr/ppqp1ppp/2n1p3/8/3NP3/2N1B3/PPP2PPP/R2QKB1R b KQkq -":        {ECO: "B48", Name: "Sicilian Defense: Taimanov Variation, 6.Be3", Moves: "e4 c5 Nf3 e6 d4 cxd4 Nxd4 Nc6 Nc3 Qc7 Be3"},
+	"r1b1kbnr/1pqp1ppp/p1n1p3/8/3NP3/2N1B3/PPP1BPPP/R2QK2R b KQkq -":       {ECO: "B49", Name: "Sicilian Defense: Taimanov Variation, 6.Be3 a6 7.Be2", Moves: "e4 c5 Nf3 e6 d4 cxd4 Nxd4 Nc6 Nc3 Qc7 Be3 a6 Be2"},
+	"rnbqkbnr/pp2pppp/3p4/2p5/4P3/5N2/PPPP1PPP/RNBQKB1R w KQkq -":          {ECO: "B50", Name: "Sicilian Defense: Modern Variations", Moves: "e4 c5 Nf3 d6"},
+	"rnbqkbnr/pp2pppp/3p4/2p5/4P3/2P2N2/PP1P1PPP/RNBQKB1R b KQkq -":        {ECO: "B50", Name: "Sicilian Defense: Delayed Alapin Variation, with ...d6", Moves: "e4 c5 Nf3 d6 c3"},
+	"rnbqkbnr/pp2pppp/3p4/2p5/4P3/5NP1/PPPP1P1P/RNBQKB1R b KQkq -":         {ECO: "B50", Name: "Sicilian Defense: Kotov Variation", Moves: "e4 c5 Nf3 d6 g3"},
+	"rnbqkbnr/pp2pppp/3p4/1Bp5/4P3/5N2/PPPP1PPP/RNBQK2R b KQkq -":          {ECO: "B51", Name: "Sicilian Defense: Moscow Variation", Moves: "e4 c5 Nf3 d6 Bb5+"},
+	"r1bqkbnr/pp2pppp/2np4/1Bp5/4P3/5N2/PPPP1PPP/RNBQK2R w KQkq -":         {ECO: "B51", Name: "Sicilian Defense: Moscow Variation, Main Line", Moves: "e4 c5 Nf3 d6 Bb5+ Nc6"},
+	"rn1qkbnr/pp1bpppp/3p4/1Bp5/4P3/5N2/PPPP1PPP/RNBQK2R w KQkq -":         {ECO: "B52", Name: "Sicilian Defense: Moscow Variation, 3...Bd7", Moves: "e4 c5 Nf3 d6 Bb5+ Bd7"},
+	"rn2kbnr/pp1qpppp/3p4/2p5/2P1P3/5N2/PP1P1PPP/RNBQK2R b KQkq -":         {ECO: "B52", Name: "Sicilian Defense: Canal Attack, Main Line", Moves: "e4 c5 Nf3 d6 Bb5+ Bd7 Bxd7+ Qxd7 c4"},
+	"rnbqkbnr/pp2pppp/3p4/8/3QP3/5N2/PPP2PPP/RNB1KB1R b KQkq -":            {ECO: "B53", Name: "Sicilian Defense: Chekhover Variation", Moves: "e4 c5 Nf3 d6 d4 cxd4 Qxd4"},
+	"r1b1kbnr/pp1qpppp/2np4/1B6/3QP3/5N2/PPP2PPP/RNB1K2R w KQkq -":         {ECO: "B53", Name: "Sicilian Defense: Chekhover Variation, Zaitsev Defense", Moves: "e4 c5 Nf3 d6 d4 cxd4 Qxd4 Nc6 Bb5 Qd7"},
+	"rnbqkbnr/pp2pppp/3p4/8/3NP3/8/PPP2PPP/RNBQKB1R b KQkq -":              {ECO: "B54", Name: "Sicilian Defense: Modern Variations, Main Line", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4"},
+	"rnbqkbnr/pp2pp1p/3p2p1/8/3NP3/8/PPP2PPP/RNBQKB1R w KQkq -":            {ECO: "B54", Name: "Sicilian Defense: Dragon Variation, Accelerated Dragon", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 g6"},
+	"rnbqkb1r/pp2pppp/3p1n2/8/3NP3/5P2/PPP3PP/RNBQKB1R b KQkq -":           {ECO: "B55", Name: "Sicilian Defense: Prins Variation", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 f3"},
+	"rnbqkb1r/pp2pppp/3p1n2/8/3NP3/2N5/PPP2PPP/R1BQKB1R b KQkq -":          {ECO: "B56", Name: "Sicilian Defense: Classical Variation", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3"},
+	"rnbqkb1r/pp3ppp/3p1n2/1B2p3/3NP3/2N5/PPP2PPP/R1BQK2R b KQkq -":        {ECO: "B56", Name: "Sicilian Defense: Venice Attack", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 e5 Bb5+"},
+	"r1bqkb1r/pp2pppp/2np1n2/8/3NP3/2N5/PPP2PPP/R1BQKB1R w KQkq -":         {ECO: "B56", Name: "Sicilian Defense: Classical Variation, 5...Nc6", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 Nc6"},
+	"r1bqkb1r/pp2pppp/2np1n2/8/2BNP3/2N5/PPP2PPP/R1BQK2R b KQkq -":         {ECO: "B57", Name: "Sicilian Defense: Classical Variation", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 Nc6 Bc4"},
+	"r1bqkb1r/p3pp1p/2pp1np1/4P3/2B5/2N5/PPP2PPP/R1BQK2R b KQkq -":         {ECO: "B57", Name: "Sicilian Defense: Magnus Smith Trap", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 Nc6 Bc4 g6 Nxc6 bxc6 e5"},
+	"r1bqkb1r/pp3ppp/2np1n2/4p3/3NP3/2N5/PPP1BPPP/R1BQK2R w KQkq -":        {ECO: "B58", Name: "Sicilian Defense: Boleslavsky Variation", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 Nc6 Be2 e5"},
+	"r1bqkb1r/pp3ppp/2np1n2/4p3/4P3/1NN5/PPP1BPPP/R1BQK2R b KQkq -":        {ECO: "B59", Name: "Sicilian Defense: Boleslavsky Variation, 7.Nb3", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 Nc6 Be2 e5 Nb3"},
+	"r1bqkb1r/pp2pppp/2np1n2/6B1/3NP3/2N5/PPP2PPP/R2QKB1R b KQkq -":        {ECO: "B60", Name: "Sicilian Defense: Richter-Rauzer Variation", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 Nc6 Bg5"},
+	"r1bqkb1r/pp2pp1p/2np1np1/6B1/3NP3/2N5/PPP2PPP/R2QKB1R w KQkq -":       {ECO: "B60", Name: "Sicilian Defense: Richter-Rauzer Variation, Bondarevsky Variation", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 Nc6 Bg5 g6"},
+	"r2qkb1r/pp1bpppp/2np1n2/6B1/3NP3/2N5/PPPQ1PPP/R3KB1R b KQkq -":        {ECO: "B61", Name: "Sicilian Defense: Richter-Rauzer Variation, Larsen Variation", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 Nc6 Bg5 Bd7 Qd2"},
+	"r1bqkb1r/pp3ppp/2nppn2/6B1/3NP3/2N5/PPP2PPP/R2QKB1R w KQkq -":         {ECO: "B62", Name: "Sicilian Defense: Richter-Rauzer Variation, 6...e6", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 Nc6 Bg5 e6"},
+	"r1bqk2r/pp2bppp/2nppn2/6B1/3NP3/2N5/PPPQ1PPP/R3KB1R w KQkq -":         {ECO: "B63", Name: "Sicilian Defense: Richter-Rauzer Variation, Traditional Line", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 Nc6 Bg5 e6 Qd2 Be7"},
+	"r1bq1rk1/pp2bppp/2nppn2/6B1/3NPP2/2N5/PPPQ2PP/2KR1B1R b - -":          {ECO: "B64", Name: "Sicilian Defense: Richter-Rauzer Variation, Classical Variation", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 Nc6 Bg5 e6 Qd2 Be7 O-O-O O-O f4"},
+	"r1bq1rk1/pp2bppp/3ppn2/6B1/3QPP2/2N5/PPP3PP/2KR1B1R b - -":            {ECO: "B65", Name: "Sicilian Defense: Richter-Rauzer Variation, Classical Variation, Main Line", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 Nc6 Bg5 e6 Qd2 Be7 O-O-O O-O f4 Nxd4 Qxd4"},
+	"r1bqkb1r/1p3ppp/p1nppn2/6B1/3NP3/2N5/PPPQ1PPP/R3KB1R w KQkq -":        {ECO: "B66", Name: "Sicilian Defense: Richter-Rauzer Variation, Modern Variation", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 Nc6 Bg5 e6 Qd2 a6"},
+	"r2qkb1r/1p1b1ppp/p1nppn2/6B1/3NP3/2N5/PPPQ1PPP/2KR1B1R w kq -":        {ECO: "B67", Name: "Sicilian Defense: Richter-Rauzer Variation, Neo-Modern Variation", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 Nc6 Bg5 e6 Qd2 a6 O-O-O Bd7"},
+	"r2qk2r/1p1bbppp/p1nppn2/6B1/3NPP2/2N5/PPPQ2PP/2KR1B1R w kq -":         {ECO: "B68", Name: "Sicilian Defense: Richter-Rauzer Variation, Neo-Modern Variation, Main Line", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 Nc6 Bg5 e6 Qd2 a6 O-O-O Bd7 f4 Be7"},
+	"r2qk2r/3bbppp/p1nppB2/1p6/4PP2/2N2N2/PPPQ2PP/2KR1B1R b kq -":          {ECO: "B69", Name: "Sicilian Defense: Richter-Rauzer Variation, Neo-Modern Variation, Nyezhmetdinov Attack", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 Nc6 Bg5 e6 Qd2 a6 O-O-O Bd7 f4 Be7 Nf3 b5 Bxf6"},
+	"rnbqkb1r/pp2pp1p/3p1np1/8/3NP3/2N5/PPP2PPP/R1BQKB1R w KQkq -":         {ECO: "B70", Name: "Sicilian Defense: Dragon Variation", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 g6"},
+	"rnbqkb1r/pp2pp1p/3p1np1/8/3NP3/2N3P1/PPP2P1P/R1BQKB1R b KQkq -":       {ECO: "B70", Name: "Sicilian Defense: Dragon Variation, Fianchetto Variation", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 g6 g3"},
+	"rnbqkb1r/pp2pp1p/3p1np1/8/3NPP2/2N5/PPP3PP/R1BQKB1R b KQkq -":         {ECO: "B71", Name: "Sicilian Defense: Dragon Variation, Levenfish Variation", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 g6 f4"},
+	"rnbqkb1r/pp2pp1p/3p1np1/8/3NP3/2N1B3/PPP2PPP/R2QKB1R b KQkq -":        {ECO: "B72", Name: "Sicilian Defense: Dragon Variation, Classical Variation", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 g6 Be3"},
+	"r1bqk2r/pp2ppbp/2np1np1/8/3NP3/2N1B3/PPPQBPPP/R3K2R b KQkq -":         {ECO: "B72", Name: "Sicilian Defense: Dragon Variation, Classical Variation, Grigoriev Variation", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 g6 Be3 Bg7 Be2 Nc6 Qd2"},
+	"r1bqk2r/pp2ppbp/2np1np1/8/3NP3/2N1B3/PPP1BPPP/R2Q1RK1 b kq -":         {ECO: "B73", Name: "Sicilian Defense: Dragon Variation, Classical Variation, Normal Line", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 g6 Be3 Bg7 Be2 Nc6 O-O"},
+	"r1bq1rk1/pp2ppbp/2np1np1/8/4P3/1NN1B3/PPP1BPPP/R2Q1RK1 b - -":         {ECO: "B74", Name: "Sicilian Defense: Dragon Variation, Classical Variation, Main Line", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 g6 Be3 Bg7 Be2 Nc6 O-O O-O Nb3"},
+	"rnbqk2r/pp2ppbp/3p1np1/8/3NP3/2N1BP2/PPP3PP/R2QKB1R b KQkq -":         {ECO: "B75", Name: "Sicilian Defense: Dragon Variation, Yugoslav Attack", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 g6 Be3 Bg7 f3"},
+	"r1bqk2r/pp2ppbp/2np1np1/8/3NP3/2N1BP2/PPP3PP/R2QKB1R w KQkq -":        {ECO: "B75", Name: "Sicilian Defense: Dragon Variation, Yugoslav Attack, 7...Nc6", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 g6 Be3 Bg7 f3 Nc6"},
+	"rnbq1rk1/pp2ppbp/3p1np1/8/3NP3/2N1BP2/PPP3PP/R2QKB1R w KQ -":          {ECO: "B76", Name: "Sicilian Defense: Dragon Variation, Yugoslav Attack, 7...O-O", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 g6 Be3 Bg7 f3 O-O"},
+	"r1bq1rk1/pp2ppbp/2np1np1/8/3NP3/2N1BP2/PPPQ2PP/2KR1B1R b - -":         {ECO: "B76", Name: "Sicilian Defense: Dragon Variation, Yugoslav Attack, Modern Line", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 g6 Be3 Bg7 f3 O-O Qd2 Nc6 O-O-O"},
+	"r1bq1rk1/pp2ppbp/2np1np1/8/2BNP3/2N1BP2/PPPQ2PP/R3K2R b KQ -":         {ECO: "B77", Name: "Sicilian Defense: Dragon Variation, Yugoslav Attack, 9.Bc4", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 g6 Be3 Bg7 f3 O-O Qd2 Nc6 Bc4"},
+	"2rq1rk1/pp1bppb1/3p1np1/4n2p/3NP2P/1BN1BP2/PPPQ2P1/2KR3R w - -":       {ECO: "B77", Name: "Sicilian Defense: Dragon Variation, Yugoslav Attack, Soltis Variation", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 g6 Be3 Bg7 f3 O-O Qd2 Nc6 Bc4 Bd7 O-O-O Rc8 Bb3 Ne5 h4 h5"},
+	"r2q1rk1/pp1bppbp/2np1np1/8/2BNP3/2N1BP2/PPPQ2PP/2KR3R b - -":          {ECO: "B78", Name: "Sicilian Defense: Dragon Variation, Yugoslav Attack, Old Line", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 g6 Be3 Bg7 f3 O-O Qd2 Nc6 Bc4 Bd7 O-O-O"},
+	"r1r3k1/pp1bppbp/2np1np1/q7/3NP2P/1BN1BP2/PPPQ2P1/2KR3R b - -":         {ECO: "B79", Name: "Sicilian Defense: Dragon Variation, Yugoslav Attack, Main Line", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 g6 Be3 Bg7 f3 O-O Qd2 Nc6 Bc4 Bd7 O-O-O Qa5 Bb3 Rfc8 h4"},
+	"rnbqkb1r/pp3ppp/3ppn2/8/3NP3/2N5/PPP2PPP/R1BQKB1R w KQkq -":           {ECO: "B80", Name: "Sicilian Defense: Scheveningen Variation", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 e6"},
+	"rnbqkb1r/1p3ppp/p2ppn2/8/3NP3/2N1BP2/PPP3PP/R2QKB1R b KQkq -":         {ECO: "B80", Name: "Sicilian Defense: Scheveningen Variation, English Attack", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 e6 Be3 a6 f3"},
+	"rnbqkb1r/pp3ppp/3ppn2/8/3NP3/2N3P1/PPP2P1P/R1BQKB1R b KQkq -":         {ECO: "B80", Name: "Sicilian Defense: Scheveningen Variation, Fianchetto Variation", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 e6 g3"},
+	"rnbqkb1r/pp3ppp/3ppn2/8/3NP1P1/2N5/PPP2P1P/R1BQKB1R b KQkq -":         {ECO: "B81", Name: "Sicilian Defense: Scheveningen Variation, Keres Attack", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 e6 g4"},
+	"rnbqkb1r/pp3ppp/3ppn2/8/3NPP2/2N5/PPP3PP/R1BQKB1R b KQkq -":           {ECO: "B82", Name: "Sicilian Defense: Scheveningen Variation, Matanović Attack", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 e6 f4"},
+	"r1bqkb1r/pp3ppp/2nppn2/8/3NP3/2N5/PPP1BPPP/R1BQK2R w KQkq -":          {ECO: "B83", Name: "Sicilian Defense: Scheveningen Variation, Modern Variation", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 e6 Be2 Nc6"},
+	"rnbqkb1r/1p3ppp/p2ppn2/8/3NP3/2N5/PPP1BPPP/R1BQK2R w KQkq -":          {ECO: "B84", Name: "Sicilian Defense: Scheveningen Variation, Classical Variation", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 e6 Be2 a6"},
+	"r1b1kb1r/1pq2ppp/p1nppn2/8/3NPP2/2N5/PPP1B1PP/R1BQ1RK1 w kq -":        {ECO: "B85", Name: "Sicilian Defense: Scheveningen Variation, Classical Variation, Paulsen Variation", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 e6 Be2 a6 O-O Qc7 f4 Nc6"},
+	"rnbqkb1r/pp3ppp/3ppn2/8/2BNP3/2N5/PPP2PPP/R1BQK2R b KQkq -":           {ECO: "B86", Name: "Sicilian Defense: Sozin Attack", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 e6 Bc4"},
+	"rnbqkb1r/5ppp/p2ppn2/1p6/3NP3/1BN5/PPP2PPP/R1BQK2R w KQkq -":          {ECO: "B87", Name: "Sicilian Defense: Sozin Attack, Flank Variation", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 e6 Bc4 a6 Bb3 b5"},
+	"r1bqkb1r/pp3ppp/2nppn2/8/2BNP3/2N5/PPP2PPP/R1BQK2R w KQkq -":          {ECO: "B88", Name: "Sicilian Defense: Sozin Attack, Leonhardt Variation", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 e6 Bc4 Nc6"},
+	"r1bq1rk1/pp2bppp/2nppn2/8/3NPP2/1BN1B3/PPP3PP/R2QK2R b KQ -":          {ECO: "B88", Name: "Sicilian Defense: Sozin Attack, Fischer Variation", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 e6 Bc4 Nc6 Bb3 Be7 Be3 O-O f4"},
+	"r1bqkb1r/pp3ppp/2nppn2/8/2BNP3/2N1B3/PPP2PPP/R2QK2R b KQkq -":         {ECO: "B89", Name: "Sicilian Defense: Sozin Attack, Main Line", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 e6 Bc4 Nc6 Be3"},
+	"r1bqk2r/pp2bppp/2nppn2/8/2BNP3/2N1B3/PPP1QPPP/R3K2R b KQkq -":         {ECO: "B89", Name: "Sicilian Defense: Velimirović Attack", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 e6 Bc4 Nc6 Be3 Be7 Qe2"},
+	"rnbqkb1r/1p2pppp/p2p1n2/8/3NP3/2N5/PPP2PPP/R1BQKB1R w KQkq -":         {ECO: "B90", Name: "Sicilian Defense: Najdorf Variation", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 a6"},
+	"rnbqkb1r/1p2pppp/p2p1n2/8/3NP3/2N1B3/PPP2PPP/R2QKB1R b KQkq -":        {ECO: "B90", Name: "Sicilian Defense: Najdorf Variation, English Attack", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 a6 Be3"},
+	"rnbqkb1r/1p2pppp/p2p1n2/8/3NP3/2N4P/PPP2PP1/R1BQKB1R b KQkq -":        {ECO: "B90", Name: "Sicilian Defense: Najdorf Variation, Adams Attack", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 a6 h3"},
+	"rnbqkb1r/1p2pppp/p2p1n2/8/2BNP3/2N5/PPP2PPP/R1BQK2R b KQkq -":         {ECO: "B90", Name: "Sicilian Defense: Najdorf Variation, Lipnitsky Attack", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 a6 Bc4"},
+	"rnbqkb1r/1p2pppp/p2p4/8/3NP1n1/2N1B3/PPP2PPP/R2QKB1R w KQkq -":        {ECO: "B90", Name: "Sicilian Defense: Najdorf Variation, English Attack, Anti-English", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 a6 Be3 Ng4"},
+	"rnbqkb1r/1p2pppp/p2p1n2/8/3NP3/2N3P1/PPP2P1P/R1BQKB1R b KQkq -":       {ECO: "B91", Name: "Sicilian Defense: Najdorf Variation, Zagreb Variation", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 a6 g3"},
+	"rnbqkb1r/1p2pppp/p2p1n2/8/3NP3/2N5/PPP1BPPP/R1BQK2R b KQkq -":         {ECO: "B92", Name: "Sicilian Defense: Najdorf Variation, Opocensky Variation", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 a6 Be2"},
+	"rnbqkb1r/1p3ppp/p2p1n2/4p3/4P3/1NN5/PPP1BPPP/R1BQK2R b KQkq -":        {ECO: "B92", Name: "Sicilian Defense: Najdorf Variation, Opocensky Variation, Traditional Line", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 a6 Be2 e5 Nb3"},
+	"rnbqkb1r/1p2pppp/p2p1n2/8/3NPP2/2N5/PPP3PP/R1BQKB1R b KQkq -":         {ECO: "B93", Name: "Sicilian Defense: Najdorf Variation, Amsterdam Variation", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 a6 f4"},
+	"rnbqkb1r/1p2pppp/p2p1n2/6B1/3NP3/2N5/PPP2PPP/R2QKB1R b KQkq -":        {ECO: "B94", Name: "Sicilian Defense: Najdorf Variation", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 a6 Bg5"},
+	"r1b1kb1r/1p1nppp1/p2p1n1p/q5B1/2BNP3/2N5/PPPQ1PPP/R3K2R w KQkq -":     {ECO: "B94", Name: "Sicilian Defense: Najdorf Variation, Ivkov Variation", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 a6 Bg5 Nbd7 Bc4 Qa5 Qd2 h6"},
+	"rnbqkb1r/1p3ppp/p2ppn2/6B1/3NP3/2N2Q2/PPP2PPP/R3KB1R b KQkq -":        {ECO: "B95", Name: "Sicilian Defense: Najdorf Variation, 6...e6 7.Qf3", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 a6 Bg5 e6 Qf3"},
+	"rnbqkb1r/1p3ppp/p2ppn2/6B1/3NP3/2N5/PPP2PPP/R2QKB1R w KQkq -":         {ECO: "B96", Name: "Sicilian Defense: Najdorf Variation", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 a6 Bg5 e6"},
+	"rnbqkb1r/5ppp/p2ppn2/1p4B1/3NPP2/2N5/PPP3PP/R2QKB1R w KQkq -":         {ECO: "B96", Name: "Sicilian Defense: Najdorf Variation, Polugaevsky Variation", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 a6 Bg5 e6 f4 b5"},
+	"rnbqkb1r/1p3ppp/p2ppn2/6B1/3NPP2/2N5/PPP3PP/R2QKB1R b KQkq -":         {ECO: "B96", Name: "Sicilian Defense: Najdorf Variation, 7.f4", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 a6 Bg5 e6 f4"},
+	"rnb1kb1r/1p3ppp/pq1ppn2/6B1/3NPP2/2N5/PPP3PP/R2QKB1R w KQkq -":        {ECO: "B97", Name: "Sicilian Defense: Najdorf Variation, Poisoned Pawn Variation", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 a6 Bg5 e6 f4 Qb6"},
+	"rnb1kb1r/1p3ppp/p2ppn2/6B1/3NPP2/2N5/PqPQ2PP/R3KB1R w KQkq -":         {ECO: "B97", Name: "Sicilian Defense: Najdorf Variation, Poisoned Pawn Accepted", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 a6 Bg5 e6 f4 Qb6 Qd2 Qxb2"},
+	"rnbqk2r/1p2bppp/p2ppn2/6B1/3NPP2/2N5/PPP3PP/R2QKB1R w KQkq -":         {ECO: "B98", Name: "Sicilian Defense: Najdorf Variation, 7...Be7", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 a6 Bg5 e6 f4 Be7"},
+	"rnbqk2r/1p2bp2/p2ppn1p/6p1/3NPP1B/2N2Q2/PPP3PP/R3KB1R w KQkq -":       {ECO: "B98", Name: "Sicilian Defense: Najdorf Variation, Goteborg Variation", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 a6 Bg5 e6 f4 Be7 Qf3 h6 Bh4 g5"},
+	"r1b1k2r/1pqnbppp/p2ppn2/6B1/3NPP2/2N2Q2/PPP3PP/2KR1B1R w kq -":        {ECO: "B99", Name: "Sicilian Defense: Najdorf Variation, Main Line", Moves: "e4 c5 Nf3 d6 d4 cxd4 Nxd4 Nf6 Nc3 a6 Bg5 e6 f4 Be7 Qf3 Qc7 O-O-O Nbd7"},
+	"rnbqkbnr/pppp1ppp/4p3/8/4P3/8/PPPP1PPP/RNBQKBNR w KQkq -":             {ECO: "C00", Name: "French Defense", Moves: "e4 e6"},
+	"rnbqkbnr/pppp1ppp/4p3/8/4P3/5N2/PPPP1PPP/RNBQKB1R b KQkq -":           {ECO: "C00", Name: "French Defense: Knight Variation", Moves: "e4 e6 Nf3"},
+	"rnbqkbnr/pppp1ppp/4p3/8/4P3/3P4/PPP2PPP/RNBQKBNR b KQkq -":            {ECO: "C00", Name: "French Defense: King's Indian Attack", Moves: "e4 e6 d3"},
+	"rnbqkbnr/pppp1ppp/4p3/8/4PP2/8/PPPP2PP/RNBQKBNR b KQkq -":             {ECO: "C00", Name: "French Defense: La Bourdonnais Variation", Moves: "e4 e6 f4"},
+	"rnbqkbnr/pp3ppp/4p3/2ppP3/1P6/5N2/P1PP1PPP/RNBQKB1R b KQkq -":         {ECO: "C00", Name: "French Defense: Wing Gambit", Moves: "e4 e6 Nf3 d5 e5 c5 b4"},
+	"rnbqkbnr/pppp1ppp/4p3/8/4P3/8/PPPPQPPP/RNB1KBNR b KQkq -":             {ECO: "C00", Name: "French Defense: Chigorin Variation", Moves: "e4 e6 Qe2"},
+	"rnbqkbnr/pppp1ppp/4p3/8/3PP3/8/PPP2PPP/RNBQKBNR b KQkq -":             {ECO: "C00", Name: "French Defense: Normal Variation", Moves: "e4 e6 d4"},
+	"rnbqkbnr/ppp2ppp/4p3/3p4/4P3/2N2N2/PPPP1PPP/R1BQKB1R b KQkq -":        {ECO: "C00", Name: "French Defense: Two Knights Variation", Moves: "e4 e6 Nf3 d5 Nc3"},
+	"rnbqkbnr/ppp2ppp/4p3/3P4/3P4/8/PPP2PPP/RNBQKBNR b KQkq -":             {ECO: "C01", Name: "French Defense: Exchange Variation", Moves: "e4 e6 d4 d5 exd5"},
+	"rnbqkbnr/ppp2ppp/8/3p4/3P4/8/PPP2PPP/RNBQKBNR w KQkq -":               {ECO: "C01", Name: "French Defense: Exchange Variation", Moves: "e4 e6 d4 d5 exd5 exd5"},
+	"rnbqkbnr/ppp2ppp/8/3p4/2PP4/8/PP3PPP/RNBQKBNR b KQkq -":               {ECO: "C01", Name: "French Defense: Exchange Variation, Monte Carlo Variation", Moves: "e4 e6 d4 d5 exd5 exd5 c4"},
+	"rnbqk1nr/ppp2ppp/8/3p4/1b1P4/2N5/PPP2PPP/R1BQKBNR w KQkq -":           {ECO: "C01", Name: "French Defense: Winawer Variation, Exchange Variation", Moves: "e4 e6 d4 d5 Nc3 Bb4 exd5 exd5"},
+	"rnbqkbnr/ppp2ppp/4p3/3pP3/3P4/8/PPP2PPP/RNBQKBNR b KQkq -":            {ECO: "C02", Name: "French Defense: Advance Variation", Moves: "e4 e6 d4 d5 e5"},
+	"r1b1kbnr/pp3ppp/1qn1p3/2ppP3/3P4/2P2N2/PP3PPP/RNBQKB1R w KQkq -":      {ECO: "C02", Name: "French Defense: Advance Variation, Main Line", Moves: "e4 e6 d4 d5 e5 c5 c3 Nc6 Nf3 Qb6"},
+	"r3kbnr/pp1b1ppp/1qn1p3/3pP3/3P4/3B1N2/PP3PPP/RNBQ1RK1 b kq -":         {ECO: "C02", Name: "French Defense: Advance Variation, Milner-Barry Gambit", Moves: "e4 e6 d4 d5 e5 c5 c3 Nc6 Nf3 Qb6 Bd3 cxd4 cxd4 Bd7 O-O"},
+	"r1bqkbnr/pp3ppp/2n1p3/2ppP3/3P4/2P2N2/PP3PPP/RNBQKB1R b KQkq -":       {ECO: "C02", Name: "French Defense: Advance Variation, Paulsen Attack", Moves: "e4 e6 d4 d5 e5 c5 c3 Nc6 Nf3"},
+	"r2qkbnr/pp1b1ppp/2n1p3/2ppP3/3P4/2P2N2/PP3PPP/RNBQKB1R w KQkq -":      {ECO: "C02", Name: "French Defense: Advance Variation, Euwe Variation", Moves: "e4 e6 d4 d5 e5 c5 c3 Nc6 Nf3 Bd7"},
+	"rnbqkbnr/pp3ppp/4p3/2ppP3/3P2Q1/8/PPP2PPP/RNB1KBNR b KQkq -":          {ECO: "C02", Name: "French Defense: Advance Variation, Nimzowitsch Attack", Moves: "e4 e6 d4 d5 e5 c5 Qg4"},
+	"rnbqkbnr/ppp2ppp/4p3/3p4/3PP3/8/PPPN1PPP/R1BQKBNR b KQkq -":           {ECO: "C03", Name: "French Defense: Tarrasch Variation", Moves: "e4 e6 d4 d5 Nd2"},
+	"rnbqkbnr/ppp3pp/4p3/3p1p2/3PP3/8/PPPN1PPP/R1BQKBNR w KQkq -":          {ECO: "C03", Name: "French Defense: Tarrasch Variation, Haberditz Variation", Moves: "e4 e6 d4 d5 Nd2 f5"},
+	"rnbqk1nr/ppp1bppp/4p3/3p4/3PP3/8/PPPN1PPP/R1BQKBNR w KQkq -":          {ECO: "C03", Name: "French Defense: Tarrasch Variation, Morozevich Variation", Moves: "e4 e6 d4 d5 Nd2 Be7"},
+	"r1bqkbnr/ppp2ppp/2n1p3/3p4/3PP3/8/PPPN1PPP/R1BQKBNR w KQkq -":         {ECO: "C04", Name: "French Defense: Tarrasch Variation, Guimard Defense", Moves: "e4 e6 d4 d5 Nd2 Nc6"},
+	"r1bqkb1r/ppp2ppp/2n1pn2/3p4/3PP3/5N2/PPPN1PPP/R1BQKB1R w KQkq -":      {ECO: "C04", Name: "French Defense: Tarrasch Variation, Guimard Defense, Main Line", Moves: "e4 e6 d4 d5 Nd2 Nc6 Ngf3 Nf6"},
+	"rnbqkb1r/ppp2ppp/4pn2/3p4/3PP3/8/PPPN1PPP/R1BQKBNR w KQkq -":          {ECO: "C05", Name: "French Defense: Tarrasch Variation, Closed Variation", Moves: "e4 e6 d4 d5 Nd2 Nf6"},
+	"rnbqkb1r/p2n1ppp/1p2p3/2ppP3/3P4/2PB4/PP1N1PPP/R1BQK1NR w KQkq -":     {ECO: "C05", Name: "French Defense: Tarrasch Variation, Botvinnik Variation", Moves: "e4 e6 d4 d5 Nd2 Nf6 e5 Nfd7 Bd3 c5 c3 b6"},
+	"r1bqkb1r/pp1n1ppp/2n1p3/2ppP3/3P4/2PB4/PP1NNPPP/R1BQK2R b KQkq -":     {ECO: "C06", Name: "French Defense: Tarrasch Variation, Closed Variation, Main Line", Moves: "e4 e6 d4 d5 Nd2 Nf6 e5 Nfd7 Bd3 c5 c3 Nc6 Ne2"},
+	"r1bqkb1r/pp3ppp/1nn1p3/3pP3/3P4/3B4/PP1NNPPP/R1BQK2R w KQkq -":        {ECO: "C06", Name: "French Defense: Tarrasch Variation, Leningrad Variation", Moves: "e4 e6 d4 d5 Nd2 Nf6 e5 Nfd7 Bd3 c5 c3 Nc6 Ne2 cxd4 cxd4 Nb6"},
+	"rnbqkbnr/pp3ppp/4p3/2pp4/3PP3/8/PPPN1PPP/R1BQKBNR w KQkq -":           {ECO: "C07", Name: "French Defense: Tarrasch Variation, Open System", Moves: "e4 e6 d4 d5 Nd2 c5"},
+	"rnb1kbnr/pp3ppp/4p3/2pq4/3P4/8/PPPN1PPP/R1BQKBNR w KQkq -":            {ECO: "C07", Name: "French Defense: Tarrasch Variation, Chistyakov Defense", Moves: "e4 e6 d4 d5 Nd2 c5 exd5 Qxd5"},
+	"r1bqk1nr/pp3ppp/2nb4/1Bpp4/3P4/5N2/PPPN1PPP/R1BQK2R w KQkq -":         {ECO: "C07", Name: "French Defense: Tarrasch Variation, Open System, Euwe-Keres Line", Moves: "e4 e6 d4 d5 Nd2 c5 exd5 exd5 Ngf3 Nc6 Bb5 Bd6"},
+	"rnbqkbnr/pp3ppp/8/2pp4/3P4/8/PPPN1PPP/R1BQKBNR w KQkq -":              {ECO: "C08", Name: "French Defense: Tarrasch Variation, Open System", Moves: "e4 e6 d4 d5 Nd2 c5 exd5 exd5"},
+	"rnbqkbnr/pp3ppp/8/3p4/2pP4/5N2/PPPN1PPP/R1BQKB1R w KQkq -":            {ECO: "C08", Name: "French Defense: Tarrasch Variation, Open System, Advance Line", Moves: "e4 e6 d4 d5 Nd2 c5 exd5 exd5 Ngf3 c4"},
+	"r1bqkbnr/pp3ppp/2n5/2pp4/3P4/5N2/PPPN1PPP/R1BQKB1R w KQkq -":          {ECO: "C09", Name: "French Defense: Tarrasch Variation, Open System, Main Line", Moves: "e4 e6 d4 d5 Nd2 c5 exd5 exd5 Ngf3 Nc6"},
+	"r1bqkbnr/pp3ppp/2n5/1Bpp4/3P4/5N2/PPPN1PPP/R1BQK2R b KQkq -":          {ECO: "C09", Name: "French Defense: Tarrasch Variation, Open System, Main Line, 5.Bb5", Moves: "e4 e6 d4 d5 Nd2 c5 exd5 exd5 Ngf3 Nc6 Bb5"},
+	"rnbqkbnr/ppp2ppp/4p3/3p4/3PP3/2N5/PPP2PPP/R1BQKBNR b KQkq -":          {ECO: "C10", Name: "French Defense: Paulsen Variation", Moves: "e4 e6 d4 d5 Nc3"},
+	"rnbqkbnr/ppp2ppp/4p3/8/3Pp3/2N5/PPP2PPP/R1BQKBNR w KQkq -":            {ECO: "C10", Name: "French Defense: Rubinstein Variation", Moves: "e4 e6 d4 d5 Nc3 dxe4"},
+	"rn1qkbnr/pppb1ppp/4p3/8/3PN3/8/PPP2PPP/R1BQKBNR w KQkq -":             {ECO: "C10", Name: "French Defense: Rubinstein Variation, Fort Knox Variation", Moves: "e4 e6 d4 d5 Nc3 dxe4 Nxe4 Bd7"},
+	"r1bqkbnr/pppn1ppp/4p3/8/3PN3/8/PPP2PPP/R1BQKBNR w KQkq -":             {ECO: "C10", Name: "French Defense: Rubinstein Variation, Blackburne Defense", Moves: "e4 e6 d4 d5 Nc3 dxe4 Nxe4 Nd7"},
+	"rnbqkb1r/ppp2ppp/4pn2/3p4/3PP3/2N5/PPP2PPP/R1BQKBNR w KQkq -":         {ECO: "C11", Name: "French Defense: Classical Variation", Moves: "e4 e6 d4 d5 Nc3 Nf6"},
+	"rnbqkb1r/ppp2ppp/4pn2/3pP3/3P4/2N5/PPP2PPP/R1BQKBNR b KQkq -":         {ECO: "C11", Name: "French Defense: Steinitz Variation", Moves: "e4 e6 d4 d5 Nc3 Nf6 e5"},
+	"rnbqkb1r/ppp2ppp/4pn2/6B1/3Pp3/2N5/PPP2PPP/R2QKBNR w KQkq -":          {ECO: "C11", Name: "French Defense: Burn Variation", Moves: "e4 e6 d4 d5 Nc3 Nf6 Bg5 dxe4"},
+	"r1bqkb1r/pp1n1ppp/2n1p3/2ppP3/3P1P2/2N1BN2/PPP3PP/R2QKB1R b KQkq -":   {ECO: "C11", Name: "French Defense: Steinitz Variation, Boleslavsky Variation", Moves: "e4 e6 d4 d5 Nc3 Nf6 e5 Nfd7 f4 c5 Nf3 Nc6 Be3"},
+	"rnbqk2r/ppp2ppp/4pn2/3p2B1/1b1PP3/2N5/PPP2PPP/R2QKBNR w KQkq -":       {ECO: "C12", Name: "French Defense: MacCutcheon Variation", Moves: "e4 e6 d4 d5 Nc3 Nf6 Bg5 Bb4"},
+	"rnbqk2r/ppp2pp1/4p2p/3pP3/3Pn1Q1/2P5/P1PB1PPP/R3KBNR b KQkq -":        {ECO: "C12", Name: "French Defense: MacCutcheon Variation, Main Line", Moves: "e4 e6 d4 d5 Nc3 Nf6 Bg5 Bb4 e5 h6 Bd2 Bxc3 bxc3 Ne4 Qg4"},
+	"rnbqkb1r/ppp2ppp/4pn2/3p2B1/3PP3/2N5/PPP2PPP/R2QKBNR b KQkq -":        {ECO: "C13", Name: "French Defense: Classical Variation", Moves: "e4 e6 d4 d5 Nc3 Nf6 Bg5"},
+	"rnbqk2r/pppnbppp/4p3/3pP1B1/3P3P/2N5/PPP2PP1/R2QKBNR b KQkq -":        {ECO: "C13", Name: "French Defense: Alekhine-Chatard Attack", Moves: "e4 e6 d4 d5 Nc3 Nf6 Bg5 Be7 e5 Nfd7 h4"},
+	"rnbqk2r/pppnbppp/4p3/3pP1B1/3P4/2N5/PPP2PPP/R2QKBNR w KQkq -":         {ECO: "C13", Name: "French Defense: Classical Variation, Normal Variation", Moves: "e4 e6 d4 d5 Nc3 Nf6 Bg5 Be7 e5 Nfd7"},
+	"rnb1k2r/pppnqppp/4p3/3pP3/3P4/2N5/PPP2PPP/R2QKBNR w KQkq -":           {ECO: "C14", Name: "French Defense: Classical Variation", Moves: "e4 e6 d4 d5 Nc3 Nf6 Bg5 Be7 e5 Nfd7 Bxe7 Qxe7"},
+	"rnb1k2r/pppnqppp/4p3/3pP3/3P1P2/2N5/PPP3PP/R2QKBNR b KQkq -":          {ECO: "C14", Name: "French Defense: Classical Variation, Steinitz Variation", Moves: "e4 e6 d4 d5 Nc3 Nf6 Bg5 Be7 e5 Nfd7 Bxe7 Qxe7 f4"},
+	"rnbqk1nr/ppp2ppp/4p3/3p4/1b1PP3/2N5/PPP2PPP/R1BQKBNR w KQkq -":        {ECO: "C15", Name: "French Defense: Winawer Variation", Moves: "e4 e6 d4 d5 Nc3 Bb4"},
+	"rnbqk1nr/ppp2ppp/4p3/3p4/1b1PP3/2N5/PPPB1PPP/R2QKBNR b KQkq -":        {ECO: "C15", Name: "French Defense: Winawer Variation, Fingerslip Variation", Moves: "e4 e6 d4 d5 Nc3 Bb4 Bd2"},
+	"rnbqk1nr/ppp2ppp/4p3/3p4/1b1PP3/2N5/PPP1NPPP/R1BQKB1R b KQkq -":       {ECO: "C15", Name: "French Defense: Winawer Variation, Alekhine Gambit", Moves: "e4 e6 d4 d5 Nc3 Bb4 Ne2"},
+	"rnbqk1nr/ppp2ppp/4p3/3pP3/1b1P4/2N5/PPP2PPP/R1BQKBNR b KQkq -":        {ECO: "C16", Name: "French Defense: Winawer Variation, Advance Variation", Moves: "e4 e6 d4 d5 Nc3 Bb4 e5"},
+	"rnb1k1nr/pppq1ppp/4p3/3pP3/1b1P4/2N5/PPP2PPP/R1BQKBNR w KQkq -":       {ECO: "C16", Name: "French Defense: Winawer Variation, Petrosian Variation", Moves: "e4 e6 d4 d5 Nc3 Bb4 e5 Qd7"},
+	"rnbqk1nr/pp3ppp/4p3/2ppP3/1b1P4/2N5/PPP2PPP/R1BQKBNR w KQkq -":        {ECO: "C17", Name: "French Defense: Winawer Variation, Advance Variation", Moves: "e4 e6 d4 d5 Nc3 Bb4 e5 c5"},
+	"rnbqk1nr/pp3ppp/4p3/2ppP3/1b1P4/2N5/PPPB1PPP/R2QKBNR b KQkq -":        {ECO: "C17", Name: "French Defense: Winawer Variation, Bogoljubov Variation", Moves: "e4 e6 d4 d5 Nc3 Bb4 e5 c5 Bd2"},
+	"rnbqk1nr/pp3ppp/4p3/b1ppP3/3P4/P1N5/1PP2PPP/R1BQKBNR w KQkq -":        {ECO: "C17", Name: "French Defense: Winawer Variation, Retreat Variation", Moves: "e4 e6 d4 d5 Nc3 Bb4 e5 c5 a3 Ba5"},
+	"rnbqk2r/pp2nppp/4p3/2ppP3/3P2Q1/P1P5/2P2PPP/R1B1KBNR b KQkq -":        {ECO: "C18", Name: "French Defense: Winawer Variation, Poisoned Pawn Variation", Moves: "e4 e6 d4 d5 Nc3 Bb4 e5 c5 a3 Bxc3+ bxc3 Ne7 Qg4"},
+	"rnbqk1nr/pp3ppp/4p3/2ppP3/3P4/P1P5/2P2PPP/R1BQKBNR b KQkq -":          {ECO: "C18", Name: "French Defense: Winawer Variation, Advance Variation, 6.bxc3", Moves: "e4 e6 d4 d5 Nc3 Bb4 e5 c5 a3 Bxc3+ bxc3"},
+	"rnb1k1nr/ppq2ppp/4p3/2ppP3/3P4/P1P5/2P2PPP/R1BQKBNR w KQkq -":         {ECO: "C18", Name: "French Defense: Winawer Variation, Classical Variation", Moves: "e4 e6 d4 d5 Nc3 Bb4 e5 c5 a3 Bxc3+ bxc3 Qc7"},
+	"rnbqk2r/pp2nppp/4p3/2ppP3/3P4/P1P2N2/2P2PPP/R1BQKB1R b KQkq -":        {ECO: "C19", Name: "French Defense: Winawer Variation, Positional Variation", Moves: "e4 e6 d4 d5 Nc3 Bb4 e5 c5 a3 Bxc3+ bxc3 Ne7 Nf3"},
+	"rnbqk2r/pp2nppp/4p3/2ppP3/P2P4/2P5/2P2PPP/R1BQKBNR b KQkq -":          {ECO: "C19", Name: "French Defense: Winawer Variation, Smyslov Variation", Moves: "e4 e6 d4 d5 Nc3 Bb4 e5 c5 a3 Bxc3+ bxc3 Ne7 a4"},
+	"rnbqkbnr/pppp1ppp/8/4p3/4P3/8/PPPP1PPP/RNBQKBNR w KQkq -":             {ECO: "C20", Name: "King's Pawn Game", Moves: "e4 e5"},
+	"rnbqkbnr/pppp1ppp/8/4p2Q/4P3/8/PPPP1PPP/RNB1KBNR b KQkq -":            {ECO: "C20", Name: "King's Pawn Game: Wayward Queen Attack", Moves: "e4 e5 Qh5"},
+	"rnbqkbnr/pppp1ppp/8/4p3/3PP3/8/PPP2PPP/RNBQKBNR b KQkq -":             {ECO: "C20", Name: "Center Game", Moves: "e4 e5 d4"},
+	"rnbqkbnr/pppp1ppp/8/4p3/4P3/5Q2/PPPP1PPP/RNB1KBNR b KQkq -":           {ECO: "C20", Name: "King's Pawn Game: Napoleon Attack", Moves: "e4 e5 Qf3"},
+	"rnbqkbnr/pppp1ppp/8/4p3/4P3/8/PPPPNPPP/RNBQKB1R b KQkq -":             {ECO: "C20", Name: "King's Pawn Game: Alapin Opening", Moves: "e4 e5 Ne2"},
+	"rnbqkbnr/pppp1ppp/8/4p3/4P3/2P5/PP1P1PPP/RNBQKBNR b KQkq -":           {ECO: "C20", Name: "King's Pawn Game: Macleod Attack", Moves: "e4 e5 c3"},
+	"rnbqkbnr/pppp1ppp/8/1B2p3/4P3/8/PPPP1PPP/RNBQK1NR b KQkq -":           {ECO: "C20", Name: "Portuguese Opening", Moves: "e4 e5 Bb5"},
+	"rnbqkbnr/pppp1ppp/8/8/3pP3/8/PPP2PPP/RNBQKBNR w KQkq -":               {ECO: "C21", Name: "Center Game: Accepted", Moves: "e4 e5 d4 exd4"},
+	"rnbqkbnr/pppp1ppp/8/8/3pP3/2P5/PP3PPP/RNBQKBNR b KQkq -":              {ECO: "C21", Name: "Danish Gambit", Moves: "e4 e5 d4 exd4 c3"},
+	"rnbqkbnr/pppp1ppp/8/8/2B1P3/8/PB3PPP/RN1QK1NR b KQkq -":               {ECO: "C21", Name: "Danish Gambit Accepted", Moves: "e4 e5 d4 exd4 c3 dxc3 Bc4 cxb2 Bxb2"},
+	"rnbqkbnr/ppp2ppp/8/3p4/3pP3/2P5/PP3PPP/RNBQKBNR w KQkq -":             {ECO: "C21", Name: "Danish Gambit Declined: Sorensen Defense", Moves: "e4 e5 d4 exd4 c3 d5"},
+	"r1bqkbnr/pppp1ppp/2n5/8/3QP3/8/PPP2PPP/RNB1KBNR w KQkq -":             {ECO: "C22", Name: "Center Game: Normal Variation", Moves: "e4 e5 d4 exd4 Qxd4 Nc6"},
+	"r1bqr1k1/pppp1ppp/2n2n2/8/1b2P3/2N1Q3/PPPB1PPP/2KR1BNR w - -":         {ECO: "C22", Name: "Center Game: Paulsen Attack Variation", Moves: "e4 e5 d4 exd4 Qxd4 Nc6 Qe3 Nf6 Nc3 Bb4 Bd2 O-O O-O-O Re8"},
+	"rnbqkbnr/pppp1ppp/8/4p3/2B1P3/8/PPPP1PPP/RNBQK1NR b KQkq -":           {ECO: "C23", Name: "Bishop's Opening", Moves: "e4 e5 Bc4"},
+	"rnbqkbnr/pp1p1ppp/2p5/4p3/2B1P3/8/PPPP1PPP/RNBQK1NR w KQkq -":         {ECO: "C23", Name: "Bishop's Opening: Philidor Counterattack", Moves: "e4 e5 Bc4 c6"},
+	"rnbqk1nr/ppp2ppp/8/2bpp3/2B1P3/2P5/PP1P1PPP/RNBQK1NR w KQkq -":        {ECO: "C23", Name: "Bishop's Opening: Lewis Countergambit", Moves: "e4 e5 Bc4 Bc5 c3 d5"},
+	"rnbqkb1r/pppp1ppp/5n2/4p3/2B1P3/8/PPPP1PPP/RNBQK1NR w KQkq -":         {ECO: "C24", Name: "Bishop's Opening: Berlin Defense", Moves: "e4 e5 Bc4 Nf6"},
+	"rnbqkb1r/pppp1ppp/5n2/8/2BpP3/5N2/PPP2PPP/RNBQK2R b KQkq -":           {ECO: "C24", Name: "Bishop's Opening: Urusov Gambit", Moves: "e4 e5 Bc4 Nf6 d4 exd4 Nf3"},
+	"r1bqkb1r/pppp1ppp/2n2n2/4p3/2B1P3/2NP4/PPP2PPP/R1BQK1NR b KQkq -":     {ECO: "C24", Name: "Bishop's Opening: Vienna Hybrid", Moves: "e4 e5 Bc4 Nf6 d3 Nc6 Nc3"},
+	"rnbqkbnr/pppp1ppp/8/4p3/4P3/2N5/PPPP1PPP/R1BQKBNR b KQkq -":           {ECO: "C25", Name: "Vienna Game", Moves: "e4 e5 Nc3"},
+	"r1bqkbnr/pppp1ppp/2n5/4p3/4P3/2N5/PPPP1PPP/R1BQKBNR w KQkq -":         {ECO: "C25", Name: "Vienna Game: Max Lange Defense", Moves: "e4 e5 Nc3 Nc6"},
+	"rnbqk1nr/pppp1ppp/8/2b1p3/4P3/2N5/PPPP1PPP/R1BQKBNR w KQkq -":         {ECO: "C25", Name: "Vienna Game: Anderssen Defense", Moves: "e4 e5 Nc3 Bc5"},
+	"r1bqkbnr/pppp1ppp/2n5/4p3/4PP2/2N5/PPPP2PP/R1BQKBNR b KQkq -":         {ECO: "C25", Name: "Vienna Game: Vienna Gambit", Moves: "e4 e5 Nc3 Nc6 f4"},
+	"r1bqkbnr/pppp1p1p/2n5/6N1/4PppP/2N5/PPPP2P1/R1BQKB1R b KQkq -":        {ECO: "C25", Name: "Vienna Game: Hamppe-Allgaier Gambit", Moves: "e4 e5 Nc3 Nc6 f4 exf4 Nf3 g5 h4 g4 Ng5"},
+	"rnbqkb1r/pppp1ppp/5n2/4p3/4P3/2N5/PPPP1PPP/R1BQKBNR w KQkq -":         {ECO: "C26", Name: "Vienna Game: Falkbeer Variation", Moves: "e4 e5 Nc3 Nf6"},
+	"rnbqkb1r/pppp1ppp/5n2/4p3/4P3/2N3P1/PPPP1P1P/R1BQKBNR b KQkq -":       {ECO: "C26", Name: "Vienna Game: Mieses Variation", Moves: "e4 e5 Nc3 Nf6 g3"},
+	"rnbqkb1r/pppp1ppp/5n2/4p3/2B1P3/2N5/PPPP1PPP/R1BQK1NR b KQkq -":       {ECO: "C26", Name: "Vienna Game: Stanley Variation", Moves: "e4 e5 Nc3 Nf6 Bc4"},
+	"rnbqkb1r/pppp1ppp/8/4p3/2B1n3/2N5/PPPP1PPP/R1BQK1NR w KQkq -":         {ECO: "C27", Name: "Vienna Game: Frankenstein-Dracula Variation", Moves: "e4 e5 Nc3 Nf6 Bc4 Nxe4"},
+	"N1bk1b1r/p2pq2p/1pnn2p1/3Qpp2/8/1B6/PPPP1PPP/R1B1K1NR w KQ -":         {ECO: "C27", Name: "Vienna Game: Stanley Variation, Frankenstein-Dracula Variation, Main Line", Moves: "e4 e5 Nc3 Nf6 Bc4 Nxe4 Qh5 Nd6 Bb3 Nc6 Nb5 g6 Qf3 f5 Qd5 Qe7 Nxc7+ Kd8 Nxa8 b6"},
+	"r1bqkb1r/pppp1ppp/2n2n2/4p3/2B1P3/2N5/PPPP1PPP/R1BQK1NR w KQkq -":     {ECO: "C28", Name: "Vienna Game: Stanley Variation, Three Knights Variation", Moves: "e4 e5 Nc3 Nf6 Bc4 Nc6"},
+	"r1bqkb1r/pppp1ppp/2n5/4p3/2B1n3/2N5/PPPPNPPP/R1BQ1RK1 b kq -":         {ECO: "C28", Name: "Vienna Game: Stanley Variation, Bronstein Gambit", Moves: "e4 e5 Nc3 Nf6 Bc4 Nc6 Nge2 Nxe4 O-O"},
+	"rnbqkb1r/pppp1ppp/5n2/4p3/4PP2/2N5/PPPP2PP/R1BQKBNR b KQkq -":         {ECO: "C29", Name: "Vienna Game: Vienna Gambit", Moves: "e4 e5 Nc3 Nf6 f4"},
+	"rnbqkb1r/ppp2ppp/8/3pP3/4n3/2N5/PPPP2PP/R1BQKBNR w KQkq -":            {ECO: "C29", Name: "Vienna Game: Vienna Gambit, Main Line", Moves: "e4 e5 Nc3 Nf6 f4 d5 fxe5 Nxe4"},
+	"rnbqkb1r/ppp2ppp/5n2/3pp3/4PP2/2NP4/PPP3PP/R1BQKBNR b KQkq -":         {ECO: "C29", Name: "Vienna Game: Vienna Gambit, Steinitz Variation", Moves: "e4 e5 Nc3 Nf6 f4 d5 d3"},
+	"rnbqkbnr/pppp1ppp/8/4p3/4PP2/8/PPPP2PP/RNBQKBNR b KQkq -":             {ECO: "C30", Name: "King's Gambit", Moves: "e4 e5 f4"},
+	"rnbqk1nr/pppp1ppp/8/2b1p3/4PP2/8/PPPP2PP/RNBQKBNR w KQkq -":           {ECO: "C30", Name: "King's Gambit Declined: Classical Variation", Moves: "e4 e5 f4 Bc5"},
+	"rnb1kbnr/ppppqppp/8/4p3/4PP2/6P1/PPPP3P/RNBQKBNR w KQkq -":            {ECO: "C30", Name: "King's Gambit Declined: Keene Defense", Moves: "e4 e5 f4 Qh4+ g3 Qe7"},
+	"rnb1kbnr/pppp1ppp/5q2/4p3/4PP2/8/PPPP2PP/RNBQKBNR w KQkq -":           {ECO: "C30", Name: "King's Gambit Declined: Norwalde Variation", Moves: "e4 e5 f4 Qf6"},
+	"rnbqk1nr/ppp2ppp/3p4/2b1p3/1P2PP2/5N2/P1PP2PP/RNBQKB1R b KQkq -":      {ECO: "C30", Name: "King's Gambit Declined: Classical Variation, Rotlewi Countergambit", Moves: "e4 e5 f4 Bc5 Nf3 d6 b4"},
+	"rnbqkbnr/ppp2ppp/8/3pp3/4PP2/8/PPPP2PP/RNBQKBNR w KQkq -":             {ECO: "C31", Name: "King's Gambit Declined: Falkbeer Countergambit", Moves: "e4 e5 f4 d5"},
+	"rnbqkbnr/pp3ppp/2p5/3Pp3/5P2/8/PPPP2PP/RNBQKBNR w KQkq -":             {ECO: "C31", Name: "King's Gambit Declined: Falkbeer Countergambit, Nimzowitsch-Marshall Countergambit", Moves: "e4 e5 f4 d5 exd5 c6"},
+	"rnbqkbnr/ppp2ppp/8/3P4/4pP2/8/PPPP2PP/RNBQKBNR w KQkq -":              {ECO: "C31", Name: "King's Gambit Declined: Falkbeer Countergambit Accepted", Moves: "e4 e5 f4 d5 exd5 e4"},
+	"rnbqkb1r/ppp2ppp/5n2/3P4/4pP2/3P4/PPP3PP/RNBQKBNR w KQkq -":           {ECO: "C32", Name: "King's Gambit Declined: Falkbeer Countergambit, Main Line", Moves: "e4 e5 f4 d5 exd5 e4 d3 Nf6"},
+	"rnbqkb1r/ppp2ppp/8/3P4/4nP2/8/PPP1Q1PP/RNB1KBNR b KQkq -":             {ECO: "C32", Name: "King's Gambit Declined: Falkbeer Countergambit, Charousek Gambit", Moves: "e4 e5 f4 d5 exd5 e4 d3 Nf6 dxe4 Nxe4 Qe2"},
+	"rnbqkbnr/pppp1ppp/8/8/4Pp2/8/PPPP2PP/RNBQKBNR w KQkq -":               {ECO: "C33", Name: "King's Gambit Accepted", Moves: "e4 e5 f4 exf4"},
+	"rnbqkbnr/pppp1ppp/8/8/2B1Pp2/8/PPPP2PP/RNBQK1NR b KQkq -":             {ECO: "C33", Name: "King's Gambit Accepted: Bishop's Gambit", Moves: "e4 e5 f4 exf4 Bc4"},
+	"rnbqkb1r/pppp1ppp/5n2/8/4Pp2/5N2/PPPP2PP/RNBQKB1R w KQkq -":           {ECO: "C33", Name: "King's Gambit Accepted: Schallopp Defense", Moves: "e4 e5 f4 exf4 Nf3 Nf6"},
+	"rnbqkbnr/pppp1ppp/8/8/3PPp2/8/PPP3PP/RNBQKBNR b KQkq -":               {ECO: "C33", Name: "King's Gambit Accepted: Villemson Gambit", Moves: "e4 e5 f4 exf4 d4"},
+	"rnb1kbnr/p1pp1ppp/8/1p6/2B1Pp1q/8/PPPP2PP/RNBQ1KNR w kq -":            {ECO: "C33", Name: "King's Gambit Accepted: Bishop's Gambit, Bryan Countergambit", Moves: "e4 e5 f4 exf4 Bc4 Qh4+ Kf1 b5"},
+	"rnbqkbnr/pppp1ppp/8/8/4Pp2/5N2/PPPP2PP/RNBQKB1R b KQkq -":             {ECO: "C34", Name: "King's Gambit Accepted: King's Knight Gambit", Moves: "e4 e5 f4 exf4 Nf3"},
+	"rnbqkbnr/ppp2ppp/3p4/8/4Pp2/5N2/PPPP2PP/RNBQKB1R w KQkq -":            {ECO: "C34", Name: "King's Gambit Accepted: Fischer Defense", Moves: "e4 e5 f4 exf4 Nf3 d6"},
+	"rnbqkbnr/pppp1pp1/7p/8/4Pp2/5N2/PPPP2PP/RNBQKB1R w KQkq -":            {ECO: "C34", Name: "King's Gambit Accepted: Becker Defense", Moves: "e4 e5 f4 exf4 Nf3 h6"},
+	"rnbqk1nr/ppppbppp/8/8/4Pp2/5N2/PPPP2PP/RNBQKB1R w KQkq -":             {ECO: "C35", Name: "King's Gambit Accepted: Cunningham Defense", Moves: "e4 e5 f4 exf4 Nf3 Be7"},
+	"rnbqk1nr/pppp1ppp/8/8/2B1Pp1b/5NP1/PPPP3P/RNBQK2R b KQkq -":           {ECO: "C35", Name: "King's Gambit Accepted: Cunningham Defense, Bertin Gambit", Moves: "e4 e5 f4 exf4 Nf3 Be7 Bc4 Bh4+ g3"},
+	"rnbqkbnr/ppp2ppp/8/3p4/4Pp2/5N2/PPPP2PP/RNBQKB1R w KQkq -":            {ECO: "C36", Name: "King's Gambit Accepted: Modern Defense", Moves: "e4 e5 f4 exf4 Nf3 d5"},
+	"rnbqkb1r/ppp2ppp/5n2/3P4/5p2/5N2/PPPP2PP/RNBQKB1R w KQkq -":           {ECO: "C36", Name: "King's Gambit Accepted: Abbazia Defense", Moves: "e4 e5 f4 exf4 Nf3 d5 exd5 Nf6"},
+	"rnbqkb1r/p4ppp/2p5/3n4/2B2p2/5N2/PPPP2PP/RNBQK2R w KQkq -":            {ECO: "C36", Name: "King's Gambit Accepted: Modern Defense, Main Line", Moves: "e4 e5 f4 exf4 Nf3 d5 exd5 Nf6 Bb5+ c6 dxc6 bxc6 Bc4 Nd5"},
+	"rnbqkbnr/pppp1p1p/8/8/2B1Ppp1/5N2/PPPP2PP/RNBQ1RK1 b kq -":            {ECO: "C37", Name: "King's Gambit Accepted: Muzio Gambit", Moves: "e4 e5 f4 exf4 Nf3 g5 Bc4 g4 O-O"},
+	"rnbqkbnr/pppp1p1p/8/6p1/4Pp2/2N2N2/PPPP2PP/R1BQKB1R b KQkq -":         {ECO: "C37", Name: "King's Gambit Accepted: Quaade Gambit", Moves: "e4 e5 f4 exf4 Nf3 g5 Nc3"},
+	"rnbqkbnr/pppp1p1p/8/6p1/4Pp2/5N2/PPPP2PP/RNBQKB1R w KQkq -":           {ECO: "C37", Name: "King's Gambit Accepted: King's Knight's Gambit", Moves: "e4 e5 f4 exf4 Nf3 g5"},
+	"rnb1kbnr/pppp1B1p/8/4q3/5p2/5Q2/PPPP2PP/RNB2RK1 b kq -":               {ECO: "C37", Name: "King's Gambit Accepted: Double Muzio Gambit", Moves: "e4 e5 f4 exf4 Nf3 g5 Bc4 g4 O-O gxf3 Qxf3 Qf6 e5 Qxe5 Bxf7+"},
+	"rnbqk1nr/pppp1pbp/8/6p1/2B1Pp2/5N2/PPPP2PP/RNBQK2R w KQkq -":          {ECO: "C38", Name: "King's Gambit Accepted: Traditional Variation", Moves: "e4 e5 f4 exf4 Nf3 g5 Bc4 Bg7"},
+	"rnbqk1nr/pppp1pbp/8/6p1/2B1Pp2/5N2/PPPP2PP/RNBQ1RK1 b kq -":           {ECO: "C38", Name: "King's Gambit Accepted: Hanstein Gambit", Moves: "e4 e5 f4 exf4 Nf3 g5 Bc4 Bg7 O-O"},
+	"rnbqk1nb/pp3p2/2pp4/4N1p1/2BPPp2/2N5/PPP3P1/R1BQK3 b Qq -":            {ECO: "C38", Name: "King's Gambit Accepted: Greco Gambit", Moves: "e4 e5 f4 exf4 Nf3 g5 Bc4 Bg7 h4 h6 d4 d6 Nc3 c6 hxg5 hxg5 Rxh8 Bxh8 Ne5"},
+	"rnbqkbnr/pppp1p1p/8/4N3/4PppP/8/PPPP2P1/RNBQKB1R b KQkq -":            {ECO: "C39", Name: "King's Gambit Accepted: Kieseritzky Gambit", Moves: "e4 e5 f4 exf4 Nf3 g5 h4 g4 Ne5"},
+	"rnbqkbnr/pppp1p1p/8/6N1/4PppP/8/PPPP2P1/RNBQKB1R b KQkq -":            {ECO: "C39", Name: "King's Gambit Accepted: Allgaier Gambit", Moves: "e4 e5 f4 exf4 Nf3 g5 h4 g4 Ng5"},
+	"rnbqkb1r/pppp1p1p/5n2/4N3/4PppP/8/PPPP2P1/RNBQKB1R w KQkq -":          {ECO: "C39", Name: "King's Gambit Accepted: Kieseritzky Gambit, Berlin Defense", Moves: "e4 e5 f4 exf4 Nf3 g5 h4 g4 Ne5 Nf6"},
+	"rnbqk2r/ppp2p1p/3b1n2/3PN3/2B2ppP/8/PPPP2P1/RNBQ1RK1 b kq -":          {ECO: "C39", Name: "King's Gambit Accepted: Kieseritzky Gambit, Rice Gambit", Moves: "e4 e5 f4 exf4 Nf3 g5 h4 g4 Ne5 Nf6 Bc4 d5 exd5 Bd6 O-O"},
+	"rnbqkbnr/pppp1ppp/8/4p3/4P3/5N2/PPPP1PPP/RNBQKB1R b KQkq -":           {ECO: "C40", Name: "King's Knight Opening", Moves: "e4 e5 Nf3"},
+	"rnbqkbnr/pppp2pp/8/4pp2/4P3/5N2/PPPP1PPP/RNBQKB1R w KQkq -":           {ECO: "C40", Name: "Latvian Gambit", Moves: "e4 e5 Nf3 f5"},
+	"rnbqkbnr/ppp2ppp/8/3pp3/4P3/5N2/PPPP1PPP/RNBQKB1R w KQkq -":           {ECO: "C40", Name: "Elephant Gambit", Moves: "e4 e5 Nf3 d5"},
+	"rnbqkbnr/pppp2pp/5p2/4p3/4P3/5N2/PPPP1PPP/RNBQKB1R w KQkq -":          {ECO: "C40", Name: "Damiano Defense", Moves: "e4 e5 Nf3 f6"},
+	"rnb1kbnr/ppppqppp/8/4p3/4P3/5N2/PPPP1PPP/RNBQKB1R w KQkq -":           {ECO: "C40", Name: "Gunderam Defense", Moves: "e4 e5 Nf3 Qe7"},
+	"rnb1kbnr/pppp1ppp/5q2/4p3/4P3/5N2/PPPP1PPP/RNBQKB1R w KQkq -":         {ECO: "C40", Name: "McConnell Defense", Moves: "e4 e5 Nf3 Qf6"},
+	"rnbqk1nr/pppp1ppp/8/2b1p3/4P3/5N2/PPPP1PPP/RNBQKB1R w KQkq -":         {ECO: "C40", Name: "King's Knight Opening: Busch-Gass Gambit", Moves: "e4 e5 Nf3 Bc5"},
+	"rnbqkbnr/ppp2ppp/3p4/4p3/4P3/5N2/PPPP1PPP/RNBQKB1R w KQkq -":          {ECO: "C41", Name: "Philidor Defense", Moves: "e4 e5 Nf3 d6"},
+	"rnbqkbnr/ppp2ppp/3p4/8/3pP3/5N2/PPP2PPP/RNBQKB1R w KQkq -":            {ECO: "C41", Name: "Philidor Defense: Exchange Variation", Moves: "e4 e5 Nf3 d6 d4 exd4"},
+	"r1bqkbnr/pppn1ppp/3p4/4p3/3PP3/5N2/PPP2PPP/RNBQKB1R w KQkq -":         {ECO: "C41", Name: "Philidor Defense: Hanham Variation", Moves: "e4 e5 Nf3 d6 d4 Nd7"},
+	"r1bqkb1r/pppn1ppp/3p1n2/4p3/3PP3/2N2N2/PPP2PPP/R1BQKB1R w KQkq -":     {ECO: "C41", Name: "Philidor Defense: Lion Variation", Moves: "e4 e5 Nf3 d6 d4 Nf6 Nc3 Nbd7"},
+	"rnbqkbnr/ppp3pp/3p4/4pp2/3PP3/5N2/PPP2PPP/RNBQKB1R w KQkq -":          {ECO: "C41", Name: "Philidor Defense: Philidor Countergambit", Moves: "e4 e5 Nf3 d6 d4 f5"},
+	"rnbqkbnr/ppp2p1p/3p2p1/8/3NP3/8/PPP2PPP/RNBQKB1R w KQkq -":            {ECO: "C41", Name: "Philidor Defense: Larsen Variation", Moves: "e4 e5 Nf3 d6 d4 exd4 Nxd4 g6"},
+	"rnbqkb1r/pppp1ppp/5n2/4p3/4P3/5N2/PPPP1PPP/RNBQKB1R w KQkq -":         {ECO: "C42", Name: "Russian Game", Moves: "e4 e5 Nf3 Nf6"},
+	"rnbqkb1r/ppp2ppp/3p4/8/3Pn3/5N2/PPP2PPP/RNBQKB1R b KQkq -":            {ECO: "C42", Name: "Russian Game: Classical Attack", Moves: "e4 e5 Nf3 Nf6 Nxe5 d6 Nf3 Nxe4 d4"},
+	"r1bqkb1r/pppp1ppp/2n2n2/4N3/4P3/8/PPPP1PPP/RNBQKB1R w KQkq -":         {ECO: "C42", Name: "Russian Game: Stafford Gambit", Moves: "e4 e5 Nf3 Nf6 Nxe5 Nc6"},
+	"rnbqkb1r/pppp1ppp/5n2/4p3/4P3/2N2N2/PPPP1PPP/R1BQKB1R b KQkq -":       {ECO: "C42", Name: "Russian Game: Three Knights Game", Moves: "e4 e5 Nf3 Nf6 Nc3"},
+	"rnbqkb1r/ppp2Npp/3p1n2/8/4P3/8/PPPP1PPP/RNBQKB1R b KQkq -":            {ECO: "C42", Name: "Russian Game: Cochrane Gambit", Moves: "e4 e5 Nf3 Nf6 Nxe5 d6 Nxf7"},
+	"rnbqkb1r/ppp2ppp/3p4/8/4n3/2N2N2/PPPP1PPP/R1BQKB1R b KQkq -":          {ECO: "C42", Name: "Russian Game: Nimzowitsch Attack", Moves: "e4 e5 Nf3 Nf6 Nxe5 d6 Nf3 Nxe4 Nc3"},
+	"rnbqkb1r/ppp2ppp/3p4/8/4n3/5N2/PPPPQPPP/RNB1KB1R b KQkq -":            {ECO: "C42", Name: "Russian Game: Cozio Attack", Moves: "e4 e5 Nf3 Nf6 Nxe5 d6 Nf3 Nxe4 Qe2"},
+	"r1bqk2r/ppp1bppp/2n5/3p4/2PPn3/3B1N2/PP3PPP/RNBQ1RK1 b kq -":          {ECO: "C42", Name: "Russian Game: Classical Attack, Jaenisch Variation", Moves: "e4 e5 Nf3 Nf6 Nxe5 d6 Nf3 Nxe4 d4 d5 Bd3 Be7 O-O Nc6 c4"},
+	"rnbqkb1r/pppp1ppp/5n2/4p3/3PP3/5N2/PPP2PPP/RNBQKB1R b KQkq -":         {ECO: "C43", Name: "Russian Game: Modern Attack", Moves: "e4 e5 Nf3 Nf6 d4"},
+	"rnbqkb1r/pppp1ppp/8/4P3/3Qn3/5N2/PPP2PPP/RNB1KB1R b KQkq -":           {ECO: "C43", Name: "Russian Game: Modern Attack, Center Variation", Moves: "e4 e5 Nf3 Nf6 d4 exd4 e5 Ne4 Qxd4"},
+	"rnbqkb1r/ppp2ppp/8/3pN3/3Pn3/3B4/PPP2PPP/RNBQK2R b KQkq -":            {ECO: "C43", Name: "Russian Game: Modern Attack, Main Line", Moves: "e4 e5 Nf3 Nf6 d4 Nxe4 Bd3 d5 Nxe5"},
+	"r1bqkbnr/pppp1ppp/2n5/4p3/4P3/5N2/PPPPBPPP/RNBQK2R b KQkq -":          {ECO: "C44", Name: "King's Pawn Game: Tayler Opening", Moves: "e4 e5 Nf3 Nc6 Be2"},
+	"r1bqkbnr/pppp1ppp/2n5/4p3/4P3/2P2N2/PP1P1PPP/RNBQKB1R b KQkq -":       {ECO: "C44", Name: "Ponziani Opening", Moves: "e4 e5 Nf3 Nc6 c3"},
+	"r1bqkbnr/pppp1ppp/2n5/4p3/3PP3/5N2/PPP2PPP/RNBQKB1R b KQkq -":         {ECO: "C44", Name: "Scotch Game", Moves: "e4 e5 Nf3 Nc6 d4"},
+	"r1bqkbnr/pppp1ppp/2n5/8/2BpP3/5N2/PPP2PPP/RNBQK2R b KQkq -":           {ECO: "C44", Name: "Scotch Gambit", Moves: "e4 e5 Nf3 Nc6 d4 exd4 Bc4"},
+	"r1bqkbnr/pppp1ppp/2n5/8/3pP3/2P2N2/PP3PPP/RNBQKB1R b KQkq -":          {ECO: "C44", Name: "Scotch Game: Göring Gambit", Moves: "e4 e5 Nf3 Nc6 d4 exd4 c3"},
+	"r1bqkbnr/pppp1ppp/2n5/4N3/4P3/8/PPPP1PPP/RNBQKB1R b KQkq -":           {ECO: "C44", Name: "Irish Gambit", Moves: "e4 e5 Nf3 Nc6 Nxe5"},
+	"r1bqkbnr/pppp1ppp/2n5/4p3/4P3/5NP1/PPPP1P1P/RNBQKB1R b KQkq -":        {ECO: "C44", Name: "Konstantinopolsky Opening", Moves: "e4 e5 Nf3 Nc6 g3"},
+	"r1bqkb1r/pppp1ppp/2n2n2/4p3/4P3/2P2N2/PP1P1PPP/RNBQKB1R w KQkq -":     {ECO: "C44", Name: "Ponziani Opening: Jaenisch Counterattack", Moves: "e4 e5 Nf3 Nc6 c3 Nf6"},
+	"r1bqkbnr/pppp1ppp/2n5/8/3NP3/8/PPP2PPP/RNBQKB1R b KQkq -":             {ECO: "C45", Name: "Scotch Game", Moves: "e4 e5 Nf3 Nc6 d4 exd4 Nxd4"},
+	"r1bqk1nr/pppp1ppp/2n5/2b5/3NP3/8/PPP2PPP/RNBQKB1R w KQkq -":           {ECO: "C45", Name: "Scotch Game: Classical Variation", Moves: "e4 e5 Nf3 Nc6 d4 exd4 Nxd4 Bc5"},
+	"r1bqkb1r/pppp1ppp/2n2n2/8/3NP3/8/PPP2PPP/RNBQKB1R w KQkq -":           {ECO: "C45", Name: "Scotch Game: Schmidt Variation", Moves: "e4 e5 Nf3 Nc6 d4 exd4 Nxd4 Nf6"},
+	"r1bqkb1r/p1pp1ppp/2p2n2/4P3/8/8/PPP2PPP/RNBQKB1R b KQkq -":            {ECO: "C45", Name: "Scotch Game: Mieses Variation", Moves: "e4 e5 Nf3 Nc6 d4 exd4 Nxd4 Nf6 Nxc6 bxc6 e5"},
+	"r1b1kbnr/pppp1ppp/2n5/8/3NP2q/8/PPP2PPP/RNBQKB1R w KQkq -":            {ECO: "C45", Name: "Scotch Game: Steinitz Variation", Moves: "e4 e5 Nf3 Nc6 d4 exd4 Nxd4 Qh4"},
+	"r1b1k1nr/pppp1ppp/2N2q2/2b5/4P3/8/PPP2PPP/RNBQKB1R w KQkq -":          {ECO: "C45", Name: "Scotch Game: Classical Variation, Intermezzo Variation", Moves: "e4 e5 Nf3 Nc6 d4 exd4 Nxd4 Bc5 Nxc6 Qf6"},
+	"r1bqk1nr/pppp1ppp/2n5/2b5/4P3/1N6/PPP2PPP/RNBQKB1R b KQkq -":          {ECO: "C45", Name: "Scotch Game: Potter Variation", Moves: "e4 e5 Nf3 Nc6 d4 exd4 Nxd4 Bc5 Nb3"},
+	"r1bqk1nr/pppp1ppp/2n5/8/1b1NP3/8/PPP2PPP/RNBQKB1R w KQkq -":           {ECO: "C45", Name: "Scotch Game: Malaniuk Variation", Moves: "e4 e5 Nf3 Nc6 d4 exd4 Nxd4 Bb4+"},
+	"r1bqkbnr/pppp1ppp/2n5/4p3/4P3/2N2N2/PPPP1PPP/R1BQKB1R b KQkq -":       {ECO: "C46", Name: "Three Knights Opening", Moves: "e4 e5 Nf3 Nc6 Nc3"},
+	"r1bqkbnr/pppp1p1p/2n3p1/4p3/4P3/2N2N2/PPPP1PPP/R1BQKB1R w KQkq -":     {ECO: "C46", Name: "Three Knights Opening: Steinitz Defense", Moves: "e4 e5 Nf3 Nc6 Nc3 g6"},
+	"r1bqk2r/pppp1ppp/2n2n2/3Np3/1b2P3/5N2/PPPP1PPP/R1BQKB1R w KQkq -":     {ECO: "C46", Name: "Three Knights Opening: Schlechter Variation", Moves: "e4 e5 Nf3 Nc6 Nc3 Bb4 Nd5 Nf6"},
+	"r1bqkb1r/pppp1ppp/2n2n2/4p3/4P3/2N2N2/PPPP1PPP/R1BQKB1R w KQkq -":     {ECO: "C47", Name: "Four Knights Game", Moves: "e4 e5 Nf3 Nc6 Nc3 Nf6"},
+	"r1bqkb1r/pppp1ppp/2n2n2/4p3/3PP3/2N2N2/PPP2PPP/R1BQKB1R b KQkq -":     {ECO: "C47", Name: "Four Knights Game: Scotch Variation", Moves: "e4 e5 Nf3 Nc6 Nc3 Nf6 d4"},
+	"r1bqkb1r/pppp1ppp/2n2n2/4p3/4P3/2N2NP1/PPPP1P1P/R1BQKB1R b KQkq -":    {ECO: "C47", Name: "Four Knights Game: Glek System", Moves: "e4 e5 Nf3 Nc6 Nc3 Nf6 g3"},
+	"r1bqkb1r/pppp1ppp/2n2n2/8/3pP3/2N2N2/PPP2PPP/R1BQKB1R w KQkq -":       {ECO: "C47", Name: "Four Knights Game: Scotch Variation Accepted", Moves: "e4 e5 Nf3 Nc6 Nc3 Nf6 d4 exd4"},
+	"r1bqkb1r/pppp1ppp/2n2n2/3N4/3pP3/5N2/PPP2PPP/R1BQKB1R b KQkq -":       {ECO: "C47", Name: "Four Knights Game: Belgrade Gambit", Moves: "e4 e5 Nf3 Nc6 Nc3 Nf6 d4 exd4 Nd5"},
+	"r1bqkb1r/pppp1ppp/2n2n2/1B2p3/4P3/2N2N2/PPPP1PPP/R1BQK2R b KQkq -":    {ECO: "C48", Name: "Four Knights Game: Spanish Variation", Moves: "e4 e5 Nf3 Nc6 Nc3 Nf6 Bb5"},
+	"r1bqkb1r/pppp1ppp/5n2/1B2p3/3nP3/2N2N2/PPPP1PPP/R1BQK2R w KQkq -":     {ECO: "C48", Name: "Four Knights Game: Rubinstein Countergambit", Moves: "e4 e5 Nf3 Nc6 Nc3 Nf6 Bb5 Nd4"},
+	"r1bqk2r/pppp1ppp/2n2n2/1Bb1p3/4P3/2N2N2/PPPP1PPP/R1BQK2R w KQkq -":    {ECO: "C48", Name: "Four Knights Game: Spanish Variation, Classical Variation", Moves: "e4 e5 Nf3 Nc6 Nc3 Nf6 Bb5 Bc5"},
+	"r1bqk2r/pppp1ppp/2n2n2/1B2p3/1b2P3/2N2N2/PPPP1PPP/R1BQK2R w KQkq -":   {ECO: "C49", Name: "Four Knights Game: Double Spanish", Moves: "e4 e5 Nf3 Nc6 Nc3 Nf6 Bb5 Bb4"},
+	"r1bq1rk1/ppp2ppp/2np1n2/1B2p3/1b2P3/2NP1N2/PPP2PPP/R1BQ1RK1 w - -":    {ECO: "C49", Name: "Four Knights Game: Spanish Variation, Symmetrical Variation", Moves: "e4 e5 Nf3 Nc6 Nc3 Nf6 Bb5 Bb4 O-O O-O d3 d6"},
+	"r1bqkbnr/pppp1ppp/2n5/4p3/2B1P3/5N2/PPPP1PPP/RNBQK2R b KQkq -":        {ECO: "C50", Name: "Italian Game", Moves: "e4 e5 Nf3 Nc6 Bc4"},
+	"r1bqk1nr/ppppbppp/2n5/4p3/2B1P3/5N2/PPPP1PPP/RNBQK2R w KQkq -":        {ECO: "C50", Name: "Italian Game: Hungarian Defense", Moves: "e4 e5 Nf3 Nc6 Bc4 Be7"},
+	"r1bqk1nr/pppp1ppp/2n5/2b1p3/2B1P3/5N2/PPPP1PPP/RNBQK2R w KQkq -":      {ECO: "C50", Name: "Giuoco Piano", Moves: "e4 e5 Nf3 Nc6 Bc4 Bc5"},
+	"r1bqk1nr/pppp1ppp/2n5/2b1p3/2B1P3/3P1N2/PPP2PPP/RNBQK2R b KQkq -":     {ECO: "C50", Name: "Giuoco Pianissimo", Moves: "e4 e5 Nf3 Nc6 Bc4 Bc5 d3"},
+	"r1bqkbnr/pppp1ppp/8/4p3/2BnP3/5N2/PPPP1PPP/RNBQK2R w KQkq -":          {ECO: "C50", Name: "Italian Game: Blackburne-Kostić Gambit", Moves: "e4 e5 Nf3 Nc6 Bc4 Nd4"},
+	"r1bqkbnr/pppp2pp/2n5/4pp2/2B1P3/5N2/PPPP1PPP/RNBQK2R w KQkq -":        {ECO: "C50", Name: "Italian Game: Rousseau Gambit", Moves: "e4 e5 Nf3 Nc6 Bc4 f5"},
+	"r1bqk2r/pppp1ppp/2n2n2/2b1p3/2B1P3/2N2N2/PPPP1PPP/R1BQK2R w KQkq -":   {ECO: "C50", Name: "Italian Game: Four Knights Variation", Moves: "e4 e5 Nf3 Nc6 Bc4 Bc5 Nc3 Nf6"},
+	"r1bqk1nr/pppp1ppp/2n5/2b1p3/1PB1P3/5N2/P1PP1PPP/RNBQK2R b KQkq -":     {ECO: "C51", Name: "Italian Game: Evans Gambit", Moves: "e4 e5 Nf3 Nc6 Bc4 Bc5 b4"},
+	"r1bqk1nr/pppp1ppp/1bn5/4p3/1PB1P3/5N2/P1PP1PPP/RNBQK2R w KQkq -":      {ECO: "C51", Name: "Italian Game: Evans Gambit Declined", Moves: "e4 e5 Nf3 Nc6 Bc4 Bc5 b4 Bb6"},
+	"r1bqk1nr/pppp1ppp/2n5/4p3/1bB1P3/2P2N2/P2P1PPP/RNBQK2R b KQkq -":      {ECO: "C52", Name: "Italian Game: Evans Gambit Accepted", Moves: "e4 e5 Nf3 Nc6 Bc4 Bc5 b4 Bxb4 c3"},
+	"r1bqk1nr/pppp1ppp/2n5/b3p3/2B1P3/2P2N2/P2P1PPP/RNBQK2R w KQkq -":      {ECO: "C52", Name: "Italian Game: Evans Gambit, Main Line", Moves: "e4 e5 Nf3 Nc6 Bc4 Bc5 b4 Bxb4 c3 Ba5"},
+	"r1bqk1nr/pppp1ppp/2n5/b7/2B1P3/2p2N2/P4PPP/RNBQ1RK1 w kq -":           {ECO: "C52", Name: "Italian Game: Evans Gambit, Compromised Defense", Moves: "e4 e5 Nf3 Nc6 Bc4 Bc5 b4 Bxb4 c3 Ba5 d4 exd4 O-O dxc3"},
+	"r1bqk1nr/pppp1ppp/2n5/2b1p3/2B1P3/2P2N2/PP1P1PPP/RNBQK2R b KQkq -":    {ECO: "C53", Name: "Italian Game: Classical Variation", Moves: "e4 e5 Nf3 Nc6 Bc4 Bc5 c3"},
+	"r1bqk2r/pppp1ppp/2n2n2/2b1p3/2B1P3/2P2N2/PP1P1PPP/RNBQ1RK1 b kq -":    {ECO: "C53", Name: "Italian Game: Classical Variation, Albin Gambit", Moves: "e4 e5 Nf3 Nc6 Bc4 Bc5 c3 Nf6 O-O"},
+	"r1b1k1nr/ppppqppp/2n5/2b1p3/2B1P3/2P2N2/PP1P1PPP/RNBQK2R w KQkq -":    {ECO: "C53", Name: "Italian Game: Classical Variation, Closed Variation", Moves: "e4 e5 Nf3 Nc6 Bc4 Bc5 c3 Qe7"},
+	"r1bqk1nr/ppp2ppp/2np4/2b1p3/2B1P3/2P2N2/PP1P1PPP/RNBQK2R w KQkq -":    {ECO: "C53", Name: "Italian Game: Classical Variation, La Bourdonnais Variation", Moves: "e4 e5 Nf3 Nc6 Bc4 Bc5 c3 d6"},
+	"r1bqk2r/pppp1ppp/2n2n2/2b1p3/2B1P3/2PP1N2/PP3PPP/RNBQK2R b KQkq -":    {ECO: "C54", Name: "Italian Game: Classical Variation, Giuoco Pianissimo", Moves: "e4 e5 Nf3 Nc6 Bc4 Bc5 c3 Nf6 d3"},
+	"r1bqk2r/pppp1ppp/2n2n2/2b1p3/2BPP3/2P2N2/PP3PPP/RNBQK2R b KQkq -":     {ECO: "C54", Name: "Italian Game: Classical Variation, Center Attack", Moves: "e4 e5 Nf3 Nc6 Bc4 Bc5 c3 Nf6 d4"},
+	"r1bqk2r/pppp1ppp/2n2n2/8/1bBPP3/2N2N2/PP3PPP/R1BQK2R b KQkq -":        {ECO: "C54", Name: "Italian Game: Classical Variation, Greco Gambit", Moves: "e4 e5 Nf3 Nc6 Bc4 Bc5 c3 Nf6 d4 exd4 cxd4 Bb4+ Nc3"},
+	"r1bqk2r/ppp2ppp/2n2n2/3p4/2BPP3/5N2/PP1N1PPP/R2QK2R w KQkq -":         {ECO: "C54", Name: "Italian Game: Classical Variation, Center Holding Variation", Moves: "e4 e5 Nf3 Nc6 Bc4 Bc5 c3 Nf6 d4 exd4 cxd4 Bb4+ Bd2 Bxd2+ Nbxd2 d5"},
+	"r1bqk2r/pppp1ppp/2n5/3P4/2B1n3/2b2N2/PP3PPP/R1BQ1RK1 b kq -":          {ECO: "C54", Name: "Italian Game: Classical Variation, Moller-Therkatz Attack", Moves: "e4 e5 Nf3 Nc6 Bc4 Bc5 c3 Nf6 d4 exd4 cxd4 Bb4+ Nc3 Nxe4 O-O Bxc3 d5"},
+	"r1bqkb1r/pppp1ppp/2n2n2/4p3/2B1P3/5N2/PPPP1PPP/RNBQK2R w KQkq -":      {ECO: "C55", Name: "Italian Game: Two Knights Defense", Moves: "e4 e5 Nf3 Nc6 Bc4 Nf6"},
+	"r1bqkb1r/pppp1ppp/2n2n2/4p3/2B1P3/3P1N2/PPP2PPP/RNBQK2R b KQkq -":     {ECO: "C55", Name: "Italian Game: Two Knights Defense, Modern Bishop's Opening", Moves: "e4 e5 Nf3 Nc6 Bc4 Nf6 d3"},
+	"r1bqkb1r/pppp1ppp/2n2n2/6N1/2BpP3/8/PPP2PPP/RNBQK2R b KQkq -":         {ECO: "C55", Name: "Italian Game: Two Knights Defense, Perreux Variation", Moves: "e4 e5 Nf3 Nc6 Bc4 Nf6 d4 exd4 Ng5"},
+	"r1bqkb1r/pppp1ppp/2n2n2/4p3/2BPP3/5N2/PPP2PPP/RNBQK2R b KQkq -":       {ECO: "C55", Name: "Italian Game: Two Knights Defense, Open Variation", Moves: "e4 e5 Nf3 Nc6 Bc4 Nf6 d4"},
+	"r1bqkb1r/pppp1ppp/2n2n2/8/2BpP3/5N2/PPP2PPP/RNBQ1RK1 b kq -":          {ECO: "C56", Name: "Italian Game: Scotch Gambit", Moves: "e4 e5 Nf3 Nc6 Bc4 Nf6 d4 exd4 O-O"},
+	"r1bqk2r/pppp1ppp/2n2n2/2b1P3/2Bp4/5N2/PPP2PPP/RNBQ1RK1 b kq -":        {ECO: "C56", Name: "Italian Game: Scotch Gambit, Max Lange Attack", Moves: "e4 e5 Nf3 Nc6 Bc4 Nf6 d4 exd4 O-O Bc5 e5"},
+	"r1b1kb1r/ppp2ppp/2n5/3q4/3pn3/2N2N2/PPP2PPP/R1BQR1K1 b kq -":          {ECO: "C56", Name: "Italian Game: Two Knights Defense, Modern Attack, Main Line", Moves: "e4 e5 Nf3 Nc6 Bc4 Nf6 d4 exd4 O-O Nxe4 Re1 d5 Bxd5 Qxd5 Nc3"},
+	"r1bqkb1r/pppp1ppp/2n2n2/4p1N1/2B1P3/8/PPPP1PPP/RNBQK2R b KQkq -":      {ECO: "C57", Name: "Italian Game: Two Knights Defense, Knight Attack", Moves: "e4 e5 Nf3 Nc6 Bc4 Nf6 Ng5"},
+	"r1bqk2r/pppp1ppp/2n2n2/2b1p1N1/2B1P3/8/PPPP1PPP/RNBQK2R w KQkq -":     {ECO: "C57", Name: "Italian Game: Two Knights Defense, Traxler Counterattack", Moves: "e4 e5 Nf3 Nc6 Bc4 Nf6 Ng5 Bc5"},
+	"r1bqkb1r/ppp2Npp/2n5/3np3/2B5/8/PPPP1PPP/RNBQK2R b KQkq -":            {ECO: "C57", Name: "Italian Game: Two Knights Defense, Fried Liver Attack", Moves: "e4 e5 Nf3 Nc6 Bc4 Nf6 Ng5 d5 exd5 Nxd5 Nxf7"},
+	"r1bqkb1r/p1p2ppp/2n2n2/1p1Pp1N1/2B5/8/PPPP1PPP/RNBQK2R w KQkq -":      {ECO: "C57", Name: "Italian Game: Two Knights Defense, Ulvestad Variation", Moves: "e4 e5 Nf3 Nc6 Bc4 Nf6 Ng5 d5 exd5 b5"},
+	"r1bqkb1r/ppp2ppp/5n2/3Pp1N1/2Bn4/8/PPPP1PPP/RNBQK2R w KQkq -":         {ECO: "C57", Name: "Italian Game: Two Knights Defense, Fritz Variation", Moves: "e4 e5 Nf3 Nc6 Bc4 Nf6 Ng5 d5 exd5 Nd4"},
+	"r1bqkb1r/ppp2ppp/2n5/3np1N1/2BP4/8/PPP2PPP/RNBQK2R b KQkq -":          {ECO: "C57", Name: "Italian Game: Two Knights Defense, Lolli Attack", Moves: "e4 e5 Nf3 Nc6 Bc4 Nf6 Ng5 d5 exd5 Nxd5 d4"},
+	"r1bqkb1r/ppp2ppp/5n2/n2Pp1N1/2B5/8/PPPP1PPP/RNBQK2R w KQkq -":         {ECO: "C58", Name: "Italian Game: Two Knights Defense, Polerio Defense", Moves: "e4 e5 Nf3 Nc6 Bc4 Nf6 Ng5 d5 exd5 Na5"},
+	"r1bqkb1r/ppp2ppp/5n2/n2Pp1N1/2B5/3P4/PPP2PPP/RNBQK2R b KQkq -":        {ECO: "C58", Name: "Italian Game: Two Knights Defense, Kieseritzky Variation", Moves: "e4 e5 Nf3 Nc6 Bc4 Nf6 Ng5 d5 exd5 Na5 d3"},
+	"r1bqkb1r/ppp2ppp/5n2/nB1Pp1N1/8/8/PPPP1PPP/RNBQK2R b KQkq -":          {ECO: "C58", Name: "Italian Game: Two Knights Defense, Polerio Defense, Bishop Check Line", Moves: "e4 e5 Nf3 Nc6 Bc4 Nf6 Ng5 d5 exd5 Na5 Bb5+"},
+	"r1bqkb1r/p4pp1/2p2n1p/n3p1N1/8/8/PPPPBPPP/RNBQK2R w KQkq -":           {ECO: "C59", Name: "Italian Game: Two Knights Defense, Polerio Defense, Suhle Defense", Moves: "e4 e5 Nf3 Nc6 Bc4 Nf6 Ng5 d5 exd5 Na5 Bb5+ c6 dxc6 bxc6 Be2 h6"},
+	"r1bqkb1r/p4pp1/2p2n1p/n3N3/4p3/8/PPPPBPPP/RNBQK2R b KQkq -":           {ECO: "C59", Name: "Italian Game: Two Knights Defense, Knorre Variation", Moves: "e4 e5 Nf3 Nc6 Bc4 Nf6 Ng5 d5 exd5 Na5 Bb5+ c6 dxc6 bxc6 Be2 h6 Nf3 e4 Ne5"},
+	"r1bqkbnr/pppp1ppp/2n5/1B2p3/4P3/5N2/PPPP1PPP/RNBQK2R b KQkq -":        {ECO: "C60", Name: "Ruy Lopez", Moves: "e4 e5 Nf3 Nc6 Bb5"},
+	"r1bqkb1r/ppppnppp/2n5/1B2p3/4P3/5N2/PPPP1PPP/RNBQK2R w KQkq -":        {ECO: "C60", Name: "Ruy Lopez: Cozio Defense", Moves: "e4 e5 Nf3 Nc6 Bb5 Nge7"},
+	"r1bqkbnr/pppp1p1p/2n3p1/1B2p3/4P3/5N2/PPPP1PPP/RNBQK2R w KQkq -":      {ECO: "C60", Name: "Ruy Lopez: Fianchetto Defense", Moves: "e4 e5 Nf3 Nc6 Bb5 g6"},
+	"r1bqk1nr/pppp1ppp/2n5/1B2p3/1b2P3/5N2/PPPP1PPP/RNBQK2R w KQkq -":      {ECO: "C60", Name: "Ruy Lopez: Alapin Defense", Moves: "e4 e5 Nf3 Nc6 Bb5 Bb4"},
+	"r1bqkbnr/pppp1p1p/2n5/1B2p1p1/4P3/5N2/PPPP1PPP/RNBQK2R w KQkq -":      {ECO: "C60", Name: "Ruy Lopez: Brentano Gambit", Moves: "e4 e5 Nf3 Nc6 Bb5 g5"},
+	"r1bqkbnr/pppp1ppp/8/1B2p3/3nP3/5N2/PPPP1PPP/RNBQK2R w KQkq -":         {ECO: "C61", Name: "Ruy Lopez: Bird Variation", Moves: "e4 e5 Nf3 Nc6 Bb5 Nd4"},
+	"r1bqkbnr/pppp1ppp/8/1B6/3pP3/8/PPPP1PPP/RNBQK2R w KQkq -":             {ECO: "C61", Name: "Ruy Lopez: Bird Variation, 4.Nxd4", Moves: "e4 e5 Nf3 Nc6 Bb5 Nd4 Nxd4 exd4"},
+	"r1bqkbnr/ppp2ppp/2np4/1B2p3/4P3/5N2/PPPP1PPP/RNBQK2R w KQkq -":        {ECO: "C62", Name: "Ruy Lopez: Steinitz Defense", Moves: "e4 e5 Nf3 Nc6 Bb5 d6"},
+	"r2qkb1r/pppb1ppp/2Bp1n2/4p3/3PP3/2N2N2/PPP2PPP/R1BQK2R b KQkq -":      {ECO: "C62", Name: "Ruy Lopez: Steinitz Defense, Nimzowitsch Attack", Moves: "e4 e5 Nf3 Nc6 Bb5 d6 d4 Bd7 Nc3 Nf6 Bxc6"},
+	"r1bqkbnr/pppp2pp/2n5/1B2pp2/4P3/5N2/PPPP1PPP/RNBQK2R w KQkq -":        {ECO: "C63", Name: "Ruy Lopez: Schliemann Defense", Moves: "e4 e5 Nf3 Nc6 Bb5 f5"},
+	"r1bqkbnr/pppp2pp/2n5/1B2pp2/4P3/2N2N2/PPPP1PPP/R1BQK2R b KQkq -":      {ECO: "C63", Name: "Ruy Lopez: Schliemann Defense, Dyckhoff Variation", Moves: "e4 e5 Nf3 Nc6 Bb5 f5 Nc3"},
+	"r1bqkbnr/pppp2pp/2B5/4pp2/4P3/5N2/PPPP1PPP/RNBQK2R b KQkq -":          {ECO: "C63", Name: "Ruy Lopez: Schliemann Defense, Exchange Variation", Moves: "e4 e5 Nf3 Nc6 Bb5 f5 Bxc6"},
+	"r1bqk1nr/pppp1ppp/2n5/1Bb1p3/4P3/5N2/PPPP1PPP/RNBQK2R w KQkq -":       {ECO: "C64", Name: "Ruy Lopez: Classical Variation", Moves: "e4 e5 Nf3 Nc6 Bb5 Bc5"},
+	"r1bqk1nr/pppp2pp/2n5/1Bb1pp2/4P3/2P2N2/PP1P1PPP/RNBQK2R w KQkq -":     {ECO: "C64", Name: "Ruy Lopez: Classical Variation, Cordel Gambit", Moves: "e4 e5 Nf3 Nc6 Bb5 Bc5 c3 f5"},
+	"r1bqk2r/pppp1ppp/2n2n2/1Bb1p3/4P3/2P2N2/PP1P1PPP/RNBQ1RK1 b kq -":     {ECO: "C64", Name: "Ruy Lopez: Classical Variation, Zukertort Gambit", Moves: "e4 e5 Nf3 Nc6 Bb5 Bc5 c3 Nf6 O-O"},
+	"r1bqkb1r/pppp1ppp/2n2n2/1B2p3/4P3/5N2/PPPP1PPP/RNBQK2R w KQkq -":      {ECO: "C65", Name: "Ruy Lopez: Berlin Defense", Moves: "e4 e5 Nf3 Nc6 Bb5 Nf6"},
+	"r1bqkb1r/pppp1ppp/2n2n2/1B2p3/4P3/3P1N2/PPP2PPP/RNBQK2R b KQkq -":     {ECO: "C65", Name: "Ruy Lopez: Berlin Defense, Anderssen Variation", Moves: "e4 e5 Nf3 Nc6 Bb5 Nf6 d3"},
+	"r1bqk2r/pppp1ppp/2n2n2/1Bb1p3/4P3/5N2/PPPP1PPP/RNBQ1RK1 w kq -":       {ECO: "C65", Name: "Ruy Lopez: Berlin Defense, Beverwijk Variation", Moves: "e4 e5 Nf3 Nc6 Bb5 Nf6 O-O Bc5"},
+	"r1bqkb1r/ppp2ppp/2np1n2/1B2p3/4P3/5N2/PPPP1PPP/RNBQ1RK1 w kq -":       {ECO: "C66", Name: "Ruy Lopez: Berlin Defense, Improved Steinitz Defense", Moves: "e4 e5 Nf3 Nc6 Bb5 Nf6 O-O d6"},
+	"r2qk2r/pppbbppp/2np1n2/1B2p3/3PP3/2N2N2/PPP2PPP/R1BQ1RK1 w kq -":      {ECO: "C66", Name: "Ruy Lopez: Berlin Defense, Hedgehog Variation", Moves: "e4 e5 Nf3 Nc6 Bb5 Nf6 O-O d6 d4 Bd7 Nc3 Be7"},
+	"r1bqkb1r/pppp1ppp/2n5/1B2p3/4n3/5N2/PPPP1PPP/RNBQ1RK1 w kq -":         {ECO: "C67", Name: "Ruy Lopez: Berlin Defense, Rio de Janeiro Variation", Moves: "e4 e5 Nf3 Nc6 Bb5 Nf6 O-O Nxe4"},
+	"r1bk1b1r/ppp2ppp/2p5/4Pn2/8/5N2/PPP2PPP/RNB2RK1 w - -":                {ECO: "C67", Name: "Ruy Lopez: Berlin Defense, Berlin Wall", Moves: "e4 e5 Nf3 Nc6 Bb5 Nf6 O-O Nxe4 d4 Nd6 Bxc6 dxc6 dxe5 Nf5 Qxd8+ Kxd8"},
+	"r1bqkb1r/pppp1ppp/2nn4/1B2P3/8/5N2/PPP2PPP/RNBQ1RK1 b kq -":           {ECO: "C67", Name: "Ruy Lopez: Berlin Defense, l'Hermet Variation", Moves: "e4 e5 Nf3 Nc6 Bb5 Nf6 O-O Nxe4 d4 Nd6 dxe5"},
+	"r1bqkbnr/1ppp1ppp/p1n5/1B2p3/4P3/5N2/PPPP1PPP/RNBQK2R w KQkq -":       {ECO: "C68", Name: "Ruy Lopez: Morphy Defense", Moves: "e4 e5 Nf3 Nc6 Bb5 a6"},
+	"r1bqkbnr/1ppp1ppp/p1B5/4p3/4P3/5N2/PPPP1PPP/RNBQK2R b KQkq -":         {ECO: "C68", Name: "Ruy Lopez: Exchange Variation", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Bxc6"},
+	"r1bqkbnr/1pp2ppp/p1p5/4p3/4P3/2N2N2/PPPP1PPP/R1BQK2R b KQkq -":        {ECO: "C68", Name: "Ruy Lopez: Exchange Variation, Keres Variation", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Bxc6 dxc6 Nc3"},
+	"r3kbnr/1ppb1ppp/p1p5/8/3NP3/8/PPP2PPP/RNB1K2R w KQkq -":               {ECO: "C68", Name: "Ruy Lopez: Exchange Variation, Alekhine Variation", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Bxc6 dxc6 d4 exd4 Qxd4 Qxd4 Nxd4 Bd7"},
+	"r1bqkbnr/1pp2ppp/p1p5/4p3/4P3/5N2/PPPP1PPP/RNBQ1RK1 b kq -":           {ECO: "C69", Name: "Ruy Lopez: Exchange Variation, Normal Variation", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Bxc6 dxc6 O-O"},
+	"r1bqkbnr/1pp3pp/p1p2p2/4p3/4P3/5N2/PPPP1PPP/RNBQ1RK1 w kq -":          {ECO: "C69", Name: "Ruy Lopez: Exchange Variation, Gligorić Variation", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Bxc6 dxc6 O-O f6"},
+	"r1b1kbnr/1pp2ppp/p1pq4/4p3/4P3/5N2/PPPP1PPP/RNBQ1RK1 w kq -":          {ECO: "C69", Name: "Ruy Lopez: Exchange Variation, Bronstein Variation", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Bxc6 dxc6 O-O Qd6"},
+	"r1bqkbnr/1ppp1ppp/p1n5/4p3/B3P3/5N2/PPPP1PPP/RNBQK2R b KQkq -":        {ECO: "C70", Name: "Ruy Lopez: Morphy Defense", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4"},
+	"r2qkbnr/1bpp1ppp/p1n5/1p2p3/4P3/1B3N2/PPPP1PPP/RNBQK2R w KQkq -":      {ECO: "C70", Name: "Ruy Lopez: Morphy Defense, Caro Variation", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 b5 Bb3 Bb7"},
+	"r1bqkbnr/2pp1ppp/p7/np2p3/4P3/1B3N2/PPPP1PPP/RNBQK2R w KQkq -":        {ECO: "C70", Name: "Ruy Lopez: Morphy Defense, Norwegian Variation", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 b5 Bb3 Na5"},
+	"r1bqk1nr/1ppp1ppp/p1n5/2b1p3/B3P3/5N2/PPPP1PPP/RNBQK2R w KQkq -":      {ECO: "C70", Name: "Ruy Lopez: Morphy Defense, Classical Defense Deferred", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Bc5"},
+	"r1bqkbnr/1pp2ppp/p1np4/4p3/B3P3/5N2/PPPP1PPP/RNBQK2R w KQkq -":        {ECO: "C71", Name: "Ruy Lopez: Morphy Defense, Modern Steinitz Defense", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 d6"},
+	"r1bqkbnr/5ppp/p2p4/1pp5/3QP3/1B6/PPP2PPP/RNB1K2R w KQkq -":            {ECO: "C71", Name: "Ruy Lopez: Noah's Ark Trap", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 d6 d4 b5 Bb3 Nxd4 Nxd4 exd4 Qxd4 c5"},
+	"r1bqkbnr/1pp2ppp/p1np4/4p3/B3P3/5N2/PPPP1PPP/RNBQ1RK1 b kq -":         {ECO: "C72", Name: "Ruy Lopez: Morphy Defense, Modern Steinitz Defense, 5.O-O", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 d6 O-O"},
+	"r1bqkbnr/2p2ppp/p1pp4/4p3/3PP3/5N2/PPP2PPP/RNBQK2R b KQkq -":          {ECO: "C73", Name: "Ruy Lopez: Morphy Defense, Modern Steinitz Defense, Richter Variation", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 d6 Bxc6+ bxc6 d4"},
+	"r1bqkbnr/1pp2ppp/p1np4/4p3/B3P3/2P2N2/PP1P1PPP/RNBQK2R b KQkq -":      {ECO: "C74", Name: "Ruy Lopez: Morphy Defense, Modern Steinitz Defense, 5.c3", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 d6 c3"},
+	"r1bqkbnr/1pp3pp/p1np4/4pp2/B3P3/2P2N2/PP1P1PPP/RNBQK2R w KQkq -":      {ECO: "C74", Name: "Ruy Lopez: Morphy Defense, Modern Steinitz Defense, Siesta Variation", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 d6 c3 f5"},
+	"r2qkbnr/1ppb1ppp/p1np4/4p3/B3P3/2P2N2/PP1P1PPP/RNBQK2R w KQkq -":      {ECO: "C75", Name: "Ruy Lopez: Morphy Defense, Modern Steinitz Defense, Rubinstein Variation", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 d6 c3 Bd7"},
+	"r2qkbnr/1ppb1p1p/p1np2p1/4p3/B2PP3/2P2N2/PP3PPP/RNBQK2R w KQkq -":     {ECO: "C76", Name: "Ruy Lopez: Morphy Defense, Modern Steinitz Defense, Fianchetto Variation", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 d6 c3 Bd7 d4 g6"},
+	"r1bqkb1r/1ppp1ppp/p1n2n2/4p3/B3P3/5N2/PPPP1PPP/RNBQK2R w KQkq -":      {ECO: "C77", Name: "Ruy Lopez: Morphy Defense", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6"},
+	"r1bqkb1r/1ppp1ppp/p1n2n2/4p3/B3P3/3P1N2/PPP2PPP/RNBQK2R b KQkq -":     {ECO: "C77", Name: "Ruy Lopez: Morphy Defense, Anderssen Variation", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 d3"},
+	"r1bqkb1r/1ppp1ppp/p1n2n2/4p3/B3P3/5N2/PPPPQPPP/RNB1K2R b KQkq -":      {ECO: "C77", Name: "Ruy Lopez: Morphy Defense, Wormald Attack", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 Qe2"},
+	"r1bqkb1r/1ppp1ppp/p1n2n2/4p3/B3P3/2N2N2/PPPP1PPP/R1BQK2R b KQkq -":    {ECO: "C77", Name: "Ruy Lopez: Four Knights Variation", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 Nc3"},
+	"r1bqkb1r/1ppp1ppp/p1n2n2/4p3/B3P3/5N2/PPPP1PPP/RNBQ1RK1 b kq -":       {ECO: "C78", Name: "Ruy Lopez: Morphy Defense", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O"},
+	"r2qkb1r/1bpp1ppp/p1n2n2/1p2p3/4P3/1B3N2/PPPP1PPP/RNBQ1RK1 w kq -":     {ECO: "C78", Name: "Ruy Lopez: Arkhangelsk Variation", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O b5 Bb3 Bb7"},
+	"r1bqk2r/2pp1ppp/p1n2n2/1pb1p3/4P3/1B3N2/PPPP1PPP/RNBQ1RK1 w kq -":     {ECO: "C78", Name: "Ruy Lopez: Morphy Defense, Neo-Arkhangelsk Variation", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O b5 Bb3 Bc5"},
+	"r1bqk2r/1ppp1ppp/p1n2n2/2b1p3/B3P3/5N2/PPPP1PPP/RNBQ1RK1 w kq -":      {ECO: "C78", Name: "Ruy Lopez: Møller Defense", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Bc5"},
+	"r1bqkb1r/1pp2ppp/p1np1n2/4p3/B3P3/5N2/PPPP1PPP/RNBQ1RK1 w kq -":       {ECO: "C79", Name: "Ruy Lopez: Morphy Defense, Steinitz Deferred", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O d6"},
+	"r1bqkb1r/1ppp1ppp/p1n5/4p3/B3n3/5N2/PPPP1PPP/RNBQ1RK1 w kq -":         {ECO: "C80", Name: "Ruy Lopez: Open", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Nxe4"},
+	"r2qkb1r/2p2ppp/p1n1b3/1p1pP3/4n3/1B3N2/PPP2PPP/RNBQ1RK1 w kq -":       {ECO: "C80", Name: "Ruy Lopez: Open, Main Line", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Nxe4 d4 b5 Bb3 d5 dxe5 Be6"},
+	"r1bqkb1r/1ppp1ppp/p1n5/8/B2pn3/5N2/PPP2PPP/RNBQ1RK1 w kq -":           {ECO: "C80", Name: "Ruy Lopez: Open, Riga Variation", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Nxe4 d4 exd4"},
+	"r2qkb1r/2p2ppp/p1n1b3/1p1pP3/4n3/1B3N2/PPP1QPPP/RNB2RK1 b kq -":       {ECO: "C81", Name: "Ruy Lopez: Open, Howell Attack", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Nxe4 d4 b5 Bb3 d5 dxe5 Be6 Qe2"},
+	"r2qkb1r/2p2ppp/p1n1b3/1p1pP3/4n3/1BP2N2/PP3PPP/RNBQ1RK1 b kq -":       {ECO: "C82", Name: "Ruy Lopez: Open, 9.c3", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Nxe4 d4 b5 Bb3 d5 dxe5 Be6 c3"},
+	"r2q1rk1/2p2ppp/p1n1b3/1pbpP3/8/2P2N2/PPBN1nPP/R1BQ1RK1 w - -":         {ECO: "C82", Name: "Ruy Lopez: Open, Dilworth Variation", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Nxe4 d4 b5 Bb3 d5 dxe5 Be6 c3 Bc5 Nbd2 O-O Bc2 Nxf2"},
+	"r2qk2r/2p1bppp/p1n1b3/1p1pP3/4n3/1BP2N2/PP3PPP/RNBQ1RK1 w kq -":       {ECO: "C83", Name: "Ruy Lopez: Open, Classical Defense", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Nxe4 d4 b5 Bb3 d5 dxe5 Be6 c3 Be7"},
+	"r1bqk2r/1pppbppp/p1n2n2/4p3/B3P3/5N2/PPPP1PPP/RNBQ1RK1 w kq -":        {ECO: "C84", Name: "Ruy Lopez: Closed", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Be7"},
+	"r1bqk2r/1pppbppp/p1n2n2/4p3/B2PP3/5N2/PPP2PPP/RNBQ1RK1 b kq -":        {ECO: "C84", Name: "Ruy Lopez: Closed, Center Attack", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Be7 d4"},
+	"r1bqk2r/1pppbppp/p1n2n2/4p3/B3P3/3P1N2/PPP2PPP/RNBQ1RK1 b kq -":       {ECO: "C84", Name: "Ruy Lopez: Closed, Martinez Variation", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Be7 d3"},
+	"r1bqk2r/1pppbppp/p1B2n2/4p3/4P3/5N2/PPPP1PPP/RNBQ1RK1 b kq -":         {ECO: "C85", Name: "Ruy Lopez: Closed, Delayed Exchange", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Be7 Bxc6"},
+	"r1bqk2r/1pp1bppp/p1p2n2/4p3/4P3/5N2/PPPP1PPP/RNBQ1RK1 w kq -":         {ECO: "C85", Name: "Ruy Lopez: Closed, Delayed Exchange, 6...dxc6", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Be7 Bxc6 dxc6"},
+	"r1bqk2r/1pppbppp/p1n2n2/4p3/B3P3/5N2/PPPPQPPP/RNB2RK1 b kq -":         {ECO: "C86", Name: "Ruy Lopez: Worrall Attack", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Be7 Qe2"},
+	"r1bq1rk1/2ppbppp/p1n2n2/1p2p3/4P3/1B3N2/PPPPQPPP/RNB2RK1 w - -":       {ECO: "C86", Name: "Ruy Lopez: Worrall Attack, Sharp Line", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Be7 Qe2 b5 Bb3 O-O"},
+	"r1bqk2r/1pp1bppp/p1np1n2/4p3/B3P3/5N2/PPPP1PPP/RNBQR1K1 w kq -":       {ECO: "C87", Name: "Ruy Lopez: Closed, Averbakh Variation", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Be7 Re1 d6"},
+	"r1bqk2r/2ppbppp/p1n2n2/1p2p3/4P3/1B3N2/PPPP1PPP/RNBQR1K1 b kq -":      {ECO: "C88", Name: "Ruy Lopez: Closed", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Be7 Re1 b5 Bb3"},
+	"r1bq1rk1/2ppbppp/p1n2n2/1p2p3/P3P3/1B3N2/1PPP1PPP/RNBQR1K1 b - -":     {ECO: "C88", Name: "Ruy Lopez: Closed, Anti-Marshall", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Be7 Re1 b5 Bb3 O-O a4"},
+	"r1bq1rk1/2ppbppp/p1n2n2/1p2p3/4P3/1B3N2/PPPP1PPP/RNBQR1K1 w - -":      {ECO: "C88", Name: "Ruy Lopez: Closed, 7...O-O", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Be7 Re1 b5 Bb3 O-O"},
+	"r2qk2r/1bppbppp/p1n2n2/1p2p3/4P3/1B3N2/PPPP1PPP/RNBQR1K1 w kq -":      {ECO: "C88", Name: "Ruy Lopez: Closed, Trajković Counterattack", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Be7 Re1 b5 Bb3 Bb7"},
+	"r1bq1rk1/2ppbppp/p1n2n2/1p2p3/4P3/1BP2N2/PP1P1PPP/RNBQR1K1 b - -":     {ECO: "C88", Name: "Ruy Lopez: Noah's Ark Trap, Closed", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Be7 Re1 b5 Bb3 O-O c3"},
+	"r1bq1rk1/2p1bppp/p1n2n2/1p1pp3/4P3/1BP2N2/PP1P1PPP/RNBQR1K1 w - -":    {ECO: "C89", Name: "Ruy Lopez: Marshall Attack", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Be7 Re1 b5 Bb3 O-O c3 d5"},
+	"r1bq1rk1/4bppp/p1p5/1p1nR3/8/1BP5/PP1P1PPP/RNBQ2K1 w - -":             {ECO: "C89", Name: "Ruy Lopez: Marshall Attack, Main Line", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Be7 Re1 b5 Bb3 O-O c3 d5 exd5 Nxd5 Nxe5 Nxe5 Rxe5 c6"},
+	"r1b2rk1/5ppp/p1pb4/1p1n4/3P4/1BP3Pq/PP3P1P/RNBQR1K1 w - -":            {ECO: "C89", Name: "Ruy Lopez: Marshall Attack, Modern Main Line", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Be7 Re1 b5 Bb3 O-O c3 d5 exd5 Nxd5 Nxe5 Nxe5 Rxe5 c6 d4 Bd6 Re1 Qh4 g3 Qh3"},
+	"r1bqk2r/2p1bppp/p1np1n2/1p2p3/4P3/1B3N2/PPPP1PPP/RNBQR1K1 w kq -":     {ECO: "C90", Name: "Ruy Lopez: Closed", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Be7 Re1 b5 Bb3 d6"},
+	"r1bq1rk1/2p1bppp/p1np1n2/1p2p3/4P3/1BPP1N2/PP3PPP/RNBQR1K1 b - -":     {ECO: "C90", Name: "Ruy Lopez: Closed, Pilnik Variation", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Be7 Re1 b5 Bb3 d6 c3 O-O d3"},
+	"r1bq1rk1/2p1bppp/p1np1n2/1p2p3/4P3/2P2N2/PPBP1PPP/RNBQR1K1 b - -":     {ECO: "C90", Name: "Ruy Lopez: Closed, Lutikov Gambit", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Be7 Re1 b5 Bb3 d6 c3 O-O Bc2"},
+	"r1bqk2r/2p1bppp/p1np1n2/1p2p3/4P3/1BP2N2/PP1P1PPP/RNBQR1K1 b kq -":    {ECO: "C90", Name: "Ruy Lopez: Closed, 8.c3", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Be7 Re1 b5 Bb3 d6 c3"},
+	"r1bq1rk1/2p1bppp/p1np1n2/1p2p3/3PP3/1BP2N2/PP3PPP/RNBQR1K1 b - -":     {ECO: "C91", Name: "Ruy Lopez: Closed, 9.d4", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Be7 Re1 b5 Bb3 d6 c3 O-O d4"},
+	"r2q1rk1/2p1bppp/p1np1n2/1p2p3/3PP1b1/1BP2N2/PP3PPP/RNBQR1K1 w - -":    {ECO: "C91", Name: "Ruy Lopez: Closed, Bogoljubov Variation", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Be7 Re1 b5 Bb3 d6 c3 O-O d4 Bg4"},
+	"r1bq1rk1/2p1bppp/p1np1n2/1p2p3/4P3/1BP2N1P/PP1P1PP1/RNBQR1K1 b - -":   {ECO: "C92", Name: "Ruy Lopez: Closed", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Be7 Re1 b5 Bb3 d6 c3 O-O h3"},
+	"r2q1rk1/1bp1bppp/p1np1n2/1p2p3/4P3/1BP2N1P/PP1P1PP1/RNBQR1K1 w - -":   {ECO: "C92", Name: "Ruy Lopez: Closed, Zaitsev System", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Be7 Re1 b5 Bb3 d6 c3 O-O h3 Bb7"},
+	"r2q1rk1/2pbbppp/p1np1n2/1p2p3/4P3/1BP2N1P/PP1P1PP1/RNBQR1K1 w - -":    {ECO: "C92", Name: "Ruy Lopez: Closed, Flohr System", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Be7 Re1 b5 Bb3 d6 c3 O-O h3 Bd7"},
+	"r1bq1rk1/2pnbppp/p1np4/1p2p3/4P3/1BP2N1P/PP1P1PP1/RNBQR1K1 w - -":     {ECO: "C92", Name: "Ruy Lopez: Closed, Keres Defense", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Be7 Re1 b5 Bb3 d6 c3 O-O h3 Nd7"},
+	"r2q1rk1/2p1bppp/p1npbn2/1p2p3/4P3/1BP2N1P/PP1P1PP1/RNBQR1K1 w - -":    {ECO: "C92", Name: "Ruy Lopez: Closed, Kholmov Variation", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Be7 Re1 b5 Bb3 d6 c3 O-O h3 Be6"},
+	"r1bq1rk1/2p1bpp1/p1np1n1p/1p2p3/4P3/1BP2N1P/PP1P1PP1/RNBQR1K1 w - -":  {ECO: "C93", Name: "Ruy Lopez: Closed, Smyslov Defense", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Be7 Re1 b5 Bb3 d6 c3 O-O h3 h6"},
+	"rnbq1rk1/2p1bppp/p2p1n2/1p2p3/4P3/1BPP1N1P/PP3PP1/RNBQR1K1 b - -":     {ECO: "C94", Name: "Ruy Lopez: Closed, Breyer Defense, 10.d3", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Be7 Re1 b5 Bb3 d6 c3 O-O h3 Nb8 d3"},
+	"rnbq1rk1/2p1bppp/p2p1n2/1p2p3/4P3/1BP2N1P/PP1P1PP1/RNBQR1K1 w - -":    {ECO: "C95", Name: "Ruy Lopez: Closed, Breyer Defense", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Be7 Re1 b5 Bb3 d6 c3 O-O h3 Nb8"},
+	"rnbq1rk1/2p1bppp/p2p1n2/1p2p3/3PP3/1BP2N1P/PP3PP1/RNBQR1K1 b - -":     {ECO: "C95", Name: "Ruy Lopez: Closed, Breyer Defense, 10.d4", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Be7 Re1 b5 Bb3 d6 c3 O-O h3 Nb8 d4"},
+	"r2qrbk1/1bpn1ppp/p2p1n2/1p2p3/3PP3/2P2N1P/PPB2PP1/R1BQRNK1 w - -":     {ECO: "C95", Name: "Ruy Lopez: Closed, Breyer Defense, Zaitsev Hybrid", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Be7 Re1 b5 Bb3 d6 c3 O-O h3 Nb8 d4 Nbd7 Nbd2 Bb7 Bc2 Re8 Nf1 Bf8"},
+	"r1bq1rk1/2p1bppp/p2p1n2/np2p3/4P3/2P2N1P/PPBP1PP1/RNBQR1K1 b - -":     {ECO: "C96", Name: "Ruy Lopez: Closed", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Be7 Re1 b5 Bb3 d6 c3 O-O h3 Na5 Bc2"},
+	"r1bq1rk1/2p1bppp/p2p1n2/np2p3/4P3/1BP2N1P/PP1P1PP1/RNBQR1K1 w - -":    {ECO: "C96", Name: "Ruy Lopez: Closed, Chigorin Defense", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Be7 Re1 b5 Bb3 d6 c3 O-O h3 Na5"},
+	"r1bq1rk1/4bppp/p1np1n2/1pp1p3/3PP3/2P2N1P/PPB2PP1/RNBQR1K1 w - -":     {ECO: "C96", Name: "Ruy Lopez: Closed, Borisenko Defense", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Be7 Re1 b5 Bb3 d6 c3 O-O h3 Na5 Bc2 c5 d4 Nc6"},
+	"r1b2rk1/2q1bppp/p2p1n2/npp1p3/3PP3/2P2N1P/PPB2PP1/RNBQR1K1 w - -":     {ECO: "C97", Name: "Ruy Lopez: Closed, Chigorin Defense", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Be7 Re1 b5 Bb3 d6 c3 O-O h3 Na5 Bc2 c5 d4 Qc7"},
+	"r3r1k1/2qbbppp/p2p1n2/npp1p3/3PP3/2P2N1P/PPB2PP1/R1BQRNK1 w - -":      {ECO: "C97", Name: "Ruy Lopez: Closed, Chigorin Defense, Yugoslav System", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Be7 Re1 b5 Bb3 d6 c3 O-O h3 Na5 Bc2 c5 d4 Qc7 Nbd2 Bd7 Nf1 Rfe8"},
+	"r1b2rk1/2q1bppp/p1np1n2/1pp1p3/3PP3/2P2N1P/PPBN1PP1/R1BQR1K1 w - -":   {ECO: "C98", Name: "Ruy Lopez: Closed, Chigorin Defense, Rauzer Attack", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Be7 Re1 b5 Bb3 d6 c3 O-O h3 Na5 Bc2 c5 d4 Qc7 Nbd2 Nc6"},
+	"r1b2rk1/2q1bppp/p2p1n2/np2p3/3PP3/5N1P/PPBN1PP1/R1BQR1K1 b - -":       {ECO: "C99", Name: "Ruy Lopez: Closed, Chigorin Defense, Panov System", Moves: "e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Be7 Re1 b5 Bb3 d6 c3 O-O h3 Na5 Bc2 c5 d4 Qc7 Nbd2 cxd4 cxd4"},
+	"rnbqkbnr/ppp1pppp/8/3p4/3P4/8/PPP1PPPP/RNBQKBNR w KQkq -":             {ECO: "D00", Name: "Queen's Pawn Game", Moves: "d4 d5"},
+	"rnbqkbnr/ppp1pppp/8/3p4/3P1B2/8/PPP1PPPP/RN1QKBNR b KQkq -":           {ECO: "D00", Name: "Queen's Pawn Game: Accelerated London System", Moves: "d4 d5 Bf4"},
+	"rnbqkbnr/ppp1pppp/8/3p4/3PP3/8/PPP2PPP/RNBQKBNR b KQkq -":             {ECO: "D00", Name: "Blackmar-Diemer Gambit", Moves: "d4 d5 e4"},
+	"rnbqkb1r/ppp1pppp/5n2/8/3Pp3/2N2P2/PPP3PP/R1BQKBNR b KQkq -":          {ECO: "D00", Name: "Blackmar-Diemer Gambit", Moves: "d4 d5 e4 dxe4 Nc3 Nf6 f3"},
+	"rnbqkbnr/ppp1pppp/8/3p4/3P4/2N5/PPP1PPPP/R1BQKBNR b KQkq -":           {ECO: "D00", Name: "Queen's Pawn Game: Chigorin Variation", Moves: "d4 d5 Nc3"},
+	"rnbqkbnr/ppp1pppp/8/3p2B1/3P4/8/PPP1PPPP/RN1QKBNR b KQkq -":           {ECO: "D00", Name: "Queen's Pawn Game: Levitsky Attack", Moves: "d4 d5 Bg5"},
+	"rnbqkb1r/ppp1pppp/5n2/3p4/3P4/3BP3/PPP2PPP/RNBQK1NR b KQkq -":         {ECO: "D00", Name: "Queen's Pawn Game: Stonewall Attack", Moves: "d4 d5 e3 Nf6 Bd3"},
+	"rnbqkb1r/ppp1pppp/5n2/8/3P4/2N2N2/PPP3PP/R1BQKB1R b KQkq -":           {ECO: "D00", Name: "Blackmar-Diemer Gambit Accepted", Moves: "d4 d5 e4 dxe4 Nc3 Nf6 f3 exf3 Nxf3"},
+	"rn1qkb1r/ppp1pppp/5n2/8/3P2b1/2N2N2/PPP3PP/R1BQKB1R w KQkq -":         {ECO: "D00", Name: "Blackmar-Diemer Gambit: Teichmann Defense", Moves: "d4 d5 e4 dxe4 Nc3 Nf6 f3 exf3 Nxf3 Bg4"},
+	"rnbqkb1r/ppp1pppp/5n2/3p4/3P1B2/2N5/PPP1PPPP/R2QKBNR b KQkq -":        {ECO: "D01", Name: "Rapport-Jobava System", Moves: "d4 d5 Nc3 Nf6 Bf4"},
+	"rnbqkb1r/ppp1pppp/5n2/3p2B1/3P4/2N5/PPP1PPPP/R2QKBNR b KQkq -":        {ECO: "D01", Name: "Richter-Veresov Attack", Moves: "d4 d5 Nc3 Nf6 Bg5"},
+	"r1bqkb1r/pppnpppp/5n2/3p2B1/3P4/2N2N2/PPP1PPPP/R2QKB1R b KQkq -":      {ECO: "D01", Name: "Richter-Veresov Attack: Two Knights System", Moves: "d4 d5 Nc3 Nf6 Bg5 Nbd7 Nf3"},
+	"rnbqkbnr/ppp1pppp/8/3p4/3P4/5N2/PPP1PPPP/RNBQKB1R b KQkq -":           {ECO: "D02", Name: "Queen's Pawn Game: Zukertort Variation", Moves: "d4 d5 Nf3"},
+	"rnbqkb1r/ppp1pppp/5n2/3p4/3P1B2/5N2/PPP1PPPP/RN1QKB1R b KQkq -":       {ECO: "D02", Name: "Queen's Pawn Game: London System", Moves: "d4 d5 Nf3 Nf6 Bf4"},
+	"rnbqkb1r/ppp1pppp/5n2/3p4/3P4/5N2/PPP1PPPP/RNBQKB1R w KQkq -":         {ECO: "D02", Name: "Queen's Pawn Game: Symmetrical Variation", Moves: "d4 d5 Nf3 Nf6"},
+	"r1bqkbnr/ppp1pppp/2n5/3p4/3P4/5N2/PPP1PPPP/RNBQKB1R w KQkq -":         {ECO: "D02", Name: "Queen's Pawn Game: Chigorin Variation, with Nf3", Moves: "d4 d5 Nf3 Nc6"},
+	"rn1qkbnr/ppp1pppp/8/3p1b2/3P4/5N2/PPP1PPPP/RNBQKB1R w KQkq -":         {ECO: "D02", Name: "Queen's Gambit Declined: Baltic Defense, Queen Attack Deferred", Moves: "d4 d5 Nf3 Bf5"},
+	"rnbqkb1r/ppp1pppp/5n2/3p2B1/3P4/5N2/PPP1PPPP/RN1QKB1R b KQkq -":       {ECO: "D03", Name: "Queen's Pawn Game: Torre Attack", Moves: "d4 d5 Nf3 Nf6 Bg5"},
+	"rnbqkb1r/ppp1pppp/8/3p2B1/3Pn3/5N2/PPP1PPPP/RN1QKB1R w KQkq -":        {ECO: "D03", Name: "Queen's Pawn Game: Torre Attack, Grünfeld Variation", Moves: "d4 d5 Nf3 Nf6 Bg5 Ne4"},
+	"rnbqkb1r/ppp1pppp/5n2/3p4/3P4/4PN2/PPP2PPP/RNBQKB1R b KQkq -":         {ECO: "D04", Name: "Queen's Pawn Game: Colle System", Moves: "d4 d5 Nf3 Nf6 e3"},
+	"rn1qkb1r/ppp1pppp/5n2/3p1b2/3P4/4PN2/PPP2PPP/RNBQKB1R w KQkq -":       {ECO: "D04", Name: "Queen's Pawn Game: Colle System, Anti-Colle", Moves: "d4 d5 Nf3 Nf6 e3 Bf5"},
+	"rnbqkb1r/ppp2ppp/4pn2/3p4/3P4/3BPN2/PPP2PPP/RNBQK2R b KQkq -":         {ECO: "D05", Name: "Queen's Pawn Game: Colle System", Moves: "d4 d5 Nf3 Nf6 e3 e6 Bd3"},
+	"rnbqkb1r/pp3ppp/4pn2/2pp4/3P4/1P1BPN2/P1P2PPP/RNBQK2R b KQkq -":       {ECO: "D05", Name: "Queen's Pawn Game: Zukertort Variation", Moves: "d4 d5 Nf3 Nf6 e3 e6 Bd3 c5 b3"},
+	"r1bq1rk1/pp3ppp/2nbpn2/2pp4/3P4/1P1BPN2/PBP2PPP/RN1Q1RK1 w - -":       {ECO: "D05", Name: "Queen's Pawn Game: Colle System, Rubinstein Attack", Moves: "d4 d5 Nf3 Nf6 e3 e6 Bd3 c5 b3 Nc6 O-O Bd6 Bb2 O-O"},
+	"rnbqkbnr/ppp1pppp/8/3p4/2PP4/8/PP2PPPP/RNBQKBNR b KQkq -":             {ECO: "D06", Name: "Queen's Gambit", Moves: "d4 d5 c4"},
+	"rn1qkbnr/ppp1pppp/8/3p1b2/2PP4/8/PP2PPPP/RNBQKBNR w KQkq -":           {ECO: "D06", Name: "Queen's Gambit Refused: Baltic Defense", Moves: "d4 d5 c4 Bf5"},
+	"rnbqkb1r/ppp1pppp/5n2/3p4/2PP4/8/PP2PPPP/RNBQKBNR w KQkq -":           {ECO: "D06", Name: "Queen's Gambit Declined: Marshall Defense", Moves: "d4 d5 c4 Nf6"},
+	"rnbqkbnr/pp2pppp/8/2pp4/2PP4/8/PP2PPPP/RNBQKBNR w KQkq -":             {ECO: "D06", Name: "Queen's Gambit Declined: Austrian Defense", Moves: "d4 d5 c4 c5"},
+	"rnbqkb1r/pp2pppp/5n2/2pP4/3P4/8/PP2PPPP/RNBQKBNR w KQkq -":            {ECO: "D06", Name: "Queen's Gambit Declined: Symmetrical Defense", Moves: "d4 d5 c4 c5 cxd5 Nf6"},
+	"r1bqkbnr/ppp1pppp/2n5/3p4/2PP4/8/PP2PPPP/RNBQKBNR w KQkq -":           {ECO: "D07", Name: "Queen's Gambit Declined: Chigorin Defense", Moves: "d4 d5 c4 Nc6"},
+	"r1bqkbnr/ppp1pppp/2n5/8/2pP4/2N2N2/PP2PPPP/R1BQKB1R b KQkq -":         {ECO: "D07", Name: "Queen's Gambit Declined: Chigorin Defense, Main Line", Moves: "d4 d5 c4 Nc6 Nc3 dxc4 Nf3"},
+	"r1bqkb1r/ppp1pppp/2n2n2/8/2pP4/2N2N2/PP2PPPP/R1BQKB1R w KQkq -":       {ECO: "D07", Name: "Queen's Gambit Declined: Chigorin Defense, Janowski Variation", Moves: "d4 d5 c4 Nc6 Nc3 dxc4 Nf3 Nf6"},
+	"rnbqkbnr/ppp2ppp/8/3pp3/2PP4/8/PP2PPPP/RNBQKBNR w KQkq -":             {ECO: "D08", Name: "Queen's Gambit Declined: Albin Countergambit", Moves: "d4 d5 c4 e5"},
+	"rnbqk1nr/ppp2ppp/8/4P3/1bP5/4p3/PP1B1PPP/RN1QKBNR w KQkq -":           {ECO: "D08", Name: "Queen's Gambit Declined: Albin Countergambit, Lasker Trap", Moves: "d4 d5 c4 e5 dxe5 d4 e3 Bb4+ Bd2 dxe3"},
+	"rnbqkbnr/ppp2ppp/8/4P3/2Pp4/5N2/PP2PPPP/RNBQKB1R b KQkq -":            {ECO: "D08", Name: "Queen's Gambit Declined: Albin Countergambit, Normal Line", Moves: "d4 d5 c4 e5 dxe5 d4 Nf3"},
+	"r1bqkbnr/ppp2ppp/2n5/4P3/2Pp4/5NP1/PP2PP1P/RNBQKB1R b KQkq -":         {ECO: "D09", Name: "Queen's Gambit Declined: Albin Countergambit, Fianchetto Variation", Moves: "d4 d5 c4 e5 dxe5 d4 Nf3 Nc6 g3"},
+	"r3kbnr/pppq1ppp/2n5/4Pb2/2Pp4/5NP1/PP1NPPBP/R1BQK2R w KQkq -":         {ECO: "D09", Name: "Queen's Gambit Declined: Albin Countergambit, Fianchetto Variation, Bf5 Line", Moves: "d4 d5 c4 e5 dxe5 d4 Nf3 Nc6 g3 Be6 Nbd2 Qd7 Bg2 Bf5"},
+	"rnbqkbnr/pp2pppp/2p5/3p4/2PP4/8/PP2PPPP/RNBQKBNR w KQkq -":            {ECO: "D10", Name: "Slav Defense", Moves: "d4 d5 c4 c6"},
+	"rnbqkbnr/pp2pppp/8/3p4/3P4/8/PP2PPPP/RNBQKBNR w KQkq -":               {ECO: "D10", Name: "Slav Defense: Exchange Variation", Moves: "d4 d5 c4 c6 cxd5 cxd5"},
+	"rnbqkbnr/pp3ppp/2p5/3pp3/2PP4/2N5/PP2PPPP/R1BQKBNR w KQkq -":          {ECO: "D10", Name: "Slav Defense: Winawer Countergambit", Moves: "d4 d5 c4 c6 Nc3 e5"},
+	"rnbqkbnr/pp2pppp/2p5/3p4/2PP4/5N2/PP2PPPP/RNBQKB1R b KQkq -":          {ECO: "D11", Name: "Slav Defense: Modern Line", Moves: "d4 d5 c4 c6 Nf3"},
+	"rnbqkb1r/pp2pppp/2p2n2/3p4/2PP4/4PN2/PP3PPP/RNBQKB1R b KQkq -":        {ECO: "D11", Name: "Slav Defense: Quiet Variation", Moves: "d4 d5 c4 c6 Nf3 Nf6 e3"},
+	"rnbqkb1r/pp2pppp/2p2n2/3p4/2PP4/5N2/PP1NPPPP/R1BQKB1R b KQkq -":       {ECO: "D11", Name: "Slav Defense: Breyer Variation", Moves: "d4 d5 c4 c6 Nf3 Nf6 Nbd2"},
+	"rnbqkb1r/pp2pppp/2p2n2/3p4/2PP4/5N2/PP2PPPP/RNBQKB1R w KQkq -":        {ECO: "D11", Name: "Slav Defense: Modern Line, 3...Nf6", Moves: "d4 d5 c4 c6 Nf3 Nf6"},
+	"rn1qkb1r/pp2pppp/2p2n2/3p1b2/2PP4/4PN2/PP3PPP/RNBQKB1R w KQkq -":      {ECO: "D12", Name: "Slav Defense: Quiet Variation, Schallopp Defense", Moves: "d4 d5 c4 c6 Nf3 Nf6 e3 Bf5"},
+	"r2qk2r/pp1n1pp1/2p1pnp1/3p4/1bPP4/2NBP3/PPQ2PPP/R1B1K2R w KQkq -":     {ECO: "D12", Name: "Slav Defense: Quiet Variation, Amsterdam Variation", Moves: "d4 d5 c4 c6 Nf3 Nf6 e3 Bf5 Nc3 e6 Nh4 Bg6 Nxg6 hxg6 Bd3 Nbd7 Qc2 Bb4"},
+	"rnbqkb1r/pp2pppp/5n2/3p4/3P4/5N2/PP2PPPP/RNBQKB1R w KQkq -":           {ECO: "D13", Name: "Slav Defense: Exchange Variation, 4.cxd5", Moves: "d4 d5 c4 c6 Nf3 Nf6 cxd5 cxd5"},
+	"r1bqkb1r/pp2pppp/2n2n2/3p4/3P4/2N2N2/PP2PPPP/R1BQKB1R w KQkq -":       {ECO: "D13", Name: "Slav Defense: Exchange Variation, Main Line", Moves: "d4 d5 c4 c6 Nf3 Nf6 cxd5 cxd5 Nc3 Nc6"},
+	"r2qkb1r/pp2pppp/2n2n2/3p1b2/3P1B2/2N2N2/PP2PPPP/R2QKB1R w KQkq -":     {ECO: "D14", Name: "Slav Defense: Exchange Variation, Symmetrical Line", Moves: "d4 d5 c4 c6 Nf3 Nf6 cxd5 cxd5 Nc3 Nc6 Bf4 Bf5"},
+	"r2qk2r/pp3ppp/2n1pn2/3p1b2/1b1P1B2/1QN1PN2/PP3PPP/R3KB1R w KQkq -":    {ECO: "D14", Name: "Slav Defense: Exchange Variation, Trifunović Variation", Moves: "d4 d5 c4 c6 Nf3 Nf6 cxd5 cxd5 Nc3 Nc6 Bf4 Bf5 e3 e6 Qb3 Bb4"},
+	"rnbqkb1r/pp2pppp/2p2n2/3p4/2PP4/2N2N2/PP2PPPP/R1BQKB1R b KQkq -":      {ECO: "D15", Name: "Slav Defense: Three Knights Variation", Moves: "d4 d5 c4 c6 Nf3 Nf6 Nc3"},
+	"rnbqkb1r/1p2pppp/p1p2n2/3p4/2PP4/2N2N2/PP2PPPP/R1BQKB1R w KQkq -":     {ECO: "D15", Name: "Slav Defense: Chameleon Variation", Moves: "d4 d5 c4 c6 Nf3 Nf6 Nc3 a6"},
+	"rnbqkb1r/pp2pppp/2p2n2/8/2pPP3/2N2N2/PP3PPP/R1BQKB1R b KQkq -":        {ECO: "D15", Name: "Slav Defense: Geller Gambit", Moves: "d4 d5 c4 c6 Nf3 Nf6 Nc3 dxc4 e4"},
+	"rnbqkb1r/pp2pp1p/2p2np1/3p4/2PP4/2N2N2/PP2PPPP/R1BQKB1R w KQkq -":     {ECO: "D15", Name: "Slav Defense: Schlechter Variation", Moves: "d4 d5 c4 c6 Nf3 Nf6 Nc3 g6"},
+	"rnbqkb1r/pp2pppp/2p2n2/8/2pP4/2N2N2/PP2PPPP/R1BQKB1R w KQkq -":        {ECO: "D15", Name: "Slav Defense: Two Knights Attack", Moves: "d4 d5 c4 c6 Nf3 Nf6 Nc3 dxc4"},
+	"rnbqkb1r/pp2pppp/2p2n2/8/P1pP4/2N2N2/1P2PPPP/R1BQKB1R b KQkq -":       {ECO: "D16", Name: "Slav Defense: Alapin Variation", Moves: "d4 d5 c4 c6 Nf3 Nf6 Nc3 dxc4 a4"},
+	"r1bqkb1r/pp2pppp/n1p2n2/8/P1pP4/2N2N2/1P2PPPP/R1BQKB1R w KQkq -":      {ECO: "D16", Name: "Slav Defense: Smyslov Variation", Moves: "d4 d5 c4 c6 Nf3 Nf6 Nc3 dxc4 a4 Na6"},
+	"rnbqkb1r/pp3ppp/2p1pn2/8/P1pP4/2N2N2/1P2PPPP/R1BQKB1R w KQkq -":       {ECO: "D16", Name: "Slav Defense: Soultanbeieff Variation", Moves: "d4 d5 c4 c6 Nf3 Nf6 Nc3 dxc4 a4 e6"},
+	"rn1qkb1r/pp2pppp/2p2n2/5b2/P1pP4/2N2N2/1P2PPPP/R1BQKB1R w KQkq -":     {ECO: "D17", Name: "Slav Defense: Czech Variation", Moves: "d4 d5 c4 c6 Nf3 Nf6 Nc3 dxc4 a4 Bf5"},
+	"rn1qkb1r/pp2pppp/2p2n2/4Nb2/P1pP4/2N5/1P2PPPP/R1BQKB1R b KQkq -":      {ECO: "D17", Name: "Slav Defense: Czech Variation, Wiesbaden Variation", Moves: "d4 d5 c4 c6 Nf3 Nf6 Nc3 dxc4 a4 Bf5 Ne5"},
+	"r3kb1r/ppqn1ppp/2p2n2/4pb2/P1NP4/2N3P1/1P2PP1P/R1BQKB1R w KQkq -":     {ECO: "D17", Name: "Slav Defense: Czech Variation, Carlsbad Variation", Moves: "d4 d5 c4 c6 Nf3 Nf6 Nc3 dxc4 a4 Bf5 Ne5 Nbd7 Nxc4 Qc7 g3 e5"},
+	"rn1qkb1r/pp2pppp/2p2n2/5b2/P1pP4/2N1PN2/1P3PPP/R1BQKB1R b KQkq -":     {ECO: "D18", Name: "Slav Defense: Czech Variation, Classical System", Moves: "d4 d5 c4 c6 Nf3 Nf6 Nc3 dxc4 a4 Bf5 e3"},
+	"r2qkb1r/pp2pppp/n1p2n2/5b2/P1pP4/2N1PN2/1P3PPP/R1BQKB1R w KQkq -":     {ECO: "D18", Name: "Slav Defense: Czech Variation, Lasker Variation", Moves: "d4 d5 c4 c6 Nf3 Nf6 Nc3 dxc4 a4 Bf5 e3 Na6"},
+	"rn1q1rk1/pp3ppp/2p1pn2/5b2/PbBP4/2N1PN2/1P2QPPP/R1B2RK1 b - -":        {ECO: "D19", Name: "Slav Defense: Czech Variation, Main Line", Moves: "d4 d5 c4 c6 Nf3 Nf6 Nc3 dxc4 a4 Bf5 e3 e6 Bxc4 Bb4 O-O O-O Qe2"},
+	"rn1qk2r/pp3ppp/2p1pn2/5b2/PbBP4/2N1PN2/1P3PPP/R1BQ1RK1 b kq -":        {ECO: "D19", Name: "Slav Defense: Czech Variation, Dutch Variation", Moves: "d4 d5 c4 c6 Nf3 Nf6 Nc3 dxc4 a4 Bf5 e3 e6 Bxc4 Bb4 O-O"},
+	"rnbqkbnr/ppp1pppp/8/8/2pP4/8/PP2PPPP/RNBQKBNR w KQkq -":               {ECO: "D20", Name: "Queen's Gambit Accepted", Moves: "d4 d5 c4 dxc4"},
+	"rnbqkbnr/ppp1pppp/8/8/2pPP3/8/PP3PPP/RNBQKBNR b KQkq -":               {ECO: "D20", Name: "Queen's Gambit Accepted: Central Variation", Moves: "d4 d5 c4 dxc4 e4"},
+	"rnbqkbnr/ppp1pppp/8/8/2pP4/4P3/PP3PPP/RNBQKBNR b KQkq -":              {ECO: "D20", Name: "Queen's Gambit Accepted: Old Variation", Moves: "d4 d5 c4 dxc4 e3"},
+	"rnbqkb1r/p3pppp/5n2/1ppP4/2p1P3/2N5/PP3PPP/R1BQKBNR w KQkq -":         {ECO: "D20", Name: "Queen's Gambit Accepted: Linares Variation", Moves: "d4 d5 c4 dxc4 e4 c5 d5 Nf6 Nc3 b5"},
+	"rnbqkb1r/ppp1pppp/1n6/4P3/2BP4/8/PP3PPP/RNBQK1NR w KQkq -":            {ECO: "D20", Name: "Queen's Gambit Accepted: Saduleto Variation", Moves: "d4 d5 c4 dxc4 e4 Nf6 e5 Nd5 Bxc4 Nb6"},
+	"rnbqkbnr/ppp1pppp/8/8/2pP4/5N2/PP2PPPP/RNBQKB1R b KQkq -":             {ECO: "D21", Name: "Queen's Gambit Accepted: Normal Variation", Moves: "d4 d5 c4 dxc4 Nf3"},
+	"rnbqkbnr/pp2pppp/8/2p5/2pP4/5N2/PP2PPPP/RNBQKB1R w KQkq -":            {ECO: "D21", Name: "Queen's Gambit Accepted: Gunsberg Defense", Moves: "d4 d5 c4 dxc4 Nf3 c5"},
+	"rnbqkbnr/1pp1pppp/p7/8/2pPP3/5N2/PP3PPP/RNBQKB1R b KQkq -":            {ECO: "D21", Name: "Queen's Gambit Accepted: Alekhine Defense, Borisenko-Furman Variation", Moves: "d4 d5 c4 dxc4 Nf3 a6 e4"},
+	"rnbqkbnr/1pp1pppp/p7/8/2pP4/5N2/PP2PPPP/RNBQKB1R w KQkq -":            {ECO: "D22", Name: "Queen's Gambit Accepted: Alekhine Defense", Moves: "d4 d5 c4 dxc4 Nf3 a6"},
+	"rnbqkbnr/2p1pppp/p7/1p6/2pP4/4PN2/PP3PPP/RNBQKB1R w KQkq -":           {ECO: "D22", Name: "Queen's Gambit Accepted: Haberditz Variation", Moves: "d4 d5 c4 dxc4 Nf3 a6 e3 b5"},
+	"rn1qkbnr/1pp2ppp/p3p3/3P4/2B3b1/4PN2/PP3PPP/RNBQK2R b KQkq -":         {ECO: "D22", Name: "Queen's Gambit Accepted: Alekhine Defense, Alatortsev Variation", Moves: "d4 d5 c4 dxc4 Nf3 a6 e3 Bg4 Bxc4 e6 d5"},
+	"rnbqkb1r/ppp1pppp/5n2/8/2pP4/5N2/PP2PPPP/RNBQKB1R w KQkq -":           {ECO: "D23", Name: "Queen's Gambit Accepted: 3.Nf3 Nf6", Moves: "d4 d5 c4 dxc4 Nf3 Nf6"},
+	"rnbqkb1r/ppp1pppp/5n2/8/Q1pP4/5N2/PP2PPPP/RNB1KB1R b KQkq -":          {ECO: "D23", Name: "Queen's Gambit Accepted: Mannheim Variation", Moves: "d4 d5 c4 dxc4 Nf3 Nf6 Qa4+"},
+	"rnbqkb1r/ppp1pppp/5n2/8/2pP4/2N2N2/PP2PPPP/R1BQKB1R b KQkq -":         {ECO: "D24", Name: "Queen's Gambit Accepted: Main Line", Moves: "d4 d5 c4 dxc4 Nf3 Nf6 Nc3"},
+	"rnbqkb1r/1pp1pppp/p4n2/8/2pPP3/2N2N2/PP3PPP/R1BQKB1R b KQkq -":        {ECO: "D24", Name: "Queen's Gambit Accepted: Bogoljubov Defense", Moves: "d4 d5 c4 dxc4 Nf3 Nf6 Nc3 a6 e4"},
+	"rnbqkb1r/pp3ppp/5n2/2ppP3/2p5/2N2N2/PP3PPP/R1BQKB1R b KQkq -":         {ECO: "D24", Name: "Queen's Gambit Accepted: Gunsberg Defense, Wellmuth Gambit", Moves: "d4 d5 c4 dxc4 Nf3 Nf6 Nc3 c5 d5 e6 e4 exd5 e5"},
+	"rnbqkb1r/ppp1pppp/5n2/8/2pP4/4PN2/PP3PPP/RNBQKB1R b KQkq -":           {ECO: "D25", Name: "Queen's Gambit Accepted: Normal Variation", Moves: "d4 d5 c4 dxc4 Nf3 Nf6 e3"},
+	"rn1qkb1r/ppp1pppp/5n2/8/2pP2b1/4PN2/PP3PPP/RNBQKB1R w KQkq -":         {ECO: "D25", Name: "Queen's Gambit Accepted: Janowski-Larsen Variation", Moves: "d4 d5 c4 dxc4 Nf3 Nf6 e3 Bg4"},
+	"rnbqkb1r/ppp1pp1p/5np1/8/2pP4/4PN2/PP3PPP/RNBQKB1R w KQkq -":          {ECO: "D25", Name: "Queen's Gambit Accepted: Smyslov Variation", Moves: "d4 d5 c4 dxc4 Nf3 Nf6 e3 g6"},
+	"rnbqkb1r/ppp2ppp/4pn2/8/2pP4/4PN2/PP3PPP/RNBQKB1R w KQkq -":           {ECO: "D26", Name: "Queen's Gambit Accepted: Classical Defense", Moves: "d4 d5 c4 dxc4 Nf3 Nf6 e3 e6"},
+	"rnbqkb1r/pp3ppp/4pn2/2p5/2BP4/4PN2/PP3PPP/RNBQK2R w KQkq -":           {ECO: "D26", Name: "Queen's Gambit Accepted: Classical Defense, Normal Line", Moves: "d4 d5 c4 dxc4 Nf3 Nf6 e3 e6 Bxc4 c5"},
+	"rnbqkb1r/pp3ppp/4pn2/8/2Bp4/4PN2/PP3PPP/RNBQ1RK1 w kq -":              {ECO: "D26", Name: "Queen's Gambit Accepted: Classical Defense, Steinitz Variation", Moves: "d4 d5 c4 dxc4 Nf3 Nf6 e3 e6 Bxc4 c5 O-O cxd4"},
+	"rnbqkb1r/1p3ppp/p3pn2/2p5/2BP4/4PN2/PP3PPP/RNBQ1RK1 w kq -":           {ECO: "D27", Name: "Queen's Gambit Accepted: Classical Defense, Main Line", Moves: "d4 d5 c4 dxc4 Nf3 Nf6 e3 e6 Bxc4 c5 O-O a6"},
+	"rnbqkb1r/1p3ppp/p3pn2/2p5/P1BP4/4PN2/1P3PPP/RNBQ1RK1 b kq -":          {ECO: "D27", Name: "Queen's Gambit Accepted: Classical Defense, Rubinstein Variation", Moves: "d4 d5 c4 dxc4 Nf3 Nf6 e3 e6 Bxc4 c5 O-O a6 a4"},
+	"rnbqkb1r/1p3ppp/p3pn2/2p5/2BPP3/5N2/PP3PPP/RNBQ1RK1 b kq -":           {ECO: "D27", Name: "Queen's Gambit Accepted: Classical Defense, Russian Gambit", Moves: "d4 d5 c4 dxc4 Nf3 Nf6 e3 e6 Bxc4 c5 O-O a6 e4"},
+	"rnbqkb1r/1p3ppp/p3pn2/2p5/2BP4/4PN2/PP2QPPP/RNB2RK1 b kq -":           {ECO: "D28", Name: "Queen's Gambit Accepted: Classical Defense, Alekhine System", Moves: "d4 d5 c4 dxc4 Nf3 Nf6 e3 e6 Bxc4 c5 O-O a6 Qe2"},
+	"rn1qkb1r/1b3ppp/p3pn2/1pp5/3P4/1B2PN2/PP2QPPP/RNB2RK1 w kq -":         {ECO: "D29", Name: "Queen's Gambit Accepted: Classical Defense, Alekhine System, Main Line", Moves: "d4 d5 c4 dxc4 Nf3 Nf6 e3 e6 Bxc4 c5 O-O a6 Qe2 b5 Bb3 Bb7"},
+	"r2qk2r/1b1n1ppp/p2bpn2/1pp5/3P4/1BN1PN2/PP2QPPP/R1BR2K1 w kq -":       {ECO: "D29", Name: "Queen's Gambit Accepted: Classical Defense, Alekhine System, Smyslov Variation", Moves: "d4 d5 c4 dxc4 Nf3 Nf6 e3 e6 Bxc4 c5 O-O a6 Qe2 b5 Bb3 Bb7 Rd1 Nbd7 Nc3 Bd6"},
+	"rnbqkbnr/ppp2ppp/4p3/3p4/2PP4/8/PP2PPPP/RNBQKBNR w KQkq -":            {ECO: "D30", Name: "Queen's Gambit Declined", Moves: "d4 d5 c4 e6"},
+	"rnbqkb1r/ppp2ppp/4pn2/3p4/2PP4/5N2/PP2PPPP/RNBQKB1R w KQkq -":         {ECO: "D30", Name: "Queen's Gambit Declined: Normal Defense", Moves: "d4 d5 c4 e6 Nf3 Nf6"},
+	"r1bqkb1r/pp1n1ppp/2p1pn2/3p2B1/2PP4/4PN2/PP1N1PPP/R2QKB1R b KQkq -":   {ECO: "D30", Name: "Queen's Gambit Declined: Capablanca Variation", Moves: "d4 d5 c4 e6 Nf3 Nf6 Bg5 Nbd7 e3 c6 Nbd2"},
+	"rnbqk2r/ppp2ppp/4pn2/3p2B1/1bPP4/5N2/PP2PPPP/RN1QKB1R w KQkq -":       {ECO: "D30", Name: "Queen's Gambit Declined: Vienna Variation", Moves: "d4 d5 c4 e6 Nf3 Nf6 Bg5 Bb4+"},
+	"rnbqkbnr/pp3ppp/4p3/2pp4/2PP4/5N2/PP2PPPP/RNBQKB1R w KQkq -":          {ECO: "D30", Name: "Queen's Gambit Declined: Tarrasch Defense, Dubov Tarrasch", Moves: "d4 d5 c4 e6 Nf3 c5"},
+	"rnbqkbnr/ppp2ppp/4p3/3p4/2PP4/2N5/PP2PPPP/R1BQKBNR b KQkq -":          {ECO: "D31", Name: "Queen's Gambit Declined: Queen's Knight Variation", Moves: "d4 d5 c4 e6 Nc3"},
+	"rnbqkbnr/pp3ppp/2p1p3/3p4/2PP4/2N5/PP2PPPP/R1BQKBNR w KQkq -":         {ECO: "D31", Name: "Semi-Slav Defense: Accelerated Move Order", Moves: "d4 d5 c4 e6 Nc3 c6"},
+	"rnbqk1nr/ppp1bppp/4p3/3p4/2PP4/2N5/PP2PPPP/R1BQKBNR w KQkq -":         {ECO: "D31", Name: "Queen's Gambit Declined: Charousek Variation", Moves: "d4 d5 c4 e6 Nc3 Be7"},
+	"rnbqkbnr/p1p2ppp/1p2p3/3p4/2PP4/2N5/PP2PPPP/R1BQKBNR w KQkq -":        {ECO: "D31", Name: "Queen's Gambit Declined: Alapin Variation", Moves: "d4 d5 c4 e6 Nc3 b6"},
+	"rnbqkbnr/pp3ppp/2p1p3/3p4/2PPP3/2N5/PP3PPP/R1BQKBNR b KQkq -":         {ECO: "D31", Name: "Semi-Slav Defense: Marshall Gambit", Moves: "d4 d5 c4 e6 Nc3 c6 e4"},
+	"rnbqkbnr/pp3ppp/2p1p3/8/2pP4/2N2N2/PP2PPPP/R1BQKB1R w KQkq -":         {ECO: "D31", Name: "Semi-Slav Defense: Noteboom Variation", Moves: "d4 d5 c4 e6 Nc3 c6 Nf3 dxc4"},
+	"rnbqkbnr/pp3ppp/4p3/2pp4/2PP4/2N5/PP2PPPP/R1BQKBNR w KQkq -":          {ECO: "D32", Name: "Tarrasch Defense", Moves: "d4 d5 c4 e6 Nc3 c5"},
+	"r1bqkbnr/pp3ppp/2n5/2pp4/3P4/2N2N2/PP2PPPP/R1BQKB1R w KQkq -":         {ECO: "D32", Name: "Tarrasch Defense: Two Knights Variation", Moves: "d4 d5 c4 e6 Nc3 c5 cxd5 exd5 Nf3 Nc6"},
+	"rnbqkbnr/pp3ppp/4p3/3P4/3p4/2N5/PP2PPPP/R1BQKBNR w KQkq -":            {ECO: "D32", Name: "Tarrasch Defense: Schara Gambit", Moves: "d4 d5 c4 e6 Nc3 c5 cxd5 cxd4"},
+	"rn1qkbnr/pp1b1ppp/8/3p4/3Q4/2N5/PP2PPPP/R1B1KBNR w KQkq -":            {ECO: "D32", Name: "Tarrasch Defense: Von Hennig Gambit", Moves: "d4 d5 c4 e6 Nc3 c5 cxd5 cxd4 Qa4+ Bd7 Qxd4 exd5"},
+	"r1bqkbnr/pp3ppp/2n5/2pp4/3P4/2N2NP1/PP2PP1P/R1BQKB1R b KQkq -":        {ECO: "D33", Name: "Tarrasch Defense: Rubinstein System", Moves: "d4 d5 c4 e6 Nc3 c5 cxd5 exd5 Nf3 Nc6 g3"},
+	"r1bqkbnr/pp3ppp/2n5/3p4/2pP4/2N2NP1/PP2PP1P/R1BQKB1R w KQkq -":        {ECO: "D33", Name: "Tarrasch Defense: Swedish Variation", Moves: "d4 d5 c4 e6 Nc3 c5 cxd5 exd5 Nf3 Nc6 g3 c4"},
+	"r2qkb1r/pp3ppp/2n2n2/2pp4/3P2b1/2N2NP1/PP2PPBP/R1BQK2R w KQkq -":      {ECO: "D33", Name: "Tarrasch Defense: Wagner Variation", Moves: "d4 d5 c4 e6 Nc3 c5 cxd5 exd5 Nf3 Nc6 g3 Nf6 Bg2 Bg4"},
+	"r1bqk2r/pp2bppp/2n2n2/2pp4/3P4/2N2NP1/PP2PPBP/R1BQK2R w KQkq -":       {ECO: "D34", Name: "Tarrasch Defense: Classical Variation", Moves: "d4 d5 c4 e6 Nc3 c5 cxd5 exd5 Nf3 Nc6 g3 Nf6 Bg2 Be7"},
+	"r1bq1rk1/pp2bppp/2n2n2/2pp2B1/3P4/2N2NP1/PP2PPBP/R2Q1RK1 b - -":       {ECO: "D34", Name: "Tarrasch Defense: Prague Variation, Main Line", Moves: "d4 d5 c4 e6 Nc3 c5 cxd5 exd5 Nf3 Nc6 g3 Nf6 Bg2 Be7 O-O O-O Bg5"},
+	"r2q1rk1/p3bppp/1pn1bn2/2pp2B1/3P4/2N2NP1/PP2PPBP/2RQ1RK1 w - -":       {ECO: "D34", Name: "Tarrasch Defense: Prague Variation, Bogoljubov Variation", Moves: "d4 d5 c4 e6 Nc3 c5 cxd5 exd5 Nf3 Nc6 g3 Nf6 Bg2 Be7 O-O O-O Bg5 Be6 Rc1 b6"},
+	"rnbqkb1r/ppp2ppp/4pn2/3p4/2PP4/2N5/PP2PPPP/R1BQKBNR w KQkq -":         {ECO: "D35", Name: "Queen's Gambit Declined: Normal Defense", Moves: "d4 d5 c4 e6 Nc3 Nf6"},
+	"rnbqkb1r/ppp2ppp/5n2/3p4/3P4/2N5/PP2PPPP/R1BQKBNR w KQkq -":           {ECO: "D35", Name: "Queen's Gambit Declined: Exchange Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 cxd5 exd5"},
+	"rnbqkb1r/ppp2ppp/5n2/3p2B1/3P4/2N5/PP2PPPP/R2QKBNR b KQkq -":          {ECO: "D35", Name: "Queen's Gambit Declined: Exchange Variation, Positional Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 cxd5 exd5 Bg5"},
+	"rnbqkb1r/ppp2ppp/4pn2/3p4/2PP1B2/2N5/PP2PPPP/R2QKBNR b KQkq -":        {ECO: "D35", Name: "Queen's Gambit Declined: Harrwitz Attack, Main Line", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bf4"},
+	"rnbqkb1r/pp3ppp/2p2n2/3p2B1/3P4/2N5/PPQ1PPPP/R3KBNR b KQkq -":         {ECO: "D36", Name: "Queen's Gambit Declined: Exchange Variation, Reshevsky Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 cxd5 exd5 Bg5 c6 Qc2"},
+	"r1bqr1k1/pp1nbppp/2p2n2/3p2B1/3P4/2NBP3/PPQ1NPPP/R3K2R w KQ -":        {ECO: "D36", Name: "Queen's Gambit Declined: Exchange Variation, Main Line", Moves: "d4 d5 c4 e6 Nc3 Nf6 cxd5 exd5 Bg5 c6 Qc2 Be7 e3 Nbd7 Bd3 O-O Nge2 Re8"},
+	"rnbqkb1r/ppp2ppp/4pn2/3p4/2PP4/2N2N2/PP2PPPP/R1BQKB1R b KQkq -":       {ECO: "D37", Name: "Queen's Gambit Declined: Three Knights Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Nf3"},
+	"rnbqk2r/ppp1bppp/4pn2/3p4/2PP1B2/2N2N2/PP2PPPP/R2QKB1R b KQkq -":      {ECO: "D37", Name: "Queen's Gambit Declined: Harrwitz Attack", Moves: "d4 d5 c4 e6 Nc3 Nf6 Nf3 Be7 Bf4"},
+	"rnbq1rk1/p1p1bppp/1p2pn2/3p4/2PP1B2/2N1PN2/PP3PPP/R2QKB1R w KQ -":     {ECO: "D37", Name: "Queen's Gambit Declined: Harrwitz Attack, Fianchetto Defense", Moves: "d4 d5 c4 e6 Nc3 Nf6 Nf3 Be7 Bf4 O-O e3 b6"},
+	"rnbq1rk1/pp3ppp/4pn2/2bp4/2P2B2/2N1PN2/PP3PPP/R2QKB1R w KQ -":         {ECO: "D37", Name: "Queen's Gambit Declined: Harrwitz Attack, Main Line", Moves: "d4 d5 c4 e6 Nc3 Nf6 Nf3 Be7 Bf4 O-O e3 c5 dxc5 Bxc5"},
+	"rnbqk2r/ppp2ppp/4pn2/3p4/1bPP4/2N2N2/PP2PPPP/R1BQKB1R w KQkq -":       {ECO: "D38", Name: "Queen's Gambit Declined: Ragozin Defense", Moves: "d4 d5 c4 e6 Nc3 Nf6 Nf3 Bb4"},
+	"r1bqk2r/pppn1ppp/4pn2/3p2B1/1bPP4/2N2N2/PP2PPPP/R2QKB1R w KQkq -":     {ECO: "D38", Name: "Queen's Gambit Declined: Ragozin Defense, Westphalian Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Nf3 Bb4 Bg5 Nbd7"},
+	"rnbqk2r/ppp2ppp/4pn2/3p4/QbPP4/2N2N2/PP2PPPP/R1B1KB1R b KQkq -":       {ECO: "D38", Name: "Queen's Gambit Declined: Ragozin Defense, Alekhine Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Nf3 Bb4 Qa4+"},
+	"rnbqk2r/ppp2ppp/4pn2/6B1/1bpP4/2N2N2/PP2PPPP/R2QKB1R w KQkq -":        {ECO: "D39", Name: "Queen's Gambit Declined: Ragozin Defense, Vienna Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Nf3 Bb4 Bg5 dxc4"},
+	"rnbqk2r/pp3ppp/4pn2/2p3B1/1bBPP3/2N2N2/PP3PPP/R2QK2R b KQkq -":        {ECO: "D39", Name: "Queen's Gambit Declined: Vienna Variation, Main Line", Moves: "d4 d5 c4 e6 Nc3 Nf6 Nf3 Bb4 Bg5 dxc4 e4 c5 Bxc4"},
+	"rnbqkb1r/pp3ppp/4pn2/2pp2B1/2PP4/2N2N2/PP2PPPP/R2QKB1R b KQkq -":      {ECO: "D40", Name: "Queen's Gambit Declined: Semi-Tarrasch Defense, Pillsbury Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Nf3 c5 Bg5"},
+	"r1b2rk1/pp2qppp/2n1pn2/2bp4/2P5/2NBPN2/PP2QPPP/R1B2RK1 w - -":         {ECO: "D40", Name: "Queen's Gambit Declined: Semi-Tarrasch Defense, Symmetrical Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Nf3 c5 e3 Nc6 Bd3 Bd6 O-O O-O Qe2 Qe7 dxc5 Bxc5"},
+	"rnbqkb1r/pp3ppp/4pn2/2pp4/2PP4/2N1PN2/PP3PPP/R1BQKB1R b KQkq -":       {ECO: "D40", Name: "Queen's Gambit Declined: Semi-Tarrasch Defense, 5.e3", Moves: "d4 d5 c4 e6 Nc3 Nf6 Nf3 c5 e3"},
+	"rnbqkb1r/pp3ppp/4pn2/2pp4/2PP4/2N2N2/PP2PPPP/R1BQKB1R w KQkq -":       {ECO: "D41", Name: "Queen's Gambit Declined: Semi-Tarrasch Defense", Moves: "d4 d5 c4 e6 Nc3 Nf6 Nf3 c5"},
+	"rnbqkb1r/pp3ppp/4p3/2pn4/3PP3/2N2N2/PP3PPP/R1BQKB1R b KQkq -":         {ECO: "D41", Name: "Queen's Gambit Declined: Semi-Tarrasch Defense, Exchange Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Nf3 c5 cxd5 Nxd5 e4"},
+	"rnb1k2r/pp3ppp/4p3/q7/1b1PP3/5N2/P2B1PPP/R2QKB1R w KQkq -":            {ECO: "D41", Name: "Queen's Gambit Declined: Semi-Tarrasch Defense, San Sebastian Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Nf3 c5 cxd5 Nxd5 e4 Nxc3 bxc3 cxd4 cxd4 Bb4+ Bd2 Qa5"},
+	"rnbq1rk1/pp3ppp/4p3/1B6/3PP3/5N2/P2Q1PPP/R3K2R b KQ -":                {ECO: "D41", Name: "Queen's Gambit Declined: Semi-Tarrasch Defense, Kmoch Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Nf3 c5 cxd5 Nxd5 e4 Nxc3 bxc3 cxd4 cxd4 Bb4+ Bd2 Bxd2+ Qxd2 O-O Bb5"},
+	"r1bqkb1r/pp3ppp/2n1p3/2pn4/3P4/2NBPN2/PP3PPP/R1BQK2R b KQkq -":        {ECO: "D42", Name: "Queen's Gambit Declined: Semi-Tarrasch Defense, Main Line", Moves: "d4 d5 c4 e6 Nc3 Nf6 Nf3 c5 cxd5 Nxd5 e3 Nc6 Bd3"},
+	"rnbqkb1r/pp3ppp/2p1pn2/3p4/2PP4/2N2N2/PP2PPPP/R1BQKB1R w KQkq -":      {ECO: "D43", Name: "Semi-Slav Defense", Moves: "d4 d5 c4 e6 Nc3 Nf6 Nf3 c6"},
+	"rnbqkb1r/pp3pp1/2p1pn1p/3p2B1/2PP4/2N2N2/PP2PPPP/R2QKB1R w KQkq -":    {ECO: "D43", Name: "Semi-Slav Defense: Moscow Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Nf3 c6 Bg5 h6"},
+	"rnb1kb1r/pp3pp1/2p1pq1p/3p4/2PP4/1QN2N2/PP2PPPP/R3KB1R b KQkq -":      {ECO: "D43", Name: "Semi-Slav Defense: Hastings Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Nf3 c6 Bg5 h6 Bxf6 Qxf6 Qb3"},
+	"rnbqkb1r/pp3pp1/2p1pn1p/3p4/2PP3B/2N2N2/PP2PPPP/R2QKB1R b KQkq -":     {ECO: "D43", Name: "Semi-Slav Defense: Anti-Moscow Gambit", Moves: "d4 d5 c4 e6 Nc3 Nf6 Nf3 c6 Bg5 h6 Bh4"},
+	"rnbqkb1r/pp3ppp/2p1pn2/6B1/2pP4/2N2N2/PP2PPPP/R2QKB1R w KQkq -":       {ECO: "D44", Name: "Semi-Slav Defense: Botvinnik Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Nf3 c6 Bg5 dxc4"},
+	"r1bqkb1r/p2n1p2/2p1pn2/1p2P1B1/2pP4/2N5/PP3PPP/R2QKB1R w KQkq -":      {ECO: "D44", Name: "Semi-Slav Defense: Botvinnik System", Moves: "d4 d5 c4 e6 Nc3 Nf6 Nf3 c6 Bg5 dxc4 e4 b5 e5 h6 Bh4 g5 Nxg5 hxg5 Bxg5 Nbd7"},
+	"2kr1b1r/pb1n1p2/5P2/1qpp2B1/Npp5/P5P1/1P3PBP/R2Q1RK1 w - -":           {ECO: "D44", Name: "Semi-Slav Defense: Botvinnik System, Lilienthal Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Nf3 c6 Bg5 dxc4 e4 b5 e5 h6 Bh4 g5 Nxg5 hxg5 Bxg5 Nbd7 exf6 Bb7 g3 c5 d5 Qb6 Bg2 O-O-O O-O b4 Na4 Qb5 a3 exd5"},
+	"rnbqkb1r/pp3ppp/2p1pn2/3p4/2PP4/2N1PN2/PP3PPP/R1BQKB1R b KQkq -":      {ECO: "D45", Name: "Semi-Slav Defense: Normal Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Nf3 c6 e3"},
+	"r1bqkb1r/pp1n1ppp/2p1pn2/3p4/2PP4/2N1PN2/PPQ2PPP/R1B1KB1R b KQkq -":   {ECO: "D45", Name: "Semi-Slav Defense: Stoltz Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Nf3 c6 e3 Nbd7 Qc2"},
+	"rnbqkb1r/1p3ppp/p1p1pn2/3p4/2PP4/2N1PN2/PP3PPP/R1BQKB1R w KQkq -":     {ECO: "D45", Name: "Semi-Slav Defense: Accelerated Meran Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Nf3 c6 e3 a6"},
+	"r1bqkb1r/pp1n1ppp/2p1pn2/3p4/2PP4/2N1PN2/PP3PPP/R1BQKB1R w KQkq -":    {ECO: "D45", Name: "Semi-Slav Defense: Normal Variation, 5...Nbd7", Moves: "d4 d5 c4 e6 Nc3 Nf6 Nf3 c6 e3 Nbd7"},
+	"r1bqkb1r/pp1n1ppp/2p1pn2/3pN3/2PP4/2N1P3/PP3PPP/R1BQKB1R b KQkq -":    {ECO: "D45", Name: "Semi-Slav Defense: Rubinstein System", Moves: "d4 d5 c4 e6 Nc3 Nf6 Nf3 c6 e3 Nbd7 Ne5"},
+	"r1bqkb1r/pp1n1ppp/2p1pn2/3p4/2PP4/2NBPN2/PP3PPP/R1BQK2R b KQkq -":     {ECO: "D46", Name: "Semi-Slav Defense: Main Line", Moves: "d4 d5 c4 e6 Nc3 Nf6 Nf3 c6 e3 Nbd7 Bd3"},
+	"r1bqk2r/pp1n1ppp/2pbpn2/3p4/2PP4/2NBPN2/PP3PPP/R1BQK2R w KQkq -":      {ECO: "D46", Name: "Semi-Slav Defense: Chigorin Defense", Moves: "d4 d5 c4 e6 Nc3 Nf6 Nf3 c6 e3 Nbd7 Bd3 Bd6"},
+	"r1bqk2r/pp1n1ppp/2p1pn2/3p4/1bPP4/2NBPN2/PP3PPP/R1BQK2R w KQkq -":     {ECO: "D46", Name: "Semi-Slav Defense: Romih Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Nf3 c6 e3 Nbd7 Bd3 Bb4"},
+	"r1bqkb1r/p2n1ppp/2p1pn2/1p6/2BP4/2N1PN2/PP3PPP/R1BQK2R w KQkq -":      {ECO: "D47", Name: "Semi-Slav Defense: Meran Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Nf3 c6 e3 Nbd7 Bd3 dxc4 Bxc4 b5"},
+	"r2qkb1r/pb1n1ppp/2p1pn2/1p6/3P4/2NBPN2/PP3PPP/R1BQK2R w KQkq -":       {ECO: "D47", Name: "Semi-Slav Defense: Meran Variation, Wade Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Nf3 c6 e3 Nbd7 Bd3 dxc4 Bxc4 b5 Bd3 Bb7"},
+	"r1bqkb1r/p2n1ppp/2p1pn2/8/1p1P4/2NBPN2/PP3PPP/R1BQK2R w KQkq -":       {ECO: "D47", Name: "Semi-Slav Defense: Meran Variation, Lundin Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Nf3 c6 e3 Nbd7 Bd3 dxc4 Bxc4 b5 Bd3 b4"},
+	"r1bqkb1r/p2n1ppp/2p1pn2/1p6/3P4/2NBPN2/PP3PPP/R1BQK2R b KQkq -":       {ECO: "D47", Name: "Semi-Slav Defense: Meran Variation, 7...b5", Moves: "d4 d5 c4 e6 Nc3 Nf6 Nf3 c6 e3 Nbd7 Bd3 dxc4 Bxc4 b5 Bd3"},
+	"r1bqkb1r/3n1ppp/p1p1pn2/1p6/3P4/2NBPN2/PP3PPP/R1BQK2R w KQkq -":       {ECO: "D48", Name: "Semi-Slav Defense: Meran Variation, 8...a6", Moves: "d4 d5 c4 e6 Nc3 Nf6 Nf3 c6 e3 Nbd7 Bd3 dxc4 Bxc4 b5 Bd3 a6"},
+	"r1bqkb1r/3n1ppp/p1p1pn2/8/1p1PP3/2NB1N2/PP3PPP/R1BQK2R w KQkq -":      {ECO: "D48", Name: "Semi-Slav Defense: Meran Variation, Pirc Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Nf3 c6 e3 Nbd7 Bd3 dxc4 Bxc4 b5 Bd3 a6 e4 b4"},
+	"r1bqkb1r/3n1ppp/p3pn2/1ppP4/4P3/2NB1N2/PP3PPP/R1BQK2R b KQkq -":       {ECO: "D48", Name: "Semi-Slav Defense: Meran Variation, Reynolds' Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Nf3 c6 e3 Nbd7 Bd3 dxc4 Bxc4 b5 Bd3 a6 e4 c5 d5"},
+	"r1bqkb1r/3n1ppp/p3pn2/1N2P3/3p4/3B1N2/PP3PPP/R1BQK2R b KQkq -":        {ECO: "D49", Name: "Semi-Slav Defense: Meran Variation, Blumenfeld Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Nf3 c6 e3 Nbd7 Bd3 dxc4 Bxc4 b5 Bd3 a6 e4 c5 e5 cxd4 Nxb5"},
+	"r3kb1r/5ppp/b3pn2/1p1qN1B1/3p4/3B4/PP2QPPP/R4RK1 b kq -":              {ECO: "D49", Name: "Semi-Slav Defense: Meran Variation, Rellstab Attack", Moves: "d4 d5 c4 e6 Nc3 Nf6 Nf3 c6 e3 Nbd7 Bd3 dxc4 Bxc4 b5 Bd3 a6 e4 c5 e5 cxd4 Nxb5 Nxe5 Nxe5 axb5 O-O Qd5 Qe2 Ba6 Bg5"},
+	"rnbqkb1r/ppp2ppp/4pn2/3p2B1/2PP4/2N5/PP2PPPP/R2QKBNR b KQkq -":        {ECO: "D50", Name: "Queen's Gambit Declined: Modern Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bg5"},
+	"rnbqkb1r/pp3ppp/4pn2/2pp2B1/2PP4/2N5/PP2PPPP/R2QKBNR w KQkq -":        {ECO: "D50", Name: "Queen's Gambit Declined: Semi-Tarrasch Defense, Pillsbury Variation, 4...c5", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bg5 c5"},
+	"rnbqkb1r/pp3ppp/4pn2/3p2B1/2PQ4/2N2N2/PP2PPPP/R3KB1R b KQkq -":        {ECO: "D50", Name: "Queen's Gambit Declined: Been-Koomen Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bg5 c5 Nf3 cxd4 Qxd4"},
+	"r1b1kb1r/pp1n1ppp/2p1pn2/q2p2B1/2PP4/2N1PN2/PP3PPP/R2QKB1R w KQkq -":  {ECO: "D51", Name: "Queen's Gambit Declined: Cambridge Springs Defense", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bg5 Nbd7 e3 c6 Nf3 Qa5"},
+	"r1bqkb1r/pppn1ppp/4pn2/3p2B1/2PP4/2N5/PP2PPPP/R2QKBNR w KQkq -":       {ECO: "D51", Name: "Queen's Gambit Declined: Modern Variation, 4...Nbd7", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bg5 Nbd7"},
+	"r1b1kb1r/pp1n1ppp/2p1pn2/q2p4/2PP4/2N2N2/PP1BPPPP/2RQKB1R b Kkq -":    {ECO: "D51", Name: "Queen's Gambit Declined: Rochlin Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bg5 Nbd7 Nf3 c6 Rc1 Qa5 Bd2"},
+	"r1bqk2r/pppn1ppp/4pn2/3p2B1/1bPP4/2N1P3/PP3PPP/R2QKBNR w KQkq -":      {ECO: "D51", Name: "Queen's Gambit Declined: Manhattan Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bg5 Nbd7 e3 Bb4"},
+	"r1b2rk1/pp1n1ppp/2p2n2/q2pp1B1/1bPP4/2N1P3/PPQNBPPP/R3K2R w KQ -":     {ECO: "D52", Name: "Queen's Gambit Declined: Cambridge Springs Variation, Bogoljubov Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bg5 Nbd7 e3 c6 Nf3 Qa5 Nd2 Bb4 Qc2 O-O Be2 e5"},
+	"r1b1kb1r/pp1n1ppp/2p1p3/q2n2B1/3P4/2N1PN2/PP3PPP/R2QKB1R w KQkq -":    {ECO: "D52", Name: "Queen's Gambit Declined: Cambridge Springs Variation, Yugoslav Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bg5 Nbd7 e3 c6 Nf3 Qa5 cxd5 Nxd5"},
+	"rnbqk2r/ppp1bppp/4pn2/3p2B1/2PP4/2N5/PP2PPPP/R2QKBNR w KQkq -":        {ECO: "D53", Name: "Queen's Gambit Declined: Modern Variation, Heral Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bg5 Be7"},
+	"rnbqk2r/ppp1bppp/4p3/3p2B1/2PPn3/2N1P3/PP3PPP/R2QKBNR w KQkq -":       {ECO: "D53", Name: "Queen's Gambit Declined: Lasker Defense, Early Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bg5 Be7 e3 Ne4"},
+	"rnbq1rk1/ppp1bppp/4pn2/3p2B1/2PP4/2N1P3/PP3PPP/R2QKBNR w KQ -":        {ECO: "D53", Name: "Queen's Gambit Declined: Modern Variation, Knight Defense", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bg5 Be7 e3 O-O"},
+	"rnbq1rk1/ppp1bppp/4pn2/3p2B1/2PP4/2N1P3/PP3PPP/2RQKBNR b K -":         {ECO: "D54", Name: "Queen's Gambit Declined: Anti-Neo-Orthodox Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bg5 Be7 e3 O-O Rc1"},
+	"rnbq1rk1/ppp1bppp/4pn2/3p2B1/2PP4/2N1PN2/PP3PPP/R2QKB1R b KQ -":       {ECO: "D55", Name: "Queen's Gambit Declined: Modern Variation, Normal Line", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bg5 Be7 e3 O-O Nf3"},
+	"rnbq1rk1/ppp1bpp1/4pB1p/3p4/2PP4/2N1PN2/PP3PPP/R2QKB1R b KQ -":        {ECO: "D55", Name: "Queen's Gambit Declined: Neo-Orthodox Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bg5 Be7 e3 O-O Nf3 h6 Bxf6"},
+	"rn1q1rk1/pbp1bppp/1p3n2/3p2B1/3P4/2NBPN2/PP3PPP/R2QK2R w KQ -":        {ECO: "D55", Name: "Queen's Gambit Declined: Pillsbury Attack", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bg5 Be7 e3 O-O Nf3 b6 Bd3 Bb7 cxd5 exd5"},
+	"rnbq1rk1/ppp1bpp1/4p2p/3p4/2PPn2B/2N1PN2/PP3PPP/R2QKB1R w KQ -":       {ECO: "D56", Name: "Queen's Gambit Declined: Lasker Defense", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bg5 Be7 e3 O-O Nf3 h6 Bh4 Ne4"},
+	"rnb2rk1/ppp1qpp1/4p2p/3p4/2PPn3/2N1PN2/PPQ2PPP/R3KB1R b KQ -":         {ECO: "D56", Name: "Queen's Gambit Declined: Lasker Defense, Teichmann Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bg5 Be7 e3 O-O Nf3 h6 Bh4 Ne4 Bxe7 Qxe7 Qc2"},
+	"r4rk1/pp1bqpp1/2n1pn1p/2p5/2BP4/2N1PN2/PPQ2PPP/R2R2K1 w - -":          {ECO: "D56", Name: "Queen's Gambit Declined: Lasker Defense, Russian Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bg5 Be7 e3 O-O Nf3 h6 Bh4 Ne4 Bxe7 Qxe7 Qc2 Nf6 Bd3 dxc4 Bxc4 c5 O-O Nc6 Rfd1 Bd7"},
+	"rnb2rk1/ppp1qpp1/7p/3p4/3P4/2P1PN2/P4PPP/R2QKB1R w KQ -":              {ECO: "D57", Name: "Queen's Gambit Declined: Lasker Defense, Main Line", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bg5 Be7 e3 O-O Nf3 h6 Bh4 Ne4 Bxe7 Qxe7 cxd5 Nxc3 bxc3 exd5"},
+	"rnb2rk1/ppp2pp1/3q3p/3p4/3P4/1QP1PN2/P4PPP/R3KB1R w KQ -":             {ECO: "D57", Name: "Queen's Gambit Declined: Lasker Defense, Bernstein Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bg5 Be7 e3 O-O Nf3 h6 Bh4 Ne4 Bxe7 Qxe7 cxd5 Nxc3 bxc3 exd5 Qb3 Qd6"},
+	"rnbq1rk1/p1p1bpp1/1p2pn1p/3p4/2PP3B/2N1PN2/PP3PPP/R2QKB1R w KQ -":     {ECO: "D58", Name: "Queen's Gambit Declined: Tartakower Defense", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bg5 Be7 e3 O-O Nf3 h6 Bh4 b6"},
+	"rnbq1rk1/p1p1bpp1/1p2pn1p/3p4/2PP3B/2NBPN2/PP3PPP/R2QK2R b KQ -":      {ECO: "D58", Name: "Queen's Gambit Declined: Tartakower Defense, Exchange Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bg5 Be7 e3 O-O Nf3 h6 Bh4 b6 Bd3"},
+	"rnbq1rk1/p1p1bpp1/1p2p2p/3n4/3P3B/2N1PN2/PP3PPP/R2QKB1R w KQ -":       {ECO: "D59", Name: "Queen's Gambit Declined: Tartakower Defense, 8.cxd5", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bg5 Be7 e3 O-O Nf3 h6 Bh4 b6 cxd5 Nxd5"},
+	"rn3rk1/p1p1qpp1/1p2b2p/3p4/3P4/4PN2/PP3PPP/2RQKB1R w K -":             {ECO: "D59", Name: "Queen's Gambit Declined: Tartakower Defense, Makogonov Exchange Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bg5 Be7 e3 O-O Nf3 h6 Bh4 b6 cxd5 Nxd5 Bxe7 Qxe7 Nxd5 exd5 Rc1 Be6"},
+	"r1bq1rk1/pppnbppp/4pn2/3p2B1/2PP4/2N1PN2/PP3PPP/R2QKB1R w KQ -":       {ECO: "D60", Name: "Queen's Gambit Declined: Orthodox Defense", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bg5 Be7 e3 O-O Nf3 Nbd7"},
+	"r1bq1rk1/pppnbppp/4pn2/3p2B1/2PP4/2NBPN2/PP3PPP/R2QK2R b KQ -":        {ECO: "D60", Name: "Queen's Gambit Declined: Orthodox Defense, Botvinnik Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bg5 Be7 e3 O-O Nf3 Nbd7 Bd3"},
+	"r1bq1rk1/pppnbppp/4pn2/3p2B1/2PP4/1QN1PN2/PP3PPP/R3KB1R b KQ -":       {ECO: "D60", Name: "Queen's Gambit Declined: Orthodox Defense, Rauzer Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bg5 Be7 e3 O-O Nf3 Nbd7 Qb3"},
+	"r1bq1rk1/pppnbppp/4pn2/3p2B1/2PP4/2N1PN2/PPQ2PPP/R3KB1R b KQ -":       {ECO: "D61", Name: "Queen's Gambit Declined: Orthodox Defense, Rubinstein Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bg5 Be7 e3 O-O Nf3 Nbd7 Qc2"},
+	"r1bq1rk1/pp1nbppp/4pn2/2pP2B1/3P4/2N1PN2/PPQ2PPP/R3KB1R b KQ -":       {ECO: "D62", Name: "Queen's Gambit Declined: Orthodox Defense, Rubinstein Variation, Flohr Line", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bg5 Be7 e3 O-O Nf3 Nbd7 Qc2 c5 cxd5"},
+	"r1bq1rk1/pppnbppp/4pn2/3p2B1/2PP4/2N1PN2/PP3PPP/2RQKB1R b K -":        {ECO: "D63", Name: "Queen's Gambit Declined: Orthodox Defense, 7.Rc1", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bg5 Be7 e3 O-O Nf3 Nbd7 Rc1"},
+	"r1bq1rk1/p1pnbppp/1p3n2/3p2B1/3P4/2NBPN2/PP3PPP/2RQK2R b K -":         {ECO: "D63", Name: "Queen's Gambit Declined: Orthodox Defense, Pillsbury Attack", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bg5 Be7 e3 O-O Nf3 Nbd7 Rc1 b6 cxd5 exd5 Bd3"},
+	"r1bq1rk1/1ppnbppp/p3pn2/3p2B1/2PP4/2N1PN2/PP3PPP/2RQKB1R w K -":       {ECO: "D63", Name: "Queen's Gambit Declined: Orthodox Defense, Swiss Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bg5 Be7 e3 O-O Nf3 Nbd7 Rc1 a6"},
+	"r1bq1rk1/pp1nbppp/2p1pn2/3p2B1/2PP4/2N1PN2/PPQ2PPP/2R1KB1R b K -":     {ECO: "D64", Name: "Queen's Gambit Declined: Orthodox Defense, Rubinstein Attack", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bg5 Be7 e3 O-O Nf3 Nbd7 Rc1 c6 Qc2"},
+	"r1bq1rk1/1p1nbppp/p1p1pn2/3p2B1/2PP4/P1N1PN2/1PQ2PPP/2R1KB1R b K -":   {ECO: "D64", Name: "Queen's Gambit Declined: Orthodox Defense, Rubinstein Attack, Karlsbad Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bg5 Be7 e3 O-O Nf3 Nbd7 Rc1 c6 Qc2 a6 a3"},
+	"r1bq1rk1/1p1nbppp/p1p1pn2/3P2B1/3P4/2N1PN2/PPQ2PPP/2R1KB1R b K -":     {ECO: "D65", Name: "Queen's Gambit Declined: Orthodox Defense, Rubinstein Attack, Main Line", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bg5 Be7 e3 O-O Nf3 Nbd7 Rc1 c6 Qc2 a6 cxd5"},
+	"r1bq1rk1/pp1nbppp/2p1pn2/3p2B1/2PP4/2NBPN2/PP3PPP/2RQK2R b K -":       {ECO: "D66", Name: "Queen's Gambit Declined: Orthodox Defense, Bd3 Line", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bg5 Be7 e3 O-O Nf3 Nbd7 Rc1 c6 Bd3"},
+	"r1bq1rk1/p2nbpp1/2p1pn1p/1p6/2BP3B/2N1PN2/PP3PPP/2RQK2R w K -":        {ECO: "D66", Name: "Queen's Gambit Declined: Orthodox Defense, Bd3 Line, Fianchetto Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bg5 Be7 e3 O-O Nf3 Nbd7 Rc1 c6 Bd3 h6 Bh4 dxc4 Bxc4 b5"},
+	"r1bq1rk1/pp1nbppp/2p1p3/3n2B1/2BP4/2N1PN2/PP3PPP/2RQK2R w K -":        {ECO: "D67", Name: "Queen's Gambit Declined: Orthodox Defense, Main Line", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bg5 Be7 e3 O-O Nf3 Nbd7 Rc1 c6 Bd3 dxc4 Bxc4 Nd5"},
+	"r1b2rk1/pp1nqppp/2p1p3/3n4/2BPN3/4PN2/PP3PPP/2RQK2R b K -":            {ECO: "D67", Name: "Queen's Gambit Declined: Orthodox Defense, Bd3 Line, Alekhine Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bg5 Be7 e3 O-O Nf3 Nbd7 Rc1 c6 Bd3 dxc4 Bxc4 Nd5 Bxe7 Qxe7 Ne4"},
+	"r1b2rk1/pp1nqppp/2p1p3/3n4/2BP4/2N1PN2/PP3PPP/2RQK2R w K -":           {ECO: "D67", Name: "Queen's Gambit Declined: Orthodox Defense, Bd3 Line, Capablanca Freeing Maneuver", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bg5 Be7 e3 O-O Nf3 Nbd7 Rc1 c6 Bd3 dxc4 Bxc4 Nd5 Bxe7 Qxe7"},
+	"r1b2rk1/pp1nqppp/2p5/4p3/2BP4/2R1PN2/PP3PPP/3Q1RK1 w - -":             {ECO: "D68", Name: "Queen's Gambit Declined: Orthodox Defense, Classical Variation", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bg5 Be7 e3 O-O Nf3 Nbd7 Rc1 c6 Bd3 dxc4 Bxc4 Nd5 Bxe7 Qxe7 O-O Nxc3 Rxc3 e5"},
+	"r1b2rk1/pp1nqppp/2p5/4p3/2BP4/2R1PN2/PP3PPP/1Q3RK1 b - -":             {ECO: "D68", Name: "Queen's Gambit Declined: Orthodox Defense, Classical, 13.d1b1", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bg5 Be7 e3 O-O Nf3 Nbd7 Rc1 c6 Bd3 dxc4 Bxc4 Nd5 Bxe7 Qxe7 O-O Nxc3 Rxc3 e5 Qb1"},
+	"r1b2rk1/pp3ppp/2p5/4q3/2B5/2R1P3/PP3PPP/3Q1RK1 w - -":                 {ECO: "D69", Name: "Queen's Gambit Declined: Orthodox Defense, Classical Variation, Main Line", Moves: "d4 d5 c4 e6 Nc3 Nf6 Bg5 Be7 e3 O-O Nf3 Nbd7 Rc1 c6 Bd3 dxc4 Bxc4 Nd5 Bxe7 Qxe7 O-O Nxc3 Rxc3 e5 dxe5 Nxe5 Nxe5 Qxe5"},
+	"rnbqkb1r/ppp1pp1p/5np1/3p4/2PP4/5P2/PP2P1PP/RNBQKBNR w KQkq -":        {ECO: "D70", Name: "Neo-Grünfeld Defense", Moves: "d4 Nf6 c4 g6 f3 d5"},
+	"rnbqkb1r/ppp1pp1p/6p1/3n4/3PP3/5P2/PP4PP/RNBQKBNR b KQkq -":           {ECO: "D70", Name: "Neo-Grünfeld Defense: Goglidze Attack", Moves: "d4 Nf6 c4 g6 f3 d5 cxd5 Nxd5 e4"},
+	"rnbqkb1r/ppp1pp1p/5np1/3p4/2PP4/5N2/PP2PPPP/RNBQKB1R w KQkq -":        {ECO: "D70", Name: "Neo-Grünfeld Defense: with Nf3", Moves: "d4 Nf6 c4 g6 Nf3 d5"},
+	"rnbqk2r/ppp1ppbp/6p1/3n4/3P4/6P1/PP2PPBP/RNBQK1NR w KQkq -":           {ECO: "D71", Name: "Neo-Grünfeld Defense: Exchange Variation", Moves: "d4 Nf6 c4 g6 g3 d5 Bg2 Bg7 cxd5 Nxd5"},
+	"rnbqkb1r/ppp1pp1p/5np1/3p4/2PP4/6P1/PP2PP1P/RNBQKBNR w KQkq -":        {ECO: "D71", Name: "Neo-Grünfeld Defense: Fianchetto Variation", Moves: "d4 Nf6 c4 g6 g3 d5"},
+	"rnbqk2r/ppp1ppbp/1n4p1/8/3PP3/6P1/PP2NPBP/RNBQK2R b KQkq -":           {ECO: "D72", Name: "Neo-Grünfeld Defense: Classical Variation, Main Line", Moves: "d4 Nf6 c4 g6 g3 d5 Bg2 Bg7 cxd5 Nxd5 e4 Nb6 Ne2"},
+	"rnbqk2r/ppp1ppbp/5np1/3p4/2PP4/5NP1/PP2PPBP/RNBQK2R b KQkq -":         {ECO: "D73", Name: "Neo-Grünfeld Defense: 5.Nf3", Moves: "d4 Nf6 c4 g6 g3 d5 Bg2 Bg7 Nf3"},
+	"rnbq1rk1/ppp1ppbp/5np1/3P4/3P4/5NP1/PP2PPBP/RNBQK2R b KQ -":           {ECO: "D73", Name: "Neo-Grünfeld Defense: Goglidze Variation", Moves: "d4 Nf6 c4 g6 g3 d5 Bg2 Bg7 Nf3 O-O cxd5"},
+	"rnbq1rk1/ppp1ppbp/6p1/3n4/3P4/5NP1/PP2PPBP/RNBQ1RK1 b - -":            {ECO: "D74", Name: "Neo-Grünfeld Defense: Delayed Exchange Variation", Moves: "d4 Nf6 c4 g6 g3 d5 Bg2 Bg7 Nf3 O-O cxd5 Nxd5 O-O"},
+	"rnbq1rk1/pp2ppbp/6p1/2pn4/3P4/2N2NP1/PP2PPBP/R1BQ1RK1 b - -":          {ECO: "D75", Name: "Neo-Grünfeld Defense: Delayed Exchange Variation, 8.Nc3", Moves: "d4 Nf6 c4 g6 g3 d5 Bg2 Bg7 Nf3 O-O cxd5 Nxd5 O-O c5 Nc3"},
+	"rnbq1rk1/pp2ppbp/6p1/2Pn4/8/5NP1/PP2PPBP/RNBQ1RK1 b - -":              {ECO: "D75", Name: "Neo-Grünfeld Defense: Delayed Exchange Variation, 8.dxc5", Moves: "d4 Nf6 c4 g6 g3 d5 Bg2 Bg7 Nf3 O-O cxd5 Nxd5 O-O c5 dxc5"},
+	"rnbq1rk1/ppp1ppbp/1n4p1/8/3P4/5NP1/PP2PPBP/RNBQ1RK1 w - -":            {ECO: "D76", Name: "Neo-Grünfeld Defense: Delayed Exchange Variation, 7...Nb6", Moves: "d4 Nf6 c4 g6 g3 d5 Bg2 Bg7 Nf3 O-O cxd5 Nxd5 O-O Nb6"},
+	"rnbq1rk1/ppp1ppbp/5np1/3p4/2PP4/5NP1/PP2PPBP/RNBQ1RK1 b - -":          {ECO: "D77", Name: "Neo-Grünfeld Defense: Classical Variation, 6.O-O", Moves: "d4 Nf6 c4 g6 g3 d5 Bg2 Bg7 Nf3 O-O O-O"},
+	"r1bq1rk1/ppp1ppbp/2n2np1/3p4/2PP4/5NP1/PP2PPBP/RNBQ1RK1 w - -":        {ECO: "D77", Name: "Neo-Grünfeld Defense: Classical Variation, Polgar Variation", Moves: "d4 Nf6 c4 g6 g3 d5 Bg2 Bg7 Nf3 O-O O-O Nc6"},
+	"rnbq1rk1/ppp1ppbp/5np1/8/2pP4/5NP1/PP2PPBP/RNBQ1RK1 w - -":            {ECO: "D77", Name: "Neo-Grünfeld Defense: Classical Variation, Modern Defense", Moves: "d4 Nf6 c4 g6 g3 d5 Bg2 Bg7 Nf3 O-O O-O dxc4"},
+	"rnbq1rk1/pp2ppbp/2p2np1/3p4/2PP4/5NP1/PP2PPBP/RNBQ1RK1 w - -":         {ECO: "D78", Name: "Neo-Grünfeld Defense: Classical Variation", Moves: "d4 Nf6 c4 g6 g3 d5 Bg2 Bg7 Nf3 O-O O-O c6"},
+	"rnbq1rk1/pp2ppbp/2p2np1/3p4/2PP4/1P3NP1/P3PPBP/RNBQ1RK1 b - -":        {ECO: "D78", Name: "Neo-Grünfeld Defense: Classical Variation, Original Defense", Moves: "d4 Nf6 c4 g6 g3 d5 Bg2 Bg7 Nf3 O-O O-O c6 b3"},
+	"rnbq1rk1/pp2ppbp/5np1/3p4/3P4/5NP1/PP2PPBP/RNBQ1RK1 w - -":            {ECO: "D79", Name: "Neo-Grünfeld Defense: Ultra-Delayed Exchange Variation", Moves: "d4 Nf6 c4 g6 g3 d5 Bg2 Bg7 Nf3 O-O O-O c6 cxd5 cxd5"},
+	"rnbqkb1r/ppp1pp1p/5np1/3p4/2PP4/2N5/PP2PPPP/R1BQKBNR w KQkq -":        {ECO: "D80", Name: "Grünfeld Defense", Moves: "d4 Nf6 c4 g6 Nc3 d5"},
+	"rnbqkb1r/pp2pp1p/6p1/2p3B1/2PPp3/8/PP1QPPPP/R3KBNR w KQkq -":          {ECO: "D80", Name: "Grünfeld Defense: Lundin Variation", Moves: "d4 Nf6 c4 g6 Nc3 d5 Bg5 Ne4 Nxe4 dxe4 Qd2 c5"},
+	"rnbqkb1r/ppp1pp1p/5np1/3p2B1/2PP4/2N5/PP2PPPP/R2QKBNR b KQkq -":       {ECO: "D80", Name: "Grünfeld Defense: Stockholm Variation", Moves: "d4 Nf6 c4 g6 Nc3 d5 Bg5"},
+	"rnbqkb1r/ppp1pp1p/5np1/3p4/2PP3P/2N5/PP2PPP1/R1BQKBNR b KQkq -":       {ECO: "D80", Name: "Grünfeld Defense: Zaitsev Gambit", Moves: "d4 Nf6 c4 g6 Nc3 d5 h4"},
+	"rnbqkb1r/ppp1pp1p/5np1/3p4/2PP4/1QN5/PP2PPPP/R1B1KBNR b KQkq -":       {ECO: "D81", Name: "Grünfeld Defense: Russian Variation, Accelerated Variation", Moves: "d4 Nf6 c4 g6 Nc3 d5 Qb3"},
+	"rnbqk2r/ppp1ppbp/5np1/3p4/2PP1B2/2N5/PP2PPPP/R2QKBNR w KQkq -":        {ECO: "D82", Name: "Grünfeld Defense: Brinckmann Attack, 4...Bg7", Moves: "d4 Nf6 c4 g6 Nc3 d5 Bf4 Bg7"},
+	"rnbqk2r/pp2ppbp/5np1/2pp4/2PP1B2/2N1P3/PP3PPP/R2QKBNR w KQkq -":       {ECO: "D82", Name: "Grünfeld Defense: Brinckmann Attack, Reversed Tarrasch", Moves: "d4 Nf6 c4 g6 Nc3 d5 Bf4 Bg7 e3 c5"},
+	"rnbqkb1r/ppp1pp1p/5np1/3p4/2PP1B2/2N5/PP2PPPP/R2QKBNR b KQkq -":       {ECO: "D83", Name: "Grünfeld Defense: Brinckmann Attack", Moves: "d4 Nf6 c4 g6 Nc3 d5 Bf4"},
+	"rnbq1rk1/ppp1ppbp/5np1/3p4/2PP1B2/2N1P3/PP3PPP/R2QKBNR w KQ -":        {ECO: "D83", Name: "Grünfeld Defense: Brinckmann Attack, Grünfeld Gambit", Moves: "d4 Nf6 c4 g6 Nc3 d5 Bf4 Bg7 e3 O-O"},
+	"rnbq1rk1/ppp1ppbp/5np1/3p4/2PP1B2/2N1P3/PP3PPP/2RQKBNR b K -":         {ECO: "D83", Name: "Grünfeld Defense: Brinckmann Attack, Capablanca Variation", Moves: "d4 Nf6 c4 g6 Nc3 d5 Bf4 Bg7 e3 O-O Rc1"},
+	"rnb2rk1/ppB1ppbp/6p1/3q4/3P4/4P3/PP3PPP/R2QKBNR b KQ -":               {ECO: "D84", Name: "Grünfeld Defense: Grünfeld Gambit Accepted", Moves: "d4 Nf6 c4 g6 Nc3 d5 Bf4 Bg7 e3 O-O cxd5 Nxd5 Nxd5 Qxd5 Bxc7"},
+	"rnbqkb1r/ppp1pp1p/6p1/3n4/3P4/2N5/PP2PPPP/R1BQKBNR w KQkq -":          {ECO: "D85", Name: "Grünfeld Defense: Exchange Variation", Moves: "d4 Nf6 c4 g6 Nc3 d5 cxd5 Nxd5"},
+	"rnbqk2r/ppp1ppbp/6p1/8/3PP3/2P5/P4PPP/R1BQKBNR w KQkq -":              {ECO: "D85", Name: "Grünfeld Defense: Exchange Variation", Moves: "d4 Nf6 c4 g6 Nc3 d5 cxd5 Nxd5 e4 Nxc3 bxc3 Bg7"},
+	"rnbqk2r/ppp1ppbp/6p1/8/3PP3/2P2N2/P4PPP/R1BQKB1R b KQkq -":            {ECO: "D85", Name: "Grünfeld Defense: Exchange Variation, Modern Exchange Variation", Moves: "d4 Nf6 c4 g6 Nc3 d5 cxd5 Nxd5 e4 Nxc3 bxc3 Bg7 Nf3"},
+	"rnbqkb1r/ppp1pp1p/6p1/3n4/N2P4/8/PP2PPPP/R1BQKBNR b KQkq -":           {ECO: "D85", Name: "Grünfeld Defense: Exchange Variation, Nadanian Attack", Moves: "d4 Nf6 c4 g6 Nc3 d5 cxd5 Nxd5 Na4"},
+	"rnbq1rk1/pp2ppbp/6p1/2p5/3PP3/2P2N2/P3BPPP/1RBQK2R b K -":             {ECO: "D85", Name: "Grünfeld Defense: Exchange Variation, Modern Exchange Variation, Main Line", Moves: "d4 Nf6 c4 g6 Nc3 d5 cxd5 Nxd5 e4 Nxc3 bxc3 Bg7 Nf3 c5 Rb1 O-O Be2"},
+	"rnbqk2r/ppp1ppbp/6p1/8/2BPP3/2P5/P4PPP/R1BQK1NR b KQkq -":             {ECO: "D86", Name: "Grünfeld Defense: Exchange Variation, Classical Variation", Moves: "d4 Nf6 c4 g6 Nc3 d5 cxd5 Nxd5 e4 Nxc3 bxc3 Bg7 Bc4"},
+	"r1bq1rk1/ppp1ppbp/2n3p1/8/2BPP3/2P5/P3NPPP/R1BQK2R w KQ -":            {ECO: "D86", Name: "Grünfeld Defense: Exchange Variation, Simagin's Improved Variation", Moves: "d4 Nf6 c4 g6 Nc3 d5 cxd5 Nxd5 e4 Nxc3 bxc3 Bg7 Bc4 O-O Ne2 Nc6"},
+	"rnb2rk1/p1pqppbp/1p4p1/8/2BPP3/2P5/P3NPPP/R1BQ1RK1 w - -":             {ECO: "D86", Name: "Grünfeld Defense: Exchange Variation, Larsen Variation", Moves: "d4 Nf6 c4 g6 Nc3 d5 cxd5 Nxd5 e4 Nxc3 bxc3 Bg7 Bc4 O-O Ne2 Qd7 O-O b6"},
+	"rnbq1rk1/pp2ppbp/6p1/2p5/2BPP3/2P5/P3NPPP/R1BQK2R w KQ -":             {ECO: "D87", Name: "Grünfeld Defense: Exchange Variation, Spassky Variation", Moves: "d4 Nf6 c4 g6 Nc3 d5 cxd5 Nxd5 e4 Nxc3 bxc3 Bg7 Bc4 O-O Ne2 c5"},
+	"r2q1rk1/pp2pBbp/6p1/n1p5/3PP1b1/2P1BP2/P3N1PP/R2Q1RK1 b - -":          {ECO: "D87", Name: "Grünfeld Defense: Exchange Variation, Seville Variation", Moves: "d4 Nf6 c4 g6 Nc3 d5 cxd5 Nxd5 e4 Nxc3 bxc3 Bg7 Bc4 O-O Ne2 c5 O-O Nc6 Be3 Bg4 f3 Na5 Bxf7+"},
+	"r1bq1rk1/pp2ppbp/2n3p1/8/2BPP3/4B3/P3NPPP/R2Q1RK1 b - -":              {ECO: "D88", Name: "Grünfeld Defense: Exchange Variation, Spassky Variation, Main Line", Moves: "d4 Nf6 c4 g6 Nc3 d5 cxd5 Nxd5 e4 Nxc3 bxc3 Bg7 Bc4 O-O Ne2 c5 O-O Nc6 Be3 cxd4 cxd4"},
+	"r2q1rk1/pp2ppbp/4b1p1/n2P4/4P3/3BBP2/P3N1PP/R2Q1RK1 b - -":            {ECO: "D89", Name: "Grünfeld Defense: Exchange Variation, Sokolsky Variation", Moves: "d4 Nf6 c4 g6 Nc3 d5 cxd5 Nxd5 e4 Nxc3 bxc3 Bg7 Bc4 O-O Ne2 c5 O-O Nc6 Be3 cxd4 cxd4 Bg4 f3 Na5 Bd3 Be6 d5"},
+	"r2q1rk1/pp2ppbp/6p1/n7/3PP1b1/3BBP2/P3N1PP/R2Q1RK1 b - -":             {ECO: "D89", Name: "Grünfeld Defense: Exchange Variation, Spassky Variation, 13.Bd3", Moves: "d4 Nf6 c4 g6 Nc3 d5 cxd5 Nxd5 e4 Nxc3 bxc3 Bg7 Bc4 O-O Ne2 c5 O-O Nc6 Be3 cxd4 cxd4 Bg4 f3 Na5 Bd3"},
+	"rnbqkb1r/ppp1pp1p/5np1/3p4/2PP4/2N2N2/PP2PPPP/R1BQKB1R b KQkq -":      {ECO: "D90", Name: "Grünfeld Defense: Three Knights Variation", Moves: "d4 Nf6 c4 g6 Nc3 d5 Nf3"},
+	"rnbqk2r/ppp1ppbp/6p1/3n4/3P4/2N2N2/PP1BPPPP/R2QKB1R b KQkq -":         {ECO: "D90", Name: "Grünfeld Defense: Three Knights Variation, Vienna Variation", Moves: "d4 Nf6 c4 g6 Nc3 d5 Nf3 Bg7 cxd5 Nxd5 Bd2"},
+	"rnbqk2r/ppp1ppbp/5np1/3p4/Q1PP4/2N2N2/PP2PPPP/R1B1KB1R b KQkq -":      {ECO: "D90", Name: "Grünfeld Defense: Flohr Variation", Moves: "d4 Nf6 c4 g6 Nc3 d5 Nf3 Bg7 Qa4+"},
+	"rnbqk2r/ppp1ppbp/5np1/3p4/2PP4/2N2N2/PP2PPPP/R1BQKB1R w KQkq -":       {ECO: "D90", Name: "Grünfeld Defense: Three Knights Variation, 4...Bg7", Moves: "d4 Nf6 c4 g6 Nc3 d5 Nf3 Bg7"},
+	"rnbqk2r/ppp1ppbp/5np1/3p2B1/2PP4/2N2N2/PP2PPPP/R2QKB1R b KQkq -":      {ECO: "D91", Name: "Grünfeld Defense: Three Knights Variation, Petrosian System", Moves: "d4 Nf6 c4 g6 Nc3 d5 Nf3 Bg7 Bg5"},
+	"rnbqk2r/ppp1ppbp/5np1/3p4/2PP1B2/2N2N2/PP2PPPP/R2QKB1R b KQkq -":      {ECO: "D92", Name: "Grünfeld Defense: Three Knights Variation, Hungarian Attack", Moves: "d4 Nf6 c4 g6 Nc3 d5 Nf3 Bg7 Bf4"},
+	"rnbq1rk1/ppp1ppbp/5np1/3p4/2PP1B2/2N1PN2/PP3PPP/R2QKB1R b KQ -":       {ECO: "D93", Name: "Grünfeld Defense: Three Knights Variation, Hungarian Variation", Moves: "d4 Nf6 c4 g6 Nc3 d5 Nf3 Bg7 Bf4 O-O e3"},
+	"rnbqk2r/ppp1ppbp/5np1/3p4/2PP4/2N1PN2/PP3PPP/R1BQKB1R b KQkq -":       {ECO: "D94", Name: "Grünfeld Defense: Makogonov Variation", Moves: "d4 Nf6 c4 g6 Nc3 d5 Nf3 Bg7 e3"},
+	"rnbq1rk1/ppp1ppbp/5np1/3p4/2PP4/2N1PN2/PP1B1PPP/R2QKB1R b KQ -":       {ECO: "D94", Name: "Grünfeld Defense: Smyslov Defense", Moves: "d4 Nf6 c4 g6 Nc3 d5 Nf3 Bg7 e3 O-O Bd2"},
+	"rn1q1rk1/pp2ppbp/2p2np1/3p1b2/2PP4/2NBPN2/PP3PPP/R1BQ1RK1 w - -":      {ECO: "D94", Name: "Grünfeld Defense: Flohr Defense", Moves: "d4 Nf6 c4 g6 Nc3 d5 Nf3 Bg7 e3 O-O Bd3 c6 O-O Bf5"},
+	"rnbq1rk1/ppp1ppbp/5np1/3p4/2PP4/2N1PN2/PP3PPP/R1BQKB1R w KQ -":        {ECO: "D94", Name: "Grünfeld Defense: Makogonov Variation, 5...O-O", Moves: "d4 Nf6 c4 g6 Nc3 d5 Nf3 Bg7 e3 O-O"},
+	"rnbq1rk1/ppp1ppbp/5np1/3p4/2PP4/1QN1PN2/PP3PPP/R1B1KB1R b KQ -":       {ECO: "D95", Name: "Grünfeld Defense: Three Knights Variation, Burille Variation", Moves: "d4 Nf6 c4 g6 Nc3 d5 Nf3 Bg7 e3 O-O Qb3"},
+	"rnbq1rk1/ppp2pbp/4pnp1/3p4/2PP4/1QN1PN2/PP3PPP/R1B1KB1R w KQ -":       {ECO: "D95", Name: "Grünfeld Defense: Pachman Variation", Moves: "d4 Nf6 c4 g6 Nc3 d5 Nf3 Bg7 e3 O-O Qb3 e6"},
+	"rnbq1rk1/pp2ppbp/2p2np1/3p4/2PP4/1QN1PN2/PP3PPP/R1B1KB1R w KQ -":      {ECO: "D95", Name: "Grünfeld Defense: Botvinnik Variation", Moves: "d4 Nf6 c4 g6 Nc3 d5 Nf3 Bg7 e3 O-O Qb3 c6"},
+	"rnbqk2r/ppp1ppbp/5np1/3p4/2PP4/1QN2N2/PP2PPPP/R1B1KB1R b KQkq -":      {ECO: "D96", Name: "Grünfeld Defense: Russian Variation", Moves: "d4 Nf6 c4 g6 Nc3 d5 Nf3 Bg7 Qb3"},
+	"rnbqk2r/ppp1ppbp/5np1/8/2pP4/1QN2N2/PP2PPPP/R1B1KB1R w KQkq -":        {ECO: "D96", Name: "Grünfeld Defense: Russian Variation, 5...dxc4", Moves: "d4 Nf6 c4 g6 Nc3 d5 Nf3 Bg7 Qb3 dxc4"},
+	"rnbq1rk1/ppp1ppbp/5np1/8/2QPP3/2N2N2/PP3PPP/R1B1KB1R b KQ -":          {ECO: "D97", Name: "Grünfeld Defense: Russian Variation", Moves: "d4 Nf6 c4 g6 Nc3 d5 Nf3 Bg7 Qb3 dxc4 Qxc4 O-O e4"},
+	"rnbq1rk1/1pp1ppbp/p4np1/8/2QPP3/2N2N2/PP3PPP/R1B1KB1R w KQ -":         {ECO: "D97", Name: "Grünfeld Defense: Russian Variation, Hungarian Variation", Moves: "d4 Nf6 c4 g6 Nc3 d5 Nf3 Bg7 Qb3 dxc4 Qxc4 O-O e4 a6"},
+	"r1bq1rk1/ppp1ppbp/n4np1/8/2QPP3/2N2N2/PP3PPP/R1B1KB1R w KQ -":         {ECO: "D97", Name: "Grünfeld Defense: Russian Variation, Prins Variation", Moves: "d4 Nf6 c4 g6 Nc3 d5 Nf3 Bg7 Qb3 dxc4 Qxc4 O-O e4 Na6"},
+	"rn1q1rk1/ppp1ppbp/5np1/8/2QPP1b1/2N2N2/PP3PPP/R1B1KB1R w KQ -":        {ECO: "D98", Name: "Grünfeld Defense: Russian Variation, Smyslov Defense", Moves: "d4 Nf6 c4 g6 Nc3 d5 Nf3 Bg7 Qb3 dxc4 Qxc4 O-O e4 Bg4"},
+	"r2q1rk1/ppp1ppbp/1nn3p1/8/3PP1b1/2NQBN2/PP2BPPP/2KR3R b - -":          {ECO: "D98", Name: "Grünfeld Defense: Russian Variation, Keres Variation", Moves: "d4 Nf6 c4 g6 Nc3 d5 Nf3 Bg7 Qb3 dxc4 Qxc4 O-O e4 Bg4 Be3 Nfd7 Be2 Nb6 Qd3 Nc6 O-O-O"},
+	"rn1q1rk1/pppnppbp/6p1/8/3PP1b1/1QN1BN2/PP3PPP/R3KB1R b KQ -":          {ECO: "D99", Name: "Grünfeld Defense: Russian Variation, Smyslov Defense, Main Line", Moves: "d4 Nf6 c4 g6 Nc3 d5 Nf3 Bg7 Qb3 dxc4 Qxc4 O-O e4 Bg4 Be3 Nfd7 Qb3"},
+	"rn1q1rk1/pp1nppbp/6p1/2p5/3PP1b1/1QN1BN2/PP3PPP/R3KB1R w KQ -":        {ECO: "D99", Name: "Grünfeld Defense: Russian Variation, Yugoslav Variation", Moves: "d4 Nf6 c4 g6 Nc3 d5 Nf3 Bg7 Qb3 dxc4 Qxc4 O-O e4 Bg4 Be3 Nfd7 Qb3 c5"},
+	"rnbqkb1r/pppp1ppp/4pn2/8/2PP4/8/PP2PPPP/RNBQKBNR w KQkq -":            {ECO: "E00", Name: "Indian Defense: East Indian Defense", Moves: "d4 Nf6 c4 e6"},
+	"rnbqkb1r/pppp1ppp/4pn2/8/2PP4/6P1/PP2PP1P/RNBQKBNR b KQkq -":          {ECO: "E00", Name: "Catalan Opening", Moves: "d4 Nf6 c4 e6 g3"},
+	"rnbqkb1r/pppp1ppp/5n2/4p3/2PP4/6P1/PP2PP1P/RNBQKBNR w KQkq -":         {ECO: "E00", Name: "Catalan Opening: Hungarian Gambit", Moves: "d4 Nf6 c4 e6 g3 e5"},
+	"rnbqkb1r/pppp1ppp/4pn2/8/2PP2P1/8/PP2PP1P/RNBQKBNR b KQkq -":          {ECO: "E00", Name: "Indian Defense: Devin Gambit", Moves: "d4 Nf6 c4 e6 g4"},
+	"rnbqkb1r/pppp1ppp/4pn2/6B1/2PP4/8/PP2PPPP/RN1QKBNR b KQkq -":          {ECO: "E00", Name: "Indian Defense: Seirawan Attack", Moves: "d4 Nf6 c4 e6 Bg5"},
+	"rnbqkb1r/ppp2ppp/4pn2/3p4/2PP4/6P1/PP2PPBP/RNBQK1NR b KQkq -":         {ECO: "E01", Name: "Catalan Opening: Closed", Moves: "d4 Nf6 c4 e6 g3 d5 Bg2"},
+	"rnbqkb1r/pp3ppp/2p1pn2/3p4/2PP4/5NP1/PP2PPBP/RNBQK2R b KQkq -":        {ECO: "E01", Name: "Catalan Opening: Closed, Main Line", Moves: "d4 Nf6 c4 e6 g3 d5 Bg2 c6 Nf3"},
+	"rnbqkb1r/ppp2ppp/4pn2/8/Q1pP4/6P1/PP2PPBP/RNB1K1NR b KQkq -":          {ECO: "E02", Name: "Catalan Opening: Open Defense, 5.Qa4+", Moves: "d4 Nf6 c4 e6 g3 d5 Bg2 dxc4 Qa4+"},
+	"r1bqkb1r/1ppn1ppp/p3pn2/8/3P4/6P1/PPQ1PPBP/RNB1K1NR b KQkq -":         {ECO: "E03", Name: "Catalan Opening: Open Defense, Alekhine Variation", Moves: "d4 Nf6 c4 e6 g3 d5 Bg2 dxc4 Qa4+ Nbd7 Qxc4 a6 Qc2"},
+	"r1bqkb1r/pppn1ppp/4pn2/8/2QP4/6P1/PP2PPBP/RNB1K1NR b KQkq -":          {ECO: "E03", Name: "Catalan Opening: Open Defense, 6.Qxc4", Moves: "d4 Nf6 c4 e6 g3 d5 Bg2 dxc4 Qa4+ Nbd7 Qxc4"},
+	"rnbqkb1r/ppp2ppp/4pn2/8/2pP4/5NP1/PP2PPBP/RNBQK2R b KQkq -":           {ECO: "E04", Name: "Catalan Opening: Open Defense", Moves: "d4 Nf6 c4 e6 g3 d5 Bg2 dxc4 Nf3"},
+	"rnbqkb1r/pp3ppp/4pn2/2p5/2pP4/5NP1/PP2PPBP/RNBQK2R w KQkq -":          {ECO: "E04", Name: "Catalan Opening: Open Defense, 5...c5", Moves: "d4 Nf6 c4 e6 g3 d5 Bg2 dxc4 Nf3 c5"},
+	"r1bqkb1r/ppp2ppp/2n1pn2/8/2pP4/5NP1/PP2PPBP/RNBQK2R w KQkq -":         {ECO: "E04", Name: "Catalan Opening: Open Defense, 5...Nc6", Moves: "d4 Nf6 c4 e6 g3 d5 Bg2 dxc4 Nf3 Nc6"},
+	"rnbqk2r/ppp1bppp/4pn2/8/2pP4/5NP1/PP2PPBP/RNBQK2R w KQkq -":           {ECO: "E05", Name: "Catalan Opening: Open Defense, Classical Line", Moves: "d4 Nf6 c4 e6 g3 d5 Bg2 dxc4 Nf3 Be7"},
+	"rn1q1rk1/1bp1bppp/p3pn2/1p6/3P4/5NP1/PPQ1PPBP/RNB2RK1 w - -":          {ECO: "E05", Name: "Catalan Opening: Open Defense, Classical Line, Main Line", Moves: "d4 Nf6 c4 e6 g3 d5 Bg2 dxc4 Nf3 Be7 O-O O-O Qc2 a6 Qxc4 b5 Qc2 Bb7"},
+	"rnbqk2r/ppp1bppp/4pn2/3p4/2PP4/5NP1/PP2PPBP/RNBQK2R b KQkq -":         {ECO: "E06", Name: "Catalan Opening: Closed Variation", Moves: "d4 Nf6 c4 e6 g3 d5 Bg2 Be7 Nf3"},
+	"rnbq1rk1/ppp1bppp/4pn2/3p4/2PP4/5NP1/PP2PPBP/RNBQK2R w KQ -":          {ECO: "E06", Name: "Catalan Opening: Closed Variation, 5...O-O", Moves: "d4 Nf6 c4 e6 g3 d5 Bg2 Be7 Nf3 O-O"},
+	"rnbq1rk1/ppp1bppp/4pn2/3p4/2PP4/5NP1/PP2PPBP/RNBQ1RK1 b - -":          {ECO: "E06", Name: "Catalan Opening: Closed Variation, Main Line", Moves: "d4 Nf6 c4 e6 g3 d5 Bg2 Be7 Nf3 O-O O-O"},
+	"r1bq1rk1/pppnbppp/4pn2/3p4/2PP4/5NP1/PP2PPBP/RNBQ1RK1 w - -":          {ECO: "E07", Name: "Catalan Opening: Closed Variation, 6...Nbd7", Moves: "d4 Nf6 c4 e6 g3 d5 Bg2 Be7 Nf3 O-O O-O Nbd7"},
+	"r1bq1rk1/pp1nbppp/2p1pn2/3p4/2PP4/2NQ1NP1/PP2PPBP/R1B2RK1 b - -":      {ECO: "E07", Name: "Catalan Opening: Closed Variation, Botvinnik Variation", Moves: "d4 Nf6 c4 e6 g3 d5 Bg2 Be7 Nf3 O-O O-O Nbd7 Nc3 c6 Qd3"},
+	"r1bq1rk1/pppnbppp/4pn2/3p4/2PP4/5NP1/PPQ1PPBP/RNB2RK1 b - -":          {ECO: "E08", Name: "Catalan Opening: Closed Variation, 7.Qc2", Moves: "d4 Nf6 c4 e6 g3 d5 Bg2 Be7 Nf3 O-O O-O Nbd7 Qc2"},
+	"r1bq1rk1/p2nbppp/1pp1pn2/3p4/P1PP4/5NP1/1PQ1PPBP/RNBR2K1 b - -":       {ECO: "E08", Name: "Catalan Opening: Closed Variation, Zagoryansky Variation", Moves: "d4 Nf6 c4 e6 g3 d5 Bg2 Be7 Nf3 O-O O-O Nbd7 Qc2 c6 Rd1 b6 a4"},
+	"r1bq1rk1/pp1nbppp/2p1pn2/3p4/2PP4/5NP1/PPQNPPBP/R1B2RK1 b - -":        {ECO: "E09", Name: "Catalan Opening: Closed Variation, Main Line", Moves: "d4 Nf6 c4 e6 g3 d5 Bg2 Be7 Nf3 O-O O-O Nbd7 Qc2 c6 Nbd2"},
+	"r2q1rk1/3nbppp/bpp1pn2/p2p4/2PP4/1P3NP1/PBQNPPBP/R4RK1 w - -":         {ECO: "E09", Name: "Catalan Opening: Closed Variation, Sokolsky Variation", Moves: "d4 Nf6 c4 e6 g3 d5 Bg2 Be7 Nf3 O-O O-O Nbd7 Qc2 c6 Nbd2 b6 b3 a5 Bb2 Ba6"},
+	"rnbqkb1r/pppp1ppp/4pn2/8/2PP4/5N2/PP2PPPP/RNBQKB1R b KQkq -":          {ECO: "E10", Name: "Indian Defense: Anti-Nimzo-Indian", Moves: "d4 Nf6 c4 e6 Nf3"},
+	"rnbqkb1r/p2p1ppp/4pn2/1ppP4/2P5/5N2/PP2PPPP/RNBQKB1R w KQkq -":        {ECO: "E10", Name: "Blumenfeld Countergambit", Moves: "d4 Nf6 c4 e6 Nf3 c5 d5 b5"},
+	"rnbqkb1r/p2p1ppp/4pn2/1ppP2B1/2P5/5N2/PP2PPPP/RN1QKB1R b KQkq -":      {ECO: "E10", Name: "Blumenfeld Countergambit: Dus-Khotimirsky Variation", Moves: "d4 Nf6 c4 e6 Nf3 c5 d5 b5 Bg5"},
+	"rnbqkb1r/pppp1ppp/4p3/8/2PPn3/5N2/PP2PPPP/RNBQKB1R w KQkq -":          {ECO: "E10", Name: "Indian Defense: Döry Indian", Moves: "d4 Nf6 c4 e6 Nf3 Ne4"},
+	"rnbqk2r/pppp1ppp/4pn2/8/1bPP4/5N2/PP2PPPP/RNBQKB1R w KQkq -":          {ECO: "E11", Name: "Bogo-Indian Defense", Moves: "d4 Nf6 c4 e6 Nf3 Bb4+"},
+	"rnbqk2r/pppp1ppp/4pn2/8/2PP4/5N2/PP1bPPPP/RN1QKB1R w KQkq -":          {ECO: "E11", Name: "Bogo-Indian Defense: Exchange Variation", Moves: "d4 Nf6 c4 e6 Nf3 Bb4+ Bd2 Bxd2+"},
+	"rnb1k2r/ppppqppp/4pn2/8/1bPP4/5N2/PP1BPPPP/RN1QKB1R w KQkq -":         {ECO: "E11", Name: "Bogo-Indian Defense: Nimzowitsch Variation", Moves: "d4 Nf6 c4 e6 Nf3 Bb4+ Bd2 Qe7"},
+	"rnbqk2r/pppp1ppp/4pn2/8/1bPP4/5N2/PP1NPPPP/R1BQKB1R b KQkq -":         {ECO: "E11", Name: "Bogo-Indian Defense: Grünfeld Variation", Moves: "d4 Nf6 c4 e6 Nf3 Bb4+ Nbd2"},
+	"rnbqk2r/ppppbppp/4pn2/8/2PP4/5N2/PP1BPPPP/RN1QKB1R w KQkq -":          {ECO: "E11", Name: "Bogo-Indian Defense: Retreat Variation", Moves: "d4 Nf6 c4 e6 Nf3 Bb4+ Bd2 Be7"},
+	"rnbqkb1r/p1pp1ppp/1p2pn2/8/2PP4/5N2/PP2PPPP/RNBQKB1R w KQkq -":        {ECO: "E12", Name: "Queen's Indian Defense", Moves: "d4 Nf6 c4 e6 Nf3 b6"},
+	"rnbqkb1r/p1pp1ppp/1p2pn2/8/2PP4/P4N2/1P2PPPP/RNBQKB1R b KQkq -":       {ECO: "E12", Name: "Queen's Indian Defense: Petrosian Variation", Moves: "d4 Nf6 c4 e6 Nf3 b6 a3"},
+	"rnbqkb1r/p1pp1ppp/1p2pn2/8/2PP1B2/5N2/PP2PPPP/RN1QKB1R b KQkq -":      {ECO: "E12", Name: "Queen's Indian Defense: Miles Variation", Moves: "d4 Nf6 c4 e6 Nf3 b6 Bf4"},
+	"rnbqkb1r/p1pp1ppp/1p2pn2/8/2PP4/2N2N2/PP2PPPP/R1BQKB1R b KQkq -":      {ECO: "E12", Name: "Queen's Indian Defense: Kasparov Variation", Moves: "d4 Nf6 c4 e6 Nf3 b6 Nc3"},
+	"rn1qkb1r/pbp2ppp/1p2p3/3n4/3P4/P1N2N2/1P2PPPP/R1BQKB1R w KQkq -":      {ECO: "E12", Name: "Queen's Indian Defense: Petrosian Variation, Main Line", Moves: "d4 Nf6 c4 e6 Nf3 b6 a3 Bb7 Nc3 d5 cxd5 Nxd5"},
+	"rn1qkb1r/pbpp1ppp/1p2pn2/6B1/2PP4/2N2N2/PP2PPPP/R2QKB1R b KQkq -":     {ECO: "E13", Name: "Queen's Indian Defense: Kasparov Variation, 5.Bg5", Moves: "d4 Nf6 c4 e6 Nf3 b6 Nc3 Bb7 Bg5"},
+	"rn1qk2r/pbpp1pp1/1p2pn1p/8/1bPP3B/2N2N2/PP2PPPP/R2QKB1R w KQkq -":     {ECO: "E13", Name: "Queen's Indian Defense: Kasparov Variation, Main Line", Moves: "d4 Nf6 c4 e6 Nf3 b6 Nc3 Bb7 Bg5 h6 Bh4 Bb4"},
+	"rnbqkb1r/p1pp1ppp/1p2pn2/8/2PP4/4PN2/PP3PPP/RNBQKB1R b KQkq -":        {ECO: "E14", Name: "Queen's Indian Defense: Spassky System", Moves: "d4 Nf6 c4 e6 Nf3 b6 e3"},
+	"rn1q1rk1/pb2bppp/1p2pn2/3p4/2PP4/1P1B1N2/PB3PPP/RN1Q1RK1 w - -":       {ECO: "E14", Name: "Queen's Indian Defense: Averbakh Variation", Moves: "d4 Nf6 c4 e6 Nf3 b6 e3 Bb7 Bd3 c5 O-O Be7 b3 O-O Bb2 cxd4 exd4 d5"},
+	"rnbqkb1r/p1pp1ppp/1p2pn2/8/2PP4/5NP1/PP2PP1P/RNBQKB1R b KQkq -":       {ECO: "E15", Name: "Queen's Indian Defense: Fianchetto Variation", Moves: "d4 Nf6 c4 e6 Nf3 b6 g3"},
+	"rn1qkb1r/p1pp1ppp/bp2pn2/8/2PP4/5NP1/PP2PP1P/RNBQKB1R w KQkq -":       {ECO: "E15", Name: "Queen's Indian Defense: Fianchetto Variation, Nimzowitsch Variation", Moves: "d4 Nf6 c4 e6 Nf3 b6 g3 Ba6"},
+	"rn1qkb1r/pb1p1ppp/1p3n2/2pp2N1/2P5/6P1/PP2PPBP/RNBQK2R b KQkq -":      {ECO: "E15", Name: "Queen's Indian Defense: Fianchetto Variation, Buerger Variation", Moves: "d4 Nf6 c4 e6 Nf3 b6 g3 Bb7 Bg2 c5 d5 exd5 Ng5"},
+	"rn1qk2r/p1ppbppp/bp2pn2/8/2PP4/1P3NP1/P2BPP1P/RN1QKB1R w KQkq -":      {ECO: "E15", Name: "Queen's Indian Defense: Fianchetto Variation, Nimzowitsch Variation, Main Line", Moves: "d4 Nf6 c4 e6 Nf3 b6 g3 Ba6 b3 Bb4+ Bd2 Be7"},
+	"rn1qkb1r/pbpp1ppp/1p2pn2/8/2PP4/5NP1/PP2PP1P/RNBQKB1R w KQkq -":       {ECO: "E15", Name: "Queen's Indian Defense: Fianchetto Variation, 4...Bb7", Moves: "d4 Nf6 c4 e6 Nf3 b6 g3 Bb7"},
+	"rn1qk2r/pbpp1ppp/1p2pn2/8/1bPP4/5NP1/PP2PPBP/RNBQK2R w KQkq -":        {ECO: "E16", Name: "Queen's Indian Defense: Capablanca Variation", Moves: "d4 Nf6 c4 e6 Nf3 b6 g3 Bb7 Bg2 Bb4+"},
+	"rn1qk2r/pbppbppp/1p2pn2/8/2PP4/5NP1/PP1BPPBP/RN1QK2R w KQkq -":        {ECO: "E16", Name: "Queen's Indian Defense: Riumin Variation", Moves: "d4 Nf6 c4 e6 Nf3 b6 g3 Bb7 Bg2 Bb4+ Bd2 Be7"},
+	"rn1qk2r/1bpp1ppp/1p2pn2/p7/1bPP4/5NP1/PP1BPPBP/RN1QK2R w KQkq -":      {ECO: "E16", Name: "Queen's Indian Defense: Yates Variation", Moves: "d4 Nf6 c4 e6 Nf3 b6 g3 Bb7 Bg2 Bb4+ Bd2 a5"},
+	"rn1qk2r/pbppbppp/1p2pn2/8/2PP4/5NP1/PP2PPBP/RNBQK2R w KQkq -":         {ECO: "E17", Name: "Queen's Indian Defense: Traditional Variation", Moves: "d4 Nf6 c4 e6 Nf3 b6 g3 Bb7 Bg2 Be7"},
+	"rn1qk2r/pbppbppp/1p2pn2/8/2PP4/2N2NP1/PP2PPBP/R1BQK2R b KQkq -":       {ECO: "E17", Name: "Queen's Indian Defense: Anti-Queen's Indian System", Moves: "d4 Nf6 c4 e6 Nf3 b6 g3 Bb7 Bg2 Be7 Nc3"},
+	"rn1q1rk1/pbppbppp/1p2pn2/8/2PP4/1P3NP1/P3PPBP/RNBQ1RK1 b - -":         {ECO: "E17", Name: "Queen's Indian Defense: Euwe Variation", Moves: "d4 Nf6 c4 e6 Nf3 b6 g3 Bb7 Bg2 Be7 O-O O-O b3"},
+	"rn1qk2r/pbppbppp/1p2pn2/8/2PP4/5NP1/PP2PPBP/RNBQ1RK1 b kq -":          {ECO: "E17", Name: "Queen's Indian Defense: Traditional Variation, 6.O-O", Moves: "d4 Nf6 c4 e6 Nf3 b6 g3 Bb7 Bg2 Be7 O-O"},
+	"rn1q1rk1/pbppbppp/1p2pn2/8/2PP4/2N2NP1/PP2PPBP/R1BQ1RK1 b - -":        {ECO: "E18", Name: "Queen's Indian Defense: Traditional Variation, Main Line, 7.Nc3", Moves: "d4 Nf6 c4 e6 Nf3 b6 g3 Bb7 Bg2 Be7 O-O O-O Nc3"},
+	"rn1q1rk1/pbppbppp/1p2p3/8/2PPn3/2N2NP1/PP2PPBP/R1BQ1RK1 w - -":        {ECO: "E18", Name: "Queen's Indian Defense: Traditional Variation, Main Line", Moves: "d4 Nf6 c4 e6 Nf3 b6 g3 Bb7 Bg2 Be7 O-O O-O Nc3 Ne4"},
+	"rn1q1rk1/pbppbppp/1p2p3/8/2PP4/2Q2NP1/PP2PPBP/R1B2RK1 b - -":          {ECO: "E19", Name: "Queen's Indian Defense: Traditional Variation, Main Line, 9.Qxc3", Moves: "d4 Nf6 c4 e6 Nf3 b6 g3 Bb7 Bg2 Be7 O-O O-O Nc3 Ne4 Qc2 Nxc3 Qxc3"},
+	"rnbqk2r/pppp1ppp/4pn2/8/1bPP4/2N5/PP2PPPP/R1BQKBNR w KQkq -":          {ECO: "E20", Name: "Nimzo-Indian Defense", Moves: "d4 Nf6 c4 e6 Nc3 Bb4"},
+	"rnbqk2r/pppp1ppp/4pn2/8/1bPP4/2N2P2/PP2P1PP/R1BQKBNR b KQkq -":        {ECO: "E20", Name: "Nimzo-Indian Defense: Kmoch Variation", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 f3"},
+	"rnbqk2r/pppp1ppp/4pn2/8/1bPP4/2NQ4/PP2PPPP/R1B1KBNR b KQkq -":         {ECO: "E20", Name: "Nimzo-Indian Defense: Mikenas Attack", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 Qd3"},
+	"rnbqk2r/pppp1ppp/4pn2/8/1bPP4/2N3P1/PP2PP1P/R1BQKBNR b KQkq -":        {ECO: "E20", Name: "Nimzo-Indian Defense: Romanishin Variation", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 g3"},
+	"rnbqk2r/pppp1ppp/4pn2/8/1bPP4/2N2N2/PP2PPPP/R1BQKB1R b KQkq -":        {ECO: "E21", Name: "Nimzo-Indian Defense: Three Knights Variation", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 Nf3"},
+	"rnbqk2r/pp1p1ppp/4pn2/2pP4/1bP5/2N2N2/PP2PPPP/R1BQKB1R b KQkq -":      {ECO: "E21", Name: "Nimzo-Indian Defense: Three Knights Variation, Korchnoi Variation", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 Nf3 c5 d5"},
+	"rnbqk2r/pp1p1ppp/4p3/2pP4/1bP1n3/2N2N2/PP2PPPP/R1BQKB1R w KQkq -":     {ECO: "E21", Name: "Nimzo-Indian Defense: Three Knights Variation, Euwe Variation", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 Nf3 c5 d5 Ne4"},
+	"rnbqk2r/pppp1ppp/4pn2/8/1bPP4/1QN5/PP2PPPP/R1B1KBNR b KQkq -":         {ECO: "E22", Name: "Nimzo-Indian Defense: Spielmann Variation", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 Qb3"},
+	"rnbqk2r/pp1p1ppp/4pn2/2p5/1bPP4/1QN5/PP2PPPP/R1B1KBNR w KQkq -":       {ECO: "E23", Name: "Nimzo-Indian Defense: Spielmann Variation, 4...c5", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 Qb3 c5"},
+	"r1bqk2r/pp1p1ppp/2n1p3/2P5/1bP5/1QN2N2/PP1nPPPP/R3KB1R w KQkq -":      {ECO: "E23", Name: "Nimzo-Indian Defense: Spielmann Variation, Karlsbad Variation", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 Qb3 c5 dxc5 Nc6 Nf3 Ne4 Bd2 Nxd2"},
+	"r1bqk2r/pp1p2pp/2n1p3/2n2p2/1bP5/2N2NP1/PPQBPP1P/R3KB1R b KQkq -":     {ECO: "E23", Name: "Nimzo-Indian Defense: Spielmann Variation, San Remo Variation", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 Qb3 c5 dxc5 Nc6 Nf3 Ne4 Bd2 Nxc5 Qc2 f5 g3"},
+	"rnbqk2r/pppp1ppp/4pn2/8/2PP4/P1P5/4PPPP/R1BQKBNR b KQkq -":            {ECO: "E24", Name: "Nimzo-Indian Defense: Sämisch Variation", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 a3 Bxc3+ bxc3"},
+	"rnbqk2r/pppp1ppp/4pn2/8/1bPP4/P1N5/1P2PPPP/R1BQKBNR b KQkq -":         {ECO: "E24", Name: "Nimzo-Indian Defense: Sämisch Variation, Accelerated", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 a3"},
+	"rnbq1rk1/pp3ppp/4p3/2pn4/3P4/P1P1PP2/6PP/R1BQKBNR w KQ -":             {ECO: "E24", Name: "Nimzo-Indian Defense: Sämisch Variation, Botvinnik Variation", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 a3 Bxc3+ bxc3 c5 f3 d5 e3 O-O cxd5 Nxd5"},
+	"rnbqk2r/pp3ppp/4pn2/2pP4/3P4/P1P2P2/4P1PP/R1BQKBNR b KQkq -":          {ECO: "E25", Name: "Nimzo-Indian Defense: Sämisch Variation, Keres Variation", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 f3 d5 a3 Bxc3+ bxc3 c5 cxd5"},
+	"rnbqk2r/pp4pp/4p3/2Pn1p2/8/P1P2P2/4P1PP/R1BQKBNR w KQkq -":            {ECO: "E25", Name: "Nimzo-Indian Defense: Sämisch Variation, Romanovsky Variation", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 f3 d5 a3 Bxc3+ bxc3 c5 cxd5 Nxd5 dxc5 f5"},
+	"rnbqk2r/pp1p1ppp/4pn2/2p5/2PP4/P1P1P3/5PPP/R1BQKBNR b KQkq -":         {ECO: "E26", Name: "Nimzo-Indian Defense: Sämisch Variation, 5...c5 6.e3", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 a3 Bxc3+ bxc3 c5 e3"},
+	"rnbqk2r/p2p1ppp/1p2pn2/2p5/2PP4/P1P1P3/5PPP/R1BQKBNR w KQkq -":        {ECO: "E26", Name: "Nimzo-Indian Defense: Sämisch Variation, O'Kelly Variation", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 a3 Bxc3+ bxc3 c5 e3 b6"},
+	"rnbq1rk1/pppp1ppp/4pn2/8/2PP4/P1P5/4PPPP/R1BQKBNR w KQ -":             {ECO: "E27", Name: "Nimzo-Indian Defense: Sämisch Variation, 5...O-O", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 a3 Bxc3+ bxc3 O-O"},
+	"rnbq1rk1/pppp1ppp/4pn2/8/2PP4/P1P1P3/5PPP/R1BQKBNR b KQ -":            {ECO: "E28", Name: "Nimzo-Indian Defense: Sämisch Variation, 5...O-O 6.e3", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 a3 Bxc3+ bxc3 O-O e3"},
+	"r1bq1rk1/pp1p1ppp/2n1pn2/2p5/2PP4/P1PBP3/5PPP/R1BQK1NR w KQ -":        {ECO: "E29", Name: "Nimzo-Indian Defense: Sämisch Variation, Main Line", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 a3 Bxc3+ bxc3 O-O e3 c5 Bd3 Nc6"},
+	"r1bqnrk1/p2p1ppp/1pn1p3/2p5/2PPP3/P1PB4/4NPPP/R1BQK2R w KQ -":         {ECO: "E29", Name: "Nimzo-Indian Defense: Sämisch Variation, Capablanca Variation", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 a3 Bxc3+ bxc3 O-O e3 c5 Bd3 Nc6 Ne2 b6 e4 Ne8"},
+	"rnbqk2r/pppp1ppp/4pn2/6B1/1bPP4/2N5/PP2PPPP/R2QKBNR b KQkq -":         {ECO: "E30", Name: "Nimzo-Indian Defense: Leningrad Variation", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 Bg5"},
+	"rnbqk2r/pppp1pp1/4pn1p/8/1bPP3B/2N5/PP2PPPP/R2QKBNR b KQkq -":         {ECO: "E30", Name: "Nimzo-Indian Defense: Leningrad Variation, 4...h6", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 Bg5 h6 Bh4"},
+	"rnbqk2r/p2p1pp1/4pn1p/1ppP4/1bP4B/2N5/PP2PPPP/R2QKBNR w KQkq -":       {ECO: "E30", Name: "Nimzo-Indian Defense: Leningrad Variation, Averbakh Gambit", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 Bg5 h6 Bh4 c5 d5 b5"},
+	"rnbqk2r/pp3pp1/3ppn1p/2pP4/1bP4B/2N5/PP2PPPP/R2QKBNR w KQkq -":        {ECO: "E31", Name: "Nimzo-Indian Defense: Leningrad Variation, Main Line", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 Bg5 h6 Bh4 c5 d5 d6"},
+	"rnbqk2r/pppp1ppp/4pn2/8/1bPP4/2N5/PPQ1PPPP/R1B1KBNR b KQkq -":         {ECO: "E32", Name: "Nimzo-Indian Defense: Classical Variation", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 Qc2"},
+	"rnbq1rk1/p1pp1ppp/1p2pn2/8/2PP4/P1Q5/1P2PPPP/R1B1KBNR w KQ -":         {ECO: "E32", Name: "Nimzo-Indian Defense: Classical Variation, Keres Defense", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 Qc2 O-O a3 Bxc3+ Qxc3 b6"},
+	"rnbq1rk1/p1pp1ppp/4pn2/1p6/2PP4/P1Q5/1P2PPPP/R1B1KBNR w KQ -":         {ECO: "E32", Name: "Nimzo-Indian Defense: Classical Variation, Vitolins-Adorjan Gambit", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 Qc2 O-O a3 Bxc3+ Qxc3 b5"},
+	"rnbq1rk1/pppp1ppp/4pn2/8/1bPP4/2N5/PPQ1PPPP/R1B1KBNR w KQ -":          {ECO: "E32", Name: "Nimzo-Indian Defense: Classical Variation, 4...O-O", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 Qc2 O-O"},
+	"r1bqk2r/pppp1ppp/2n1pn2/8/1bPP4/2N5/PPQ1PPPP/R1B1KBNR w KQkq -":       {ECO: "E33", Name: "Nimzo-Indian Defense: Classical Variation, Milner-Barry Variation", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 Qc2 Nc6"},
+	"r1bqk2r/ppp2ppp/2nppn2/8/1bPP4/2N2N2/PPQ1PPPP/R1B1KB1R w KQkq -":      {ECO: "E33", Name: "Nimzo-Indian Defense: Classical Variation, Zurich Variation", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 Qc2 Nc6 Nf3 d6"},
+	"rnbqk2r/ppp2ppp/4pn2/3p4/1bPP4/2N5/PPQ1PPPP/R1B1KBNR w KQkq -":        {ECO: "E34", Name: "Nimzo-Indian Defense: Classical Variation, Noa Variation", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 Qc2 d5"},
+	"rnbqk2r/ppp2ppp/5n2/3p4/1b1P4/2N5/PPQ1PPPP/R1B1KBNR w KQkq -":         {ECO: "E35", Name: "Nimzo-Indian Defense: Classical Variation, Noa Variation, 5.cxd5 exd5", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 Qc2 d5 cxd5 exd5"},
+	"rnbqk2r/ppp2ppp/4pn2/3p4/1bPP4/P1N5/1PQ1PPPP/R1B1KBNR b KQkq -":       {ECO: "E36", Name: "Nimzo-Indian Defense: Classical Variation, Noa Variation, 5.a3", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 Qc2 d5 a3"},
+	"rnbqk2r/ppp2ppp/4pn2/3p4/2PP4/P1Q5/1P2PPPP/R1B1KBNR b KQkq -":         {ECO: "E36", Name: "Nimzo-Indian Defense: Classical Variation, Noa Variation, Main Line", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 Qc2 d5 a3 Bxc3+ Qxc3"},
+	"rnbqk2r/ppp2ppp/4p3/3p4/2PPn3/P7/1PQ1PPPP/R1B1KBNR b KQkq -":          {ECO: "E37", Name: "Nimzo-Indian Defense: Classical Variation, Noa Variation, 7.Qc2", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 Qc2 d5 a3 Bxc3+ Qxc3 Ne4 Qc2"},
+	"r1bqk2r/ppp2ppp/2n5/3pp3/2PPn3/P3P3/1PQ2PPP/R1B1KBNR w KQkq -":        {ECO: "E37", Name: "Nimzo-Indian Defense: Classical Variation, San Remo Variation", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 Qc2 d5 a3 Bxc3+ Qxc3 Ne4 Qc2 Nc6 e3 e5"},
+	"rnbqk2r/pp1p1ppp/4pn2/2p5/1bPP4/2N5/PPQ1PPPP/R1B1KBNR w KQkq -":       {ECO: "E38", Name: "Nimzo-Indian Defense: Classical Variation, 4...c5", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 Qc2 c5"},
+	"rnbq1rk1/pp1p1ppp/4pn2/2P5/1bP5/2N5/PPQ1PPPP/R1B1KBNR w KQ -":         {ECO: "E39", Name: "Nimzo-Indian Defense: Classical Variation, Pirc Variation", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 Qc2 c5 dxc5 O-O"},
+	"rnbqk2r/pppp1ppp/4pn2/8/1bPP4/2N1P3/PP3PPP/R1BQKBNR b KQkq -":         {ECO: "E40", Name: "Nimzo-Indian Defense: Normal Variation", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 e3"},
+	"r1bqk2r/pppp1ppp/2n1pn2/8/1bPP4/2N1P3/PP3PPP/R1BQKBNR w KQkq -":       {ECO: "E40", Name: "Nimzo-Indian Defense: Normal Variation, Taimanov Variation", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 e3 Nc6"},
+	"rn1qk2r/pbpp1ppp/1p2pn2/8/1bPP4/2NBPN2/PP3PPP/R1BQK2R b KQkq -":       {ECO: "E40", Name: "Nimzo-Indian Defense: Normal Variation, Bishop Attack, Classical Fianchetto", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 e3 b6 Bd3 Bb7 Nf3"},
+	"r1bqk2r/pp3ppp/2nppn2/2p5/2PP4/2PBPN2/P4PPP/R1BQK2R w KQkq -":         {ECO: "E41", Name: "Nimzo-Indian Defense: Hübner Variation", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 e3 c5 Bd3 Nc6 Nf3 Bxc3+ bxc3 d6"},
+	"rnbqk2r/pp1p1ppp/4pn2/2p5/1bPP4/2N1P3/PP3PPP/R1BQKBNR w KQkq -":       {ECO: "E41", Name: "Nimzo-Indian Defense: Hübner Variation, 4...c5", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 e3 c5"},
+	"r1bqk2r/pp1p1ppp/2n1pn2/2p5/1bPP4/2NBP3/PP3PPP/R1BQK1NR w KQkq -":     {ECO: "E41", Name: "Nimzo-Indian Defense: e3, Huebner, Main Line", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 e3 c5 Bd3 Nc6"},
+	"rnbqk2r/pp1p1ppp/4pn2/2p5/1bPP4/2N1P3/PP2NPPP/R1BQKB1R b KQkq -":      {ECO: "E42", Name: "Nimzo-Indian Defense: Hübner Variation, Rubinstein Variation", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 e3 c5 Ne2"},
+	"rnbq1rk1/pp1pbppp/4pn2/3P4/2P5/P1N5/1P2NPPP/R1BQKB1R b KQ -":          {ECO: "E42", Name: "Nimzo-Indian Defense: Hübner Variation, Rubinstein Variation, Main Line", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 e3 c5 Ne2 cxd4 exd4 O-O a3 Be7 d5"},
+	"rnbqk2r/p1pp1ppp/1p2pn2/8/1bPP4/2N1P3/PP3PPP/R1BQKBNR w KQkq -":       {ECO: "E43", Name: "Nimzo-Indian Defense: St. Petersburg Variation", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 e3 b6"},
+	"rn1q1rk1/pbpp1ppp/1p2pn2/8/1bPP4/2NBPN2/PP3PPP/R1BQK2R w KQ -":        {ECO: "E43", Name: "Nimzo-Indian Defense: St. Petersburg Variation, Bishop Attack", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 e3 b6 Bd3 Bb7 Nf3 O-O"},
+	"rnbqk2r/p1pp1ppp/1p2pn2/8/1bPP4/2N1P3/PP2NPPP/R1BQKB1R b KQkq -":      {ECO: "E44", Name: "Nimzo-Indian Defense: Fischer Variation, 5.Ne2", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 e3 b6 Ne2"},
+	"rn1qk2r/p1pp1ppp/bp2pn2/8/1bPP4/2N1P3/PP2NPPP/R1BQKB1R w KQkq -":      {ECO: "E45", Name: "Nimzo-Indian Defense: Normal Variation, Bronstein (Byrne) Variation", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 e3 b6 Ne2 Ba6"},
+	"rnbq1rk1/pppp1ppp/4pn2/8/1bPP4/2N1P3/PP3PPP/R1BQKBNR w KQ -":          {ECO: "E46", Name: "Nimzo-Indian Defense: Normal Variation", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 e3 O-O"},
+	"rnbq1rk1/pppp1ppp/4pn2/8/1bPP4/2N1P3/PP2NPPP/R1BQKB1R b KQ -":         {ECO: "E46", Name: "Nimzo-Indian Defense: Reshevsky Variation", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 e3 O-O Ne2"},
+	"rnbq1rk1/ppp2ppp/3bpn2/3p4/2PP4/P1N1P3/1P2NPPP/R1BQKB1R w KQ -":       {ECO: "E46", Name: "Nimzo-Indian Defense: Simagin Variation", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 e3 O-O Ne2 d5 a3 Bd6"},
+	"rnbq1rk1/pppp1ppp/4pn2/8/1bPP4/2NBP3/PP3PPP/R1BQK1NR b KQ -":          {ECO: "E47", Name: "Nimzo-Indian Defense: Normal Variation, Bishop Attack", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 e3 O-O Bd3"},
+	"rnbq1rk1/ppp2ppp/4pn2/3p4/1bPP4/2NBP3/PP3PPP/R1BQK1NR w KQ -":         {ECO: "E48", Name: "Nimzo-Indian Defense: Normal Variation", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 e3 O-O Bd3 d5"},
+	"rnbq1rk1/ppp2ppp/4pn2/3p4/1bPP4/2NBP3/PP2NPPP/R1BQK2R b KQ -":         {ECO: "E48", Name: "Nimzo-Indian Defense: Normal Variation, Ragozin Variation", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 e3 O-O Bd3 d5 Ne2"},
+	"rnbq1rk1/ppp2ppp/4pn2/3p4/2PP4/P1PBP3/5PPP/R1BQK1NR b KQ -":           {ECO: "E49", Name: "Nimzo-Indian Defense: Normal Variation, Botvinnik System", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 e3 O-O Bd3 d5 a3 Bxc3+ bxc3"},
+	"rnbq1rk1/pppp1ppp/4pn2/8/1bPP4/2N1PN2/PP3PPP/R1BQKB1R b KQ -":         {ECO: "E50", Name: "Nimzo-Indian Defense: Normal Variation, 5.Nf3", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 e3 O-O Nf3"},
+	"rnbq1rk1/ppp2ppp/4pn2/3p4/1bPP4/2N1PN2/PP3PPP/R1BQKB1R w KQ -":        {ECO: "E51", Name: "Nimzo-Indian Defense: Normal Variation, Ragozin Variation, 5.Nf3 d5", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 e3 O-O Nf3 d5"},
+	"rnbq1rk1/ppp2ppp/4pn2/3p4/1bPP4/P1N1PN2/1P3PPP/R1BQKB1R b KQ -":       {ECO: "E51", Name: "Nimzo-Indian Defense: Normal Variation, Sämisch Deferred", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 e3 O-O Nf3 d5 a3"},
+	"rnbq1rk1/p1p2ppp/1p2pn2/3p4/1bPP4/2NBPN2/PP3PPP/R1BQK2R w KQ -":       {ECO: "E52", Name: "Nimzo-Indian Defense: Normal Variation, Schlechter Defense", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 e3 O-O Nf3 d5 Bd3 b6"},
+	"rnbq1rk1/pp3ppp/4pn2/2pp4/1bPP4/2NBPN2/PP3PPP/R1BQK2R w KQ -":         {ECO: "E53", Name: "Nimzo-Indian Defense: Normal Variation, Gligorić System", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 e3 O-O Nf3 d5 Bd3 c5"},
+	"rnbq1rk1/p4ppp/1p2pn2/2pp4/1bPP4/2NBPN2/PP3PPP/R1BQ1RK1 w - -":        {ECO: "E53", Name: "Nimzo-Indian Defense: Normal Variation, Gligorić System, Keres Variation", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 e3 O-O Nf3 d5 Bd3 c5 O-O b6"},
+	"rnbq1rk1/pp3ppp/4pn2/2p5/1bBP4/2N1PN2/PP3PPP/R1BQ1RK1 b - -":          {ECO: "E54", Name: "Nimzo-Indian Defense: Normal Variation, Gligorić System, Exchange at c4", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 e3 O-O Nf3 d5 Bd3 c5 O-O dxc4 Bxc4"},
+	"rnb2rk1/pp2qppp/4pn2/2p5/1bBP4/2N1PN2/PP3PPP/R1BQ1RK1 w - -":          {ECO: "E54", Name: "Nimzo-Indian Defense: Normal Variation, Gligorić System, Smyslov Variation", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 e3 O-O Nf3 d5 Bd3 c5 O-O dxc4 Bxc4 Qe7"},
+	"r1bq1rk1/pp1n1ppp/4pn2/2p5/1bBP4/2N1PN2/PP3PPP/R1BQ1RK1 w - -":        {ECO: "E55", Name: "Nimzo-Indian Defense: Normal Variation, Gligorić System, Bronstein Variation", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 e3 O-O Nf3 d5 Bd3 c5 O-O dxc4 Bxc4 Nbd7"},
+	"r1bq1rk1/pp3ppp/2n1pn2/2pp4/1bPP4/2NBPN2/PP3PPP/R1BQ1RK1 w - -":       {ECO: "E56", Name: "Nimzo-Indian Defense: Normal Variation, Gligorić System, 7...Nc6", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 e3 O-O Nf3 d5 Bd3 c5 O-O Nc6"},
+	"r1bq1rk1/pp3ppp/2n1pn2/8/1bBP4/P1N2N2/1P3PPP/R1BQ1RK1 b - -":          {ECO: "E57", Name: "Nimzo-Indian Defense: Normal Variation, Gligorić System, 8...dxc4 and 9...cxd4", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 e3 O-O Nf3 d5 Bd3 c5 O-O Nc6 a3 dxc4 Bxc4 cxd4 exd4"},
+	"r1bq1rk1/pp3ppp/2n1pn2/2pp4/2PP4/P1PBPN2/5PPP/R1BQ1RK1 b - -":         {ECO: "E58", Name: "Nimzo-Indian Defense: Normal Variation, Gligorić System, 8...Bxc3", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 e3 O-O Nf3 d5 Bd3 c5 O-O Nc6 a3 Bxc3 bxc3"},
+	"r1b2rk1/ppq2ppp/2n1pn2/2p5/2BP4/P1P1PN2/5PPP/R1BQ1RK1 w - -":          {ECO: "E59", Name: "Nimzo-Indian Defense: Normal Variation, Gligorić System, Main Line", Moves: "d4 Nf6 c4 e6 Nc3 Bb4 e3 O-O Nf3 d5 Bd3 c5 O-O Nc6 a3 Bxc3 bxc3 dxc4 Bxc4 Qc7"},
+	"rnbqkb1r/pppppp1p/5np1/8/2PP4/8/PP2PPPP/RNBQKBNR w KQkq -":            {ECO: "E60", Name: "King's Indian Defense", Moves: "d4 Nf6 c4 g6"},
+	"rnbqkb1r/pppppp1p/5np1/8/2PP4/6P1/PP2PP1P/RNBQKBNR b KQkq -":          {ECO: "E60", Name: "King's Indian Defense: Fianchetto Variation, Immediate Fianchetto", Moves: "d4 Nf6 c4 g6 g3"},
+	"rnbqkb1r/pppppp1p/5np1/8/2PP4/5N2/PP2PPPP/RNBQKB1R b KQkq -":          {ECO: "E60", Name: "King's Indian Defense: 3.Nf3", Moves: "d4 Nf6 c4 g6 Nf3"},
+	"rnbqk2r/ppppppbp/5np1/8/2PP4/2N2N2/PP2PPPP/R1BQKB1R b KQkq -":         {ECO: "E60", Name: "King's Indian Defense: Normal Variation, King's Knight Variation", Moves: "d4 Nf6 c4 g6 Nf3 Bg7 Nc3"},
+	"rnbqkb1r/pppppp1p/5np1/8/2PP4/5P2/PP2P1PP/RNBQKBNR b KQkq -":          {ECO: "E60", Name: "Indian Defense: Anti-Grünfeld, Alekhine Variation", Moves: "d4 Nf6 c4 g6 f3"},
+	"rnbqk2r/ppppppbp/5np1/8/2PP4/2N5/PP2PPPP/R1BQKBNR w KQkq -":           {ECO: "E61", Name: "King's Indian Defense", Moves: "d4 Nf6 c4 g6 Nc3 Bg7"},
+	"rnbqk2r/ppp1ppbp/3p1np1/6B1/2PP4/2N2N2/PP2PPPP/R2QKB1R b KQkq -":      {ECO: "E61", Name: "King's Indian Defense: Smyslov Variation", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 Nf3 d6 Bg5"},
+	"rnbqk2r/ppp1ppbp/3p1np1/8/2PP4/2N1PN2/PP3PPP/R1BQKB1R b KQkq -":       {ECO: "E61", Name: "King's Indian Defense: Semi-Classical Variation", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 Nf3 d6 e3"},
+	"rnbqk2r/ppp1ppbp/3p1np1/8/2PP4/2N2NP1/PP2PP1P/R1BQKB1R b KQkq -":      {ECO: "E62", Name: "King's Indian Defense: Fianchetto Variation", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 Nf3 d6 g3"},
+	"rnb2rk1/pp2ppbp/2pp1np1/q7/2PP4/2N2NP1/PP2PPBP/R1BQ1RK1 w - -":        {ECO: "E62", Name: "King's Indian Defense: Fianchetto Variation, Kavalek Defense", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 Nf3 d6 g3 O-O Bg2 c6 O-O Qa5"},
+	"rn1q1rk1/pp2ppbp/2pp1np1/5b2/2PP4/2N2NP1/PP2PPBP/R1BQ1RK1 w - -":      {ECO: "E62", Name: "King's Indian Defense: Fianchetto Variation, Larsen Defense", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 Nf3 d6 g3 O-O Bg2 c6 O-O Bf5"},
+	"r1bq1rk1/ppp2pbp/2np1np1/4p3/2PP4/2N2NP1/PP2PPBP/R1BQ1RK1 w - -":      {ECO: "E62", Name: "King's Indian Defense: Fianchetto Variation, Uhlmann-Szabo System", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 Nf3 d6 g3 O-O Bg2 Nc6 O-O e5"},
+	"r2q1rk1/ppp1ppbp/2np1np1/5b2/2PP4/2N2NP1/PP2PPBP/R1BQ1RK1 w - -":      {ECO: "E62", Name: "King's Indian Defense: Fianchetto Variation, Lesser Simagin", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 Nf3 d6 g3 O-O Bg2 Nc6 O-O Bf5"},
+	"r1bq1rk1/1pp1ppbp/p1np1np1/8/2PP4/2N2NP1/PP2PPBP/R1BQ1RK1 w - -":      {ECO: "E63", Name: "King's Indian Defense: Fianchetto Variation, Panno Variation", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 Nf3 d6 g3 O-O Bg2 Nc6 O-O a6"},
+	"rnbq1rk1/pp2ppbp/3p1np1/2p5/2PP4/2N2NP1/PP2PPBP/R1BQK2R w KQ -":       {ECO: "E64", Name: "King's Indian Defense: Fianchetto Variation, Yugoslav System", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 Nf3 d6 g3 O-O Bg2 c5"},
+	"r1bq1rk1/pp2ppbp/2n2np1/2p5/2P5/2N2NP1/PP2PPBP/R1BQ1RK1 w - -":        {ECO: "E65", Name: "King's Indian Defense: Fianchetto Variation, Yugoslav System, Exchange Line", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 Nf3 d6 g3 O-O Bg2 c5 O-O Nc6 dxc5 dxc5"},
+	"r1bq1rk1/pp2ppbp/2np1np1/2p5/2PP4/2N2NP1/PP2PPBP/R1BQ1RK1 w - -":      {ECO: "E65", Name: "King's Indian Defense: Fianchetto Variation, Yugoslav System, 7.O-O", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 Nf3 d6 g3 O-O Bg2 c5 O-O Nc6"},
+	"r1bq1rk1/pp2ppbp/2np1np1/2pP4/2P5/2N2NP1/PP2PPBP/R1BQ1RK1 b - -":      {ECO: "E66", Name: "King's Indian Defense: Fianchetto Variation, Yugoslav System, Advance Line", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 Nf3 d6 g3 O-O Bg2 c5 O-O Nc6 d5"},
+	"r1bq1rk1/pppnppbp/3p1np1/8/2PP4/2N2NP1/PP2PPBP/R1BQK2R w KQ -":        {ECO: "E67", Name: "King's Indian Defense: Fianchetto Variation, Classical Fianchetto", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 Nf3 d6 g3 O-O Bg2 Nbd7"},
+	"r1bq1rk1/pppn1pbp/3Q1np1/4p3/2P5/2N2NP1/PP2PPBP/R1B2RK1 b - -":        {ECO: "E67", Name: "King's Indian Defense: Fianchetto Variation, Hollis Variation", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 Nf3 d6 g3 O-O Bg2 Nbd7 O-O e5 dxe5 dxe5 Qd6"},
+	"r1bq1rk1/pppn1pbp/3p1np1/4p3/2PPP3/2N2NP1/PP3PBP/R1BQ1RK1 b - -":      {ECO: "E68", Name: "King's Indian Defense: Fianchetto Variation, Classical Variation, 8.e4", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 Nf3 d6 g3 O-O Bg2 Nbd7 O-O e5 e4"},
+	"r1bq1rk1/pp1n1pbp/2pp1np1/4p3/2PPP3/2N2NPP/PP3PB1/R1BQ1RK1 b - -":     {ECO: "E69", Name: "King's Indian Defense: Fianchetto Variation, Classical Main Line", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 Nf3 d6 g3 O-O Bg2 Nbd7 O-O e5 e4 c6 h3"},
+	"rnbqk2r/ppppppbp/5np1/8/2PPP3/2N5/PP3PPP/R1BQKBNR b KQkq -":           {ECO: "E70", Name: "King's Indian Defense: Normal Variation", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4"},
+	"rnbqk2r/ppp1ppbp/3p1np1/8/2PPP3/2N5/PP2NPPP/R1BQKB1R b KQkq -":        {ECO: "E70", Name: "King's Indian Defense: Kramer Variation", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 Nge2"},
+	"rnbqk2r/ppp1ppbp/3p1np1/6B1/2PPP3/2N5/PP3PPP/R2QKBNR b KQkq -":        {ECO: "E70", Name: "King's Indian Defense: Accelerated Averbakh Variation", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 Bg5"},
+	"rnbqk2r/ppp1ppbp/3p1np1/8/2PPP3/2N4P/PP3PP1/R1BQKBNR b KQkq -":        {ECO: "E71", Name: "King's Indian Defense: Makogonov Variation", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 h3"},
+	"rnbq1rk1/ppp2pbp/3p1np1/4p3/2PPP3/2N3P1/PP2NPBP/R1BQK2R b KQ -":       {ECO: "E72", Name: "King's Indian Defense: Pomar System", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 g3 O-O Bg2 e5 Nge2"},
+	"rnbqk2r/ppp1ppbp/3p1np1/8/2PPP3/2N3P1/PP3P1P/R1BQKBNR b KQkq -":       {ECO: "E72", Name: "King's Indian Defense: Normal Variation, Deferred Fianchetto", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 g3"},
+	"rnbq1rk1/ppp1ppbp/3p1np1/6B1/2PPP3/2N5/PP2BPPP/R2QK1NR b KQ -":        {ECO: "E73", Name: "King's Indian Defense: Averbakh Variation", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 Be2 O-O Bg5"},
+	"rnbq1rk1/ppp1ppbp/3p1np1/8/2PPP3/2N1B3/PP2BPPP/R2QK1NR b KQ -":        {ECO: "E73", Name: "King's Indian Defense: Semi-Averbakh System", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 Be2 O-O Be3"},
+	"rnbqk2r/ppp1ppbp/3p1np1/8/2PPP3/2N5/PP2BPPP/R1BQK1NR b KQkq -":        {ECO: "E73", Name: "King's Indian Defense: Normal Variation, 5.Be2", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 Be2"},
+	"rnbq1rk1/pp2ppbp/3p1np1/2p3B1/2PPP3/2N5/PP2BPPP/R2QK1NR w KQ -":       {ECO: "E74", Name: "King's Indian Defense: Averbakh Variation, Benoni Defense", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 Be2 O-O Bg5 c5"},
+	"rnbq1rk1/p3ppbp/3p1np1/1ppP2B1/2P1P3/2N5/PP2BPPP/R2QK1NR w KQ -":      {ECO: "E74", Name: "King's Indian Defense: Averbakh Variation, Modern Defense", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 Be2 O-O Bg5 c5 d5 b5"},
+	"rnbq1rk1/pp3pbp/3ppnp1/2pP2B1/2P1P3/2N5/PP2BPPP/R2QK1NR w KQ -":       {ECO: "E75", Name: "King's Indian Defense: Averbakh Variation, Main Line", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 Be2 O-O Bg5 c5 d5 e6"},
+	"rnbqk2r/ppp1ppbp/3p1np1/8/2PPPP2/2N5/PP4PP/R1BQKBNR b KQkq -":         {ECO: "E76", Name: "King's Indian Defense: Four Pawns Attack", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 f4"},
+	"rnbq1rk1/pp2ppbp/3p1np1/2pP4/2P1PP2/2N2N2/PP4PP/R1BQKB1R b KQ -":      {ECO: "E76", Name: "King's Indian Defense: Four Pawns Attack, Dynamic Attack", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 f4 O-O Nf3 c5 d5"},
+	"rnbq1rk1/pp2ppbp/3p1np1/2p5/2PPPP2/2N2N2/PP4PP/R1BQKB1R w KQ -":       {ECO: "E76", Name: "King's Indian Defense: Four Pawns Attack, Exchange Variation, 6...c5", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 f4 O-O Nf3 c5"},
+	"rnbq1rk1/ppp1ppbp/3p1np1/8/2PPPP2/2N5/PP2B1PP/R1BQK1NR b KQ -":        {ECO: "E77", Name: "King's Indian Defense: Four Pawns Attack, 6.Be2", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 f4 O-O Be2"},
+	"rnbq1rk1/pp3pbp/3p1np1/2ppP3/2P2P2/2N2N2/PP2B1PP/R1BQK2R b KQ -":      {ECO: "E77", Name: "King's Indian Defense: Four Pawns Attack, Florentine Gambit", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 f4 O-O Be2 c5 d5 e6 Nf3 exd5 e5"},
+	"rnbq1rk1/pp2ppbp/3p1np1/2p5/2PPPP2/2N2N2/PP2B1PP/R1BQK2R b KQ -":      {ECO: "E78", Name: "King's Indian Defense: Four Pawns Attack, with Be2 and Nf3", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 f4 O-O Be2 c5 Nf3"},
+	"r1bq1rk1/pp2ppbp/2np1np1/8/2PNPP2/2N1B3/PP2B1PP/R2QK2R b KQ -":        {ECO: "E79", Name: "King's Indian Defense: Four Pawns Attack, Exchange Variation", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 f4 O-O Be2 c5 Nf3 cxd4 Nxd4 Nc6 Be3"},
+	"rnbqk2r/ppp1ppbp/3p1np1/8/2PPP3/2N2P2/PP4PP/R1BQKBNR b KQkq -":        {ECO: "E80", Name: "King's Indian Defense: Sämisch Variation", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 f3"},
+	"rnbq1rk1/ppp1ppbp/3p1np1/8/2PPP3/2N2P2/PP2N1PP/R1BQKB1R b KQ -":       {ECO: "E80", Name: "King's Indian Defense: Sämisch Variation, Bobotsov-Korchnoi-Petrosian Variation", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 f3 O-O Nge2"},
+	"rnbq1rk1/ppp1ppbp/3p1np1/8/2PPP3/2N2P2/PP4PP/R1BQKBNR w KQ -":         {ECO: "E81", Name: "King's Indian Defense: Sämisch Variation, Normal Defense", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 f3 O-O"},
+	"rnbq1rk1/1p2ppbp/p1pp1np1/8/2PPP3/2NBBP2/PP4PP/R2QK1NR w KQ -":        {ECO: "E81", Name: "King's Indian Defense: Sämisch Variation, Byrne Defense", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 f3 O-O Be3 c6 Bd3 a6"},
+	"rnbq1rk1/ppp1ppbp/3p1np1/8/2PPP3/2N1BP2/PP4PP/R2QKBNR b KQ -":         {ECO: "E81", Name: "King's Indian Defense: Sämisch Variation, 6.Be3", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 f3 O-O Be3"},
+	"rnbq1rk1/p1p1ppbp/1p1p1np1/8/2PPP3/2N1BP2/PP4PP/R2QKBNR w KQ -":       {ECO: "E82", Name: "King's Indian Defense: Sämisch Variation, Double Fianchetto", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 f3 O-O Be3 b6"},
+	"r1bq1rk1/ppp1ppbp/2np1np1/8/2PPP3/2N1BP2/PP4PP/R2QKBNR w KQ -":        {ECO: "E83", Name: "King's Indian Defense: Sämisch Variation, Panno Formation", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 f3 O-O Be3 Nc6"},
+	"1rbq1rk1/ppp1ppbp/2np1np1/8/2PPP3/2N1BP2/PP2N1PP/R2QKB1R w KQ -":      {ECO: "E83", Name: "King's Indian Defense: Sämisch Variation, Ruban Variation", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 f3 O-O Be3 Nc6 Nge2 Rb8"},
+	"1rbq1rk1/1pp1ppbp/p1np1np1/8/2PPP3/2N1BP2/PP1QN1PP/R3KB1R w KQ -":     {ECO: "E84", Name: "King's Indian Defense: Sämisch Variation, Panno Main Line", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 f3 O-O Be3 Nc6 Nge2 a6 Qd2 Rb8"},
+	"rnbq1rk1/ppp2pbp/3p1np1/4p3/2PPP3/2N1BP2/PP4PP/R2QKBNR w KQ -":        {ECO: "E85", Name: "King's Indian Defense: Sämisch Variation, Orthodox Variation", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 f3 O-O Be3 e5"},
+	"rnbq1rk1/pp3pbp/2pp1np1/4p3/2PPP3/2N1BP2/PP2N1PP/R2QKB1R w KQ -":      {ECO: "E86", Name: "King's Indian Defense: Sämisch Variation, Orthodox Variation, 7.Nge2 c6", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 f3 O-O Be3 e5 Nge2 c6"},
+	"rnbq1rk1/ppp2pbp/3p1np1/3Pp3/2P1P3/2N1BP2/PP4PP/R2QKBNR b KQ -":       {ECO: "E87", Name: "King's Indian Defense: Sämisch Variation, Closed Variation", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 f3 O-O Be3 e5 d5"},
+	"rnb2rk1/ppp2pbp/3p2p1/3Pp3/2n1P2Q/2N2P2/PP2K2P/R5NR w - -":            {ECO: "E87", Name: "King's Indian Defense: Sämisch Variation, Bronstein Defense", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 f3 O-O Be3 e5 d5 Nh5 Qd2 Qh4+ g3 Nxg3 Qf2 Nxf1 Qxh4 Nxe3 Ke2 Nxc4"},
+	"rnbq1rk1/pp3pbp/2pp1np1/3Pp3/2P1P3/2N1BP2/PP4PP/R2QKBNR w KQ -":       {ECO: "E88", Name: "King's Indian Defense: Sämisch Variation, Closed Variation, 7...c6", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 f3 O-O Be3 e5 d5 c6"},
+	"rnbq1rk1/pp3pbp/3p1np1/3pp3/2P1P3/2N1BP2/PP2N1PP/R2QKB1R w KQ -":      {ECO: "E89", Name: "King's Indian Defense: Sämisch Variation, Closed Variation, Main Line", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 f3 O-O Be3 e5 d5 c6 Nge2 cxd5"},
+	"rnbqk2r/ppp1ppbp/3p1np1/8/2PPP3/2N2N2/PP3PPP/R1BQKB1R b KQkq -":       {ECO: "E90", Name: "King's Indian Defense: Normal Variation", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 Nf3"},
+	"rnbq1rk1/ppp1ppbp/3p1np1/8/2PPP3/2N1BN2/PP3PPP/R2QKB1R b KQ -":        {ECO: "E90", Name: "King's Indian Defense: Larsen Variation", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 Nf3 O-O Be3"},
+	"rnbq1rk1/ppp1ppbp/3p1np1/6B1/2PPP3/2N2N2/PP3PPP/R2QKB1R b KQ -":       {ECO: "E90", Name: "King's Indian Defense: Zinnowitz Variation", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 Nf3 O-O Bg5"},
+	"rnbq1rk1/ppp1ppbp/3p1np1/8/2PPP3/2N2N2/PP2BPPP/R1BQK2R b KQ -":        {ECO: "E91", Name: "King's Indian Defense: Orthodox Variation", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 Nf3 O-O Be2"},
+	"r1bq1rk1/ppp1ppbp/n2p1np1/8/2PPP3/2N2N2/PP2BPPP/R1BQK2R w KQ -":       {ECO: "E91", Name: "King's Indian Defense: Kazakh Variation", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 Nf3 O-O Be2 Na6"},
+	"rn1q1rk1/ppp1ppbp/3p1np1/8/2PPP1b1/2N2N2/PP2BPPP/R1BQK2R w KQ -":      {ECO: "E91", Name: "King's Indian Defense: Orthodox Variation, 6...Bg4", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 Nf3 O-O Be2 Bg4"},
+	"rnbq1rk1/ppp2pbp/3p1np1/3Pp3/2P1P3/2N2N2/PP2BPPP/R1BQK2R b KQ -":      {ECO: "E92", Name: "King's Indian Defense: Petrosian Variation", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 Nf3 O-O Be2 e5 d5"},
+	"rnbq1rk1/ppp2pbp/3p1np1/4p3/2PPP3/2N2N2/PP2BPPP/R1BQK2R w KQ -":       {ECO: "E92", Name: "King's Indian Defense: Orthodox Variation, 6...e5", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 Nf3 O-O Be2 e5"},
+	"rnbr2k1/ppp2pbp/5np1/4p3/2P1P3/2N2N2/PP2BPPP/R1B1K2R w KQ -":          {ECO: "E92", Name: "King's Indian Defense: Exchange Variation", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 Nf3 O-O Be2 e5 dxe5 dxe5 Qxd8 Rxd8"},
+	"rnbq1rk1/ppp2pbp/3p1np1/4p3/2PPP3/2N1BN2/PP2BPPP/R2QK2R b KQ -":       {ECO: "E92", Name: "King's Indian Defense: Gligorić-Taimanov System", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 Nf3 O-O Be2 e5 Be3"},
+	"rnbq1rk1/1pp2pbp/3p1np1/p2Pp3/2P1P3/2N2N2/PP2BPPP/R1BQK2R w KQ -":     {ECO: "E92", Name: "King's Indian Defense: Petrosian Variation, Stein Defense", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 Nf3 O-O Be2 e5 d5 a5"},
+	"r1bq1rk1/pppn1pbp/3p1np1/3Pp3/2P1P3/2N2N2/PP2BPPP/R1BQK2R w KQ -":     {ECO: "E93", Name: "King's Indian Defense: Petrosian Variation, Normal Defense", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 Nf3 O-O Be2 e5 d5 Nbd7"},
+	"r1bq1rk1/pppn1pb1/3p3p/3Pp1pn/2P1P2P/2N2NB1/PP2BPP1/R2QK2R b KQ -":    {ECO: "E93", Name: "King's Indian Defense: Petrosian Variation, Keres Defense", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 Nf3 O-O Be2 e5 d5 Nbd7 Bg5 h6 Bh4 g5 Bg3 Nh5 h4"},
+	"rnbq1rk1/ppp2pbp/3p1np1/4p3/2PPP3/2N2N2/PP2BPPP/R1BQ1RK1 b - -":       {ECO: "E94", Name: "King's Indian Defense: Orthodox Variation", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 Nf3 O-O Be2 e5 O-O"},
+	"rnbq1rk1/pp3pbp/2pp1np1/4p3/2PPP3/2N2N2/PP2BPPP/R1BQ1RK1 w - -":       {ECO: "E94", Name: "King's Indian Defense: Orthodox Variation, Donner Defense", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 Nf3 O-O Be2 e5 O-O c6"},
+	"r1bq1rk1/ppp2pbp/n2p1np1/4p3/2PPP3/2N2N2/PP2BPPP/R1BQ1RK1 w - -":      {ECO: "E94", Name: "King's Indian Defense: Orthodox Variation, Glek Defense", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 Nf3 O-O Be2 e5 O-O Na6"},
+	"r1bq1rk1/pppn1pbp/3p1np1/4p3/2PPP3/2N2N2/PP2BPPP/R1BQ1RK1 w - -":      {ECO: "E94", Name: "King's Indian Defense: Orthodox Variation, 7...Nbd7", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 Nf3 O-O Be2 e5 O-O Nbd7"},
+	"r1bq1rk1/pppn1pbp/3p1np1/4p3/2PPP3/2N2N2/PP2BPPP/R1BQR1K1 b - -":      {ECO: "E95", Name: "King's Indian Defense: Orthodox Variation, 7...Nbd7 8.Re1", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 Nf3 O-O Be2 e5 O-O Nbd7 Re1"},
+	"r1bq1rk1/1p1n1pbp/2pp1np1/p3p3/2PPP3/2N2N2/PP3PPP/R1BQRBK1 w - -":     {ECO: "E96", Name: "King's Indian Defense: Orthodox Variation, Positional Defense, Main Line", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 Nf3 O-O Be2 e5 O-O Nbd7 Re1 c6 Bf1 a5"},
+	"r1bq1rk1/ppp1npbp/3p1np1/3Pp3/2P1P3/2N2N2/PP2BPPP/R1BQ1RK1 w - -":     {ECO: "E97", Name: "King's Indian Defense: Orthodox Variation, Classical System", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 Nf3 O-O Be2 e5 O-O Nc6 d5 Ne7"},
+	"r1bq1rk1/ppp1npbp/3p1np1/3Pp3/1PP1P3/2N2N2/P3BPPP/R1BQ1RK1 b - -":     {ECO: "E97", Name: "King's Indian Defense: Orthodox Variation, Bayonet Attack", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 Nf3 O-O Be2 e5 O-O Nc6 d5 Ne7 b4"},
+	"r1bq1rk1/ppp2pbp/2np1np1/4p3/2PPP3/2N2N2/PP2BPPP/R1BQ1RK1 w - -":      {ECO: "E97", Name: "King's Indian Defense: Orthodox Variation, Aronin-Taimanov Defense", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 Nf3 O-O Be2 e5 O-O Nc6"},
+	"r1bq1rk1/ppp1npbp/3p1np1/3Pp3/2P1P3/2N2N2/PP1BBPPP/R2Q1RK1 b - -":     {ECO: "E97", Name: "King's Indian Defense: Orthodox Variation, Korchnoi Attack", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 Nf3 O-O Be2 e5 O-O Nc6 d5 Ne7 Bd2"},
+	"r1bq1rk1/ppp1npbp/3p1np1/3Pp3/2P1P3/2N5/PP2BPPP/R1BQNRK1 b - -":       {ECO: "E98", Name: "King's Indian Defense: Orthodox Variation, Classical System, 9.Ne1", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 Nf3 O-O Be2 e5 O-O Nc6 d5 Ne7 Ne1"},
+	"r1bq1rk1/ppp3bp/n2p2n1/3Pp1p1/1P2Pp2/2N2P2/P3BBPP/2RQNRK1 w - -":      {ECO: "E98", Name: "King's Indian Defense: Orthodox Variation, Classical System, Kozul Gambit", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 Nf3 O-O Be2 e5 O-O Nc6 d5 Ne7 Ne1 Nd7 Be3 f5 f3 f4 Bf2 g5 Rc1 Ng6 c5 Nxc5 b4 Na6"},
+	"r1bq1rk1/pppnnpbp/3p2p1/3Pp3/2P1P3/2N5/PP2BPPP/R1BQNRK1 w - -":        {ECO: "E99", Name: "King's Indian Defense: Orthodox Variation, Classical System, Main Line", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 Nf3 O-O Be2 e5 O-O Nc6 d5 Ne7 Ne1 Nd7"},
+	"r1bq1rk1/pppnn1bp/3p2p1/3Ppp2/2P1P1P1/2N2P2/PP2B2P/R1BQNRK1 b - -":    {ECO: "E99", Name: "King's Indian Defense: Orthodox Variation, Classical System, Benko Attack", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 Nf3 O-O Be2 e5 O-O Nc6 d5 Ne7 Ne1 Nd7 f3 f5 g4"},
+	"r1bq1rk1/pppnn1bp/3p2p1/3Ppp2/2P1P3/2N2P2/PP2B1PP/R1BQNRK1 w - -":     {ECO: "E99", Name: "King's Indian Defense: Orthodox Variation, Classical System, Traditional Line", Moves: "d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 Nf3 O-O Be2 e5 O-O Nc6 d5 Ne7 Ne1 Nd7 f3 f5"},
+}
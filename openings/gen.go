@@ -0,0 +1,141 @@
+//go:build ignore
+// +build ignore
+
+// gen generates eco.go from the opening dataset in data, which follows the format of the lichess chess-openings
+// tables: a header and then one tab separated ECO code, opening name and PGN move list per line.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/notnil/chess"
+)
+
+var (
+	ecoPattern  = regexp.MustCompile(`^[A-E]\d\d$`)
+	moveNumbers = regexp.MustCompile(`\d+\.(\.\.)?`)
+)
+
+func main() {
+	files, err := filepath.Glob(filepath.Join("data", "*.tsv"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	var lines []string
+	codes := map[string]bool{}
+	for _, file := range files {
+		parsed, err := parse(file, codes)
+		if err != nil {
+			log.Fatal(err)
+		}
+		lines = append(lines, parsed...)
+	}
+	for letter := 'A'; letter <= 'E'; letter++ {
+		for i := 0; i < 100; i++ {
+			if code := fmt.Sprintf("%c%02d", letter, i); !codes[code] {
+				log.Fatalf("no opening for %v", code)
+			}
+		}
+	}
+	entries, err := book(lines)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprint(&buf, "// Code generated by gen.go from data/*.tsv; DO NOT EDIT.\n\n")
+	fmt.Fprint(&buf, "package openings\n\n")
+	fmt.Fprint(&buf, "// book maps the key of every position in the opening database to the opening it is named after.\n")
+	fmt.Fprint(&buf, "// Names follow the \"Opening: Variation\" convention, and the last line reaching a position determines its name.\n")
+	fmt.Fprint(&buf, "var book = map[string]Opening{\n")
+	for _, entry := range entries {
+		fmt.Fprintf(&buf, "%q: {ECO: %q, Name: %q, Moves: %q},\n", entry.key, entry.eco, entry.name, entry.moves)
+	}
+	fmt.Fprint(&buf, "}\n")
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile("eco.go", source, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+type entry struct {
+	key, eco, name, moves string
+}
+
+// book plays every opening line, keeping the last line reaching each position, in the order of the lines kept
+func book(lines []string) ([]entry, error) {
+	last := map[string]int{}
+	entries := make([]entry, len(lines))
+	for i, line := range lines {
+		fields := strings.Split(line, "\t")
+		position := chess.NewGame().Position()
+		for _, san := range strings.Fields(fields[2]) {
+			move, err := chess.AlgebraicNotation{}.Decode(position, san)
+			if err != nil {
+				return nil, fmt.Errorf("%v in %v %v: %v", err, fields[0], fields[1], fields[2])
+			}
+			position = position.Update(move)
+		}
+		entries[i] = entry{key: positionKey(position), eco: fields[0], name: fields[1], moves: fields[2]}
+		last[entries[i].key] = i
+	}
+	kept := []entry{}
+	for i, entry := range entries {
+		if last[entry.key] == i {
+			kept = append(kept, entry)
+		}
+	}
+	return kept, nil
+}
+
+// positionKey is the same key as openings.PositionKey, which can't be imported while its book is being generated
+func positionKey(position *chess.Position) string {
+	fields := strings.Fields(position.String())
+	enPassant := "-"
+	for _, move := range position.ValidMoves() {
+		if move.HasTag(chess.EnPassant) {
+			enPassant = fields[3]
+			break
+		}
+	}
+	return strings.Join(append(fields[:3], enPassant), " ")
+}
+
+// parse reads the openings of a dataset file, recording the ECO codes seen
+func parse(file string, codes map[string]bool) ([]string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var lines []string
+	scanner := bufio.NewScanner(f)
+	for n := 1; scanner.Scan(); n++ {
+		if n == 1 {
+			continue
+		}
+		fields := strings.Split(scanner.Text(), "\t")
+		if len(fields) != 3 || !ecoPattern.MatchString(fields[0]) || fields[1] == "" {
+			return nil, fmt.Errorf("%v:%v: malformed opening %q", file, n, scanner.Text())
+		}
+		moves := strings.Fields(moveNumbers.ReplaceAllString(fields[2], ""))
+		if len(moves) == 0 {
+			return nil, fmt.Errorf("%v:%v: no moves for %v", file, n, fields[0])
+		}
+		codes[fields[0]] = true
+		lines = append(lines, strings.Join([]string{fields[0], fields[1], strings.Join(moves, " ")}, "\t"))
+	}
+	return lines, scanner.Err()
+}
@@ -0,0 +1,70 @@
+// Package openings classifies games by the ECO (Encyclopaedia of Chess Openings) code and name of their opening
+package openings
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/notnil/chess"
+)
+
+// Opening is a named opening line
+type Opening struct {
+	// ECO is the Encyclopaedia of Chess Openings code, such as B90
+	ECO  string
+	Name string
+	// Moves is the line reaching the opening in standard algebraic notation
+	Moves string
+}
+
+func (o Opening) String() string {
+	return fmt.Sprintf("%v %v", o.ECO, o.Name)
+}
+
+//go:generate go run gen.go
+
+// PositionKey identifies a position by its pieces, side to move, castling rights and en passant square.
+// Unlike the FEN (or the hash) of the position, the en passant square only counts when a pawn can capture en passant,
+// so transpositions reaching the same position with a different last move share their key.
+func PositionKey(position *chess.Position) string {
+	fields := strings.Fields(position.String())
+	enPassant := "-"
+	for _, move := range position.ValidMoves() {
+		if move.HasTag(chess.EnPassant) {
+			enPassant = fields[3]
+			break
+		}
+	}
+	return strings.Join(append(fields[:3], enPassant), " ")
+}
+
+// Lookup finds the opening named after a position, if it is in the opening database
+func Lookup(position *chess.Position) (Opening, bool) {
+	opening, ok := book[PositionKey(position)]
+	return opening, ok
+}
+
+// Classify finds the opening of a game by the positions it went through, in order.
+// The last position found in the opening database names the opening, so transpositions are recognized
+// and the opening keeps its name once the game leaves the known lines.
+func Classify(positions []*chess.Position) (Opening, bool) {
+	for i := len(positions) - 1; i >= 0; i-- {
+		if opening, ok := Lookup(positions[i]); ok {
+			return opening, true
+		}
+	}
+	return Opening{}, false
+}
+
+// ClassifyPGN finds the opening of a game in PGN format, preferring the ECO and Opening tags of the game when present
+func ClassifyPGN(pgn string) (Opening, bool) {
+	option, err := chess.PGN(strings.NewReader(pgn))
+	if err != nil {
+		return Opening{}, false
+	}
+	gm := chess.NewGame(option)
+	if eco, name := gm.GetTagPair("ECO"), gm.GetTagPair("Opening"); eco != nil && name != nil {
+		return Opening{ECO: eco.Value, Name: name.Value}, true
+	}
+	return Classify(gm.Positions())
+}
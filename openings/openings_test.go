@@ -0,0 +1,75 @@
+package openings_test
+
+import (
+	"testing"
+
+	"github.com/cjsaylor/chessbot/openings"
+	"github.com/notnil/chess"
+)
+
+func positionsAfter(t *testing.T, moves ...string) []*chess.Position {
+	gm := chess.NewGame(chess.UseNotation(chess.AlgebraicNotation{}))
+	for _, move := range moves {
+		if err := gm.MoveStr(move); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return gm.Positions()
+}
+
+func TestClassify(t *testing.T) {
+	for _, test := range []struct {
+		moves []string
+		eco   string
+		name  string
+	}{
+		{[]string{"e4", "c5"}, "B20", "Sicilian Defense"},
+		{[]string{"e4", "c5", "Nf3", "d6", "d4", "cxd4", "Nxd4", "Nf6", "Nc3", "a6"}, "B90", "Sicilian Defense: Najdorf Variation"},
+		// Out of the opening database, the game keeps the name of the last known position
+		{[]string{"e4", "c5", "Nf3", "d6", "d4", "cxd4", "Nxd4", "Nf6", "Nc3", "a6", "a3", "h6"}, "B90", "Sicilian Defense: Najdorf Variation"},
+		// Transposing from the English Opening into the Queen's Gambit Declined
+		{[]string{"c4", "e6", "Nc3", "d5", "d4"}, "D31", "Queen's Gambit Declined: Queen's Knight Variation"},
+		{[]string{"d4", "f5", "c4", "Nf6", "g3", "e6", "Bg2", "Be7", "Nf3", "O-O", "O-O", "d6", "Nc3", "Qe8", "b3"}, "A99", "Dutch Defense: Ilyin-Zhenevsky Variation, Modern Main Line"},
+		{[]string{"e4", "e5", "Nf3", "Nc6", "Bb5", "a6", "Ba4", "d6", "O-O"}, "C72", "Ruy Lopez: Morphy Defense, Modern Steinitz Defense, 5.O-O"},
+		{[]string{"d4", "Nf6", "c4", "g6", "g3", "d5", "Bg2", "Bg7", "Nf3", "O-O", "O-O", "c6", "cxd5", "cxd5"}, "D79", "Neo-Grünfeld Defense: Ultra-Delayed Exchange Variation"},
+	} {
+		opening, ok := openings.Classify(positionsAfter(t, test.moves...))
+		if !ok || opening.ECO != test.eco || opening.Name != test.name {
+			t.Errorf("expected %v %v after %v, got %v", test.eco, test.name, test.moves, opening)
+		}
+	}
+	if _, ok := openings.Classify(positionsAfter(t)); ok {
+		t.Error("expected the starting position not to be an opening")
+	}
+}
+
+func TestClassifyPGN(t *testing.T) {
+	if opening, ok := openings.ClassifyPGN("1. d4 Nf6 2. c4 e6 3. Nc3 Bb4 *"); !ok || opening.ECO != "E20" {
+		t.Errorf("expected the Nimzo-Indian Defense, got %v", opening)
+	}
+	tagged := "[ECO \"E32\"]\n[Opening \"Nimzo-Indian Defense: Classical Variation\"]\n\n1. d4 Nf6 2. c4 e6 3. Nc3 Bb4 *"
+	if opening, _ := openings.ClassifyPGN(tagged); opening.ECO != "E32" {
+		t.Errorf("expected the opening tags of the game to be kept, got %v", opening)
+	}
+}
+
+func TestRepertoire(t *testing.T) {
+	sicilian := openings.Opening{ECO: "B20", Name: "Sicilian Defense"}
+	french := openings.Opening{ECO: "C00", Name: "French Defense"}
+	repertoire := openings.NewRepertoire()
+	repertoire.Add(sicilian, chess.Black, chess.BlackWon)
+	repertoire.Add(sicilian, chess.Black, chess.Draw)
+	repertoire.Add(sicilian, chess.White, chess.BlackWon)
+	repertoire.Add(french, chess.Black, chess.WhiteWon)
+	repertoire.Add(sicilian, chess.Black, chess.WhiteWon)
+	records := repertoire.Records()
+	if len(records) != 3 {
+		t.Fatalf("expected openings to be tallied per color, got %v", records)
+	}
+	if first := records[0]; first.Opening != sicilian || first.Color != chess.Black || first.Games != 3 || first.Wins != 1 || first.Draws != 1 || first.Losses != 1 {
+		t.Errorf("unexpected record %+v", first)
+	}
+	if score := records[0].Score(); score != 0.5 {
+		t.Errorf("expected a score of 50%%, got %v", score)
+	}
+}
@@ -0,0 +1,74 @@
+package openings
+
+import (
+	"sort"
+
+	"github.com/notnil/chess"
+)
+
+// Record tallies the results of the games a player played with an opening as one color
+type Record struct {
+	Opening Opening
+	Color   chess.Color
+	Games   int
+	Wins    int
+	Draws   int
+	Losses  int
+}
+
+// Score is the share of points scored in the games, counting draws as half a point
+func (r Record) Score() float64 {
+	if r.Games == 0 {
+		return 0
+	}
+	return (float64(r.Wins) + float64(r.Draws)/2) / float64(r.Games)
+}
+
+// Repertoire tallies the openings played by a single player
+type Repertoire struct {
+	records map[string]*Record
+}
+
+// NewRepertoire creates an empty repertoire
+func NewRepertoire() *Repertoire {
+	return &Repertoire{
+		records: map[string]*Record{},
+	}
+}
+
+// Add counts a game the player played with an opening as a color
+func (r *Repertoire) Add(opening Opening, color chess.Color, outcome chess.Outcome) {
+	key := color.String() + opening.String()
+	record, ok := r.records[key]
+	if !ok {
+		record = &Record{
+			Opening: Opening{ECO: opening.ECO, Name: opening.Name},
+			Color:   color,
+		}
+		r.records[key] = record
+	}
+	record.Games++
+	switch {
+	case outcome == chess.Draw:
+		record.Draws++
+	case outcome == chess.WhiteWon && color == chess.White, outcome == chess.BlackWon && color == chess.Black:
+		record.Wins++
+	default:
+		record.Losses++
+	}
+}
+
+// Records lists the openings of the repertoire, the most played first
+func (r *Repertoire) Records() []Record {
+	records := make([]Record, 0, len(r.records))
+	for _, record := range r.records {
+		records = append(records, *record)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].Games != records[j].Games {
+			return records[i].Games > records[j].Games
+		}
+		return records[i].Opening.String() < records[j].Opening.String()
+	})
+	return records
+}
@@ -0,0 +1,97 @@
+package puzzles
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/cjsaylor/chessbot/game"
+	"github.com/notnil/chess"
+)
+
+// ErrAttemptFinished is returned when playing a move in an attempt that was already solved or failed
+var ErrAttemptFinished = errors.New("this puzzle is already finished")
+
+// ErrNoPuzzles is returned when there is no puzzle left to select for a user
+var ErrNoPuzzles = errors.New("there are no puzzles left to play")
+
+// Attempt is a user playing through a puzzle in a thread, with the opponent's replies played from the solution
+type Attempt struct {
+	TeamID    string
+	ChannelID string
+	ThreadID  string
+	UserID    string
+	PuzzleID  string
+	// Moves are the moves played so far by both sides, in long algebraic notation
+	Moves     []string
+	Solved    bool
+	Failed    bool
+	StartedAt time.Time
+}
+
+// Finished determines if the attempt was solved or failed
+func (a *Attempt) Finished() bool {
+	return a.Solved || a.Failed
+}
+
+// Play checks a move (in algebraic or long algebraic notation) of the solver against the solution.
+// A correct move is followed by the opponent's reply from the solution, until the solution is complete.
+// Any move that checkmates solves the puzzle, while any other move fails it.
+func (a *Attempt) Play(puzzle *Puzzle, answer string) (bool, error) {
+	if a.Finished() {
+		return false, ErrAttemptFinished
+	}
+	position, err := puzzle.Position()
+	if err != nil {
+		return false, err
+	}
+	if position, err = play(position, a.Moves); err != nil {
+		return false, err
+	}
+	move, err := game.DecodeMove(position, answer)
+	if err != nil {
+		return false, err
+	}
+	lan := chess.LongAlgebraicNotation{}.Encode(position, move)
+	checkmate := position.Update(move).Status() == chess.Checkmate
+	if !checkmate && (len(a.Moves) >= len(puzzle.Solution) || lan != puzzle.Solution[len(a.Moves)]) {
+		a.Failed = true
+		return false, nil
+	}
+	a.Moves = append(a.Moves, lan)
+	if checkmate || len(a.Moves) >= len(puzzle.Solution) {
+		a.Solved = true
+		return true, nil
+	}
+	a.Moves = append(a.Moves, puzzle.Solution[len(a.Moves)])
+	return true, nil
+}
+
+// Select finds the puzzle of a team with the rating closest to the user's that they haven't attempted yet
+func Select(storage PuzzleStorage, teamID string, userID string, rating int) (*Puzzle, error) {
+	attempted := make(map[string]bool)
+	err := storage.RetrieveAttempts(teamID, userID, func(attempt *Attempt) error {
+		attempted[attempt.PuzzleID] = true
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	var selected *Puzzle
+	err = storage.RetrievePuzzles(teamID, func(puzzle *Puzzle) error {
+		if attempted[puzzle.ID] {
+			return nil
+		}
+		if selected == nil || math.Abs(float64(puzzle.Rating-rating)) < math.Abs(float64(selected.Rating-rating)) {
+			selected = puzzle
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if selected == nil {
+		return nil, ErrNoPuzzles
+	}
+	return selected, nil
+}
@@ -0,0 +1,249 @@
+package puzzles
+
+import (
+	"encoding/json"
+	"fmt"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	puzzleBucket  = []byte("puzzles")
+	dailyBucket   = []byte("daily_puzzles")
+	streakBucket  = []byte("puzzle_streaks")
+	attemptBucket = []byte("puzzle_attempts")
+	ratingBucket  = []byte("puzzle_ratings")
+	channelBucket = []byte("puzzle_channels")
+)
+
+// BoltStore is an implementation of the PuzzleStorage interface that persists using an embedded bbolt database
+type BoltStore struct {
+	db *bolt.DB
+}
+
+// NewBoltStore creates (if not exists) the buckets used for puzzles within an open bbolt database
+// It implements the PuzzleStorage interface and is intended as a suitable perminent storage of puzzles
+func NewBoltStore(db *bolt.DB) (*BoltStore, error) {
+	err := db.Update(func(tx *bolt.Tx) error {
+		for _, bucket := range [][]byte{puzzleBucket, dailyBucket, streakBucket, attemptBucket, ratingBucket, channelBucket} {
+			if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &BoltStore{db: db}, nil
+}
+
+func (b *BoltStore) get(bucket []byte, key []byte, value interface{}) (bool, error) {
+	found := false
+	err := b.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(bucket).Get(key)
+		if data == nil {
+			return nil
+		}
+		found = true
+		return json.Unmarshal(data, value)
+	})
+	return found, err
+}
+
+func (b *BoltStore) put(bucket []byte, key []byte, value interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return b.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucket).Put(key, data)
+	})
+}
+
+// StorePuzzle stores a puzzle by its ID
+// Note: This will overwrite a previous puzzle of the same ID
+func (b *BoltStore) StorePuzzle(puzzle *Puzzle) error {
+	return b.put(puzzleBucket, []byte(puzzle.ID), puzzle)
+}
+
+// RetrievePuzzle finds a puzzle by its ID
+func (b *BoltStore) RetrievePuzzle(ID string) (*Puzzle, error) {
+	var puzzle Puzzle
+	found, err := b.get(puzzleBucket, []byte(ID), &puzzle)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("Puzzle %v not found", ID)
+	}
+	return &puzzle, nil
+}
+
+// RetrievePuzzles finds all puzzles of a team in the order they were created
+func (b *BoltStore) RetrievePuzzles(teamID string, each func(*Puzzle) error) error {
+	matched := []*Puzzle{}
+	err := b.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(puzzleBucket).ForEach(func(_, data []byte) error {
+			var puzzle Puzzle
+			if err := json.Unmarshal(data, &puzzle); err != nil {
+				return err
+			}
+			if puzzle.TeamID == teamID {
+				matched = append(matched, &puzzle)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return err
+	}
+	sortPuzzles(matched)
+	for _, puzzle := range matched {
+		if err := each(puzzle); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RetrieveNextPuzzle finds the oldest puzzle of a team that wasn't posted yet
+func (b *BoltStore) RetrieveNextPuzzle(teamID string) (*Puzzle, error) {
+	unposted := []*Puzzle{}
+	err := b.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(puzzleBucket).ForEach(func(_, data []byte) error {
+			var puzzle Puzzle
+			if err := json.Unmarshal(data, &puzzle); err != nil {
+				return err
+			}
+			if puzzle.TeamID == teamID && puzzle.PostedAt.IsZero() {
+				unposted = append(unposted, &puzzle)
+			}
+			return nil
+		})
+	})
+	if err != nil || len(unposted) == 0 {
+		return nil, err
+	}
+	sortPuzzles(unposted)
+	return unposted[0], nil
+}
+
+// StoreDailyPuzzle stores a puzzle of the day by the thread it was posted in
+// Note: This will overwrite a previous puzzle of the day posted in the same thread
+func (b *BoltStore) StoreDailyPuzzle(daily *DailyPuzzle) error {
+	return b.put(dailyBucket, []byte(daily.TeamID+"\x00"+daily.ThreadID), daily)
+}
+
+// RetrieveDailyPuzzle finds the puzzle of the day posted in a thread
+func (b *BoltStore) RetrieveDailyPuzzle(teamID string, threadID string) (*DailyPuzzle, error) {
+	var daily DailyPuzzle
+	found, err := b.get(dailyBucket, []byte(teamID+"\x00"+threadID), &daily)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("Puzzle of the day not found in thread %v", threadID)
+	}
+	return &daily, nil
+}
+
+// StoreStreak stores the puzzle streak of a user within a team
+func (b *BoltStore) StoreStreak(streak Streak) error {
+	return b.put(streakBucket, []byte(streak.TeamID+"\x00"+streak.UserID), streak)
+}
+
+// RetrieveStreak retrieves the puzzle streak of a user within a team
+func (b *BoltStore) RetrieveStreak(teamID string, userID string) (Streak, error) {
+	var streak Streak
+	found, err := b.get(streakBucket, []byte(teamID+"\x00"+userID), &streak)
+	if err != nil {
+		return Streak{}, err
+	}
+	if !found {
+		return Streak{}, fmt.Errorf("Puzzle streak not found for user %v", userID)
+	}
+	return streak, nil
+}
+
+// StoreAttempt stores an attempt at a puzzle by the thread it is played in
+// Note: This will overwrite a previous attempt played in the same thread
+func (b *BoltStore) StoreAttempt(attempt *Attempt) error {
+	return b.put(attemptBucket, []byte(attempt.TeamID+"\x00"+attempt.ThreadID), attempt)
+}
+
+// RetrieveAttempt finds the attempt at a puzzle played in a thread
+func (b *BoltStore) RetrieveAttempt(teamID string, threadID string) (*Attempt, error) {
+	var attempt Attempt
+	found, err := b.get(attemptBucket, []byte(teamID+"\x00"+threadID), &attempt)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("Puzzle attempt not found in thread %v", threadID)
+	}
+	return &attempt, nil
+}
+
+// RetrieveAttempts finds all attempts of a user within a team in the order they started
+func (b *BoltStore) RetrieveAttempts(teamID string, userID string, each func(*Attempt) error) error {
+	matched := []*Attempt{}
+	err := b.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(attemptBucket).ForEach(func(_, data []byte) error {
+			var attempt Attempt
+			if err := json.Unmarshal(data, &attempt); err != nil {
+				return err
+			}
+			if attempt.TeamID == teamID && attempt.UserID == userID {
+				matched = append(matched, &attempt)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return err
+	}
+	sortAttempts(matched)
+	for _, attempt := range matched {
+		if err := each(attempt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StorePlayerRating stores the puzzle rating of a user within a team
+func (b *BoltStore) StorePlayerRating(rating PlayerRating) error {
+	return b.put(ratingBucket, []byte(rating.TeamID+"\x00"+rating.UserID), rating)
+}
+
+// RetrievePlayerRating retrieves the puzzle rating of a user within a team
+func (b *BoltStore) RetrievePlayerRating(teamID string, userID string) (PlayerRating, error) {
+	var rating PlayerRating
+	found, err := b.get(ratingBucket, []byte(teamID+"\x00"+userID), &rating)
+	if err != nil {
+		return PlayerRating{}, err
+	}
+	if !found {
+		return PlayerRating{}, fmt.Errorf("Puzzle rating not found for user %v", userID)
+	}
+	return rating, nil
+}
+
+// StorePuzzleChannel stores the channel a team has its puzzle of the day posted to
+func (b *BoltStore) StorePuzzleChannel(channel PuzzleChannel) error {
+	return b.put(channelBucket, []byte(channel.TeamID), channel)
+}
+
+// RetrievePuzzleChannel retrieves the channel a team has its puzzle of the day posted to
+func (b *BoltStore) RetrievePuzzleChannel(teamID string) (PuzzleChannel, error) {
+	var channel PuzzleChannel
+	found, err := b.get(channelBucket, []byte(teamID), &channel)
+	if err != nil {
+		return PuzzleChannel{}, err
+	}
+	if !found {
+		return PuzzleChannel{}, fmt.Errorf("Puzzle channel not found for team %v", teamID)
+	}
+	return channel, nil
+}
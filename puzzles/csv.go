@@ -0,0 +1,102 @@
+package puzzles
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ImportReport summarizes the result of a puzzle import
+type ImportReport struct {
+	Imported int
+	Skipped  []SkippedPuzzle
+}
+
+// SkippedPuzzle describes a puzzle of the CSV file that could not be imported
+type SkippedPuzzle struct {
+	// Line is the line of the puzzle within the CSV file, starting at 1
+	Line   int
+	Reason error
+}
+
+func (s SkippedPuzzle) Error() string {
+	return fmt.Sprintf("line %v: %v", s.Line, s.Reason)
+}
+
+// ImportCSV stores the puzzles of a CSV file in the format of the Lichess puzzle database as puzzles of a team:
+//
+//	PuzzleId,FEN,Moves,Rating,RatingDeviation,Popularity,NbPlays,Themes,GameUrl,OpeningTags
+//
+// The FEN is the position before the opponent's move, which is the first of the moves in long algebraic notation.
+// Only the first four columns are required and the header line is optional.
+// Puzzles that were imported before are skipped, keeping their rating.
+func ImportCSV(storage PuzzleStorage, teamID string, r io.Reader) (*ImportReport, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	report := &ImportReport{}
+	createdAt := time.Now()
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return report, nil
+		}
+		if err != nil {
+			return report, err
+		}
+		if line == 1 && record[0] == "PuzzleId" {
+			continue
+		}
+		puzzle, err := parseRecord(teamID, record)
+		if err == nil {
+			if _, retrieveErr := storage.RetrievePuzzle(puzzle.ID); retrieveErr == nil {
+				err = errors.New("already imported")
+			}
+		}
+		if err != nil {
+			report.Skipped = append(report.Skipped, SkippedPuzzle{Line: line, Reason: err})
+			continue
+		}
+		puzzle.CreatedAt = createdAt
+		if err := storage.StorePuzzle(puzzle); err != nil {
+			return report, err
+		}
+		report.Imported++
+	}
+}
+
+func parseRecord(teamID string, record []string) (*Puzzle, error) {
+	if len(record) < 4 {
+		return nil, errors.New("expected at least an ID, FEN, moves and rating")
+	}
+	rating, err := strconv.Atoi(record[3])
+	if err != nil {
+		return nil, fmt.Errorf("invalid rating %v", record[3])
+	}
+	moves := strings.Fields(record[2])
+	if len(moves) < 2 || len(moves)%2 != 0 {
+		return nil, errors.New("expected the opponent's move followed by a solution ending with the solver's move")
+	}
+	puzzle := &Puzzle{
+		ID:     teamID + "-" + record[0],
+		TeamID: teamID,
+		FEN:    record[1],
+		Rating: rating,
+	}
+	position, err := puzzle.Position()
+	if err != nil {
+		return nil, fmt.Errorf("invalid FEN %v", record[1])
+	}
+	if position, err = play(position, moves[:1]); err != nil {
+		return nil, err
+	}
+	puzzle.FEN = position.String()
+	if _, err = play(position, moves[1:]); err != nil {
+		return nil, err
+	}
+	puzzle.Solution = moves[1:]
+	return puzzle, nil
+}
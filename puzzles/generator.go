@@ -0,0 +1,162 @@
+package puzzles
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cjsaylor/chessbot/engine"
+	"github.com/cjsaylor/chessbot/game"
+	"github.com/notnil/chess"
+)
+
+const (
+	// minSwing is how much (in centipawns) the evaluation must swing in favor of the side to move after the opponent's move
+	minSwing = 300
+	// minAdvantage is how far ahead (in centipawns) the side to move must be after playing the best line
+	minAdvantage = 200
+	// minMargin is how much better (in centipawns) the best move must be than the second best to count as the only solution
+	minMargin = 150
+	// maxSolutionPlies is the longest solution line, counting both sides' moves
+	maxSolutionPlies = 5
+)
+
+// Generator finds puzzles in completed games using engine evaluations
+type Generator struct {
+	evaluator    engine.Evaluator
+	timeProvider game.TimeProvider
+}
+
+// NewGenerator creates a puzzle generator evaluating positions with the evaluator
+func NewGenerator(evaluator engine.Evaluator) *Generator {
+	return &Generator{
+		evaluator:    evaluator,
+		timeProvider: time.Now,
+	}
+}
+
+// SetTimeProvider overrides the time used to date new puzzles
+func (g *Generator) SetTimeProvider(provider game.TimeProvider) {
+	g.timeProvider = provider
+}
+
+// Find evaluates every position of an archived game and returns the tactical moments as puzzles.
+// A tactical moment follows a move that swings the evaluation in favor of the side to move,
+// who then has a single clearly best line to take advantage of it.
+// Unrated (coached) games have no puzzles, as the players may have followed the engine's hints.
+func (g *Generator) Find(archived *game.ArchivedGame) ([]*Puzzle, error) {
+	if !archived.Rated() {
+		return nil, nil
+	}
+	option, err := chess.PGN(strings.NewReader(game.StripNAGs(archived.PGN)))
+	if err != nil {
+		return nil, err
+	}
+	positions := chess.NewGame(option).Positions()
+	found := []*Puzzle{}
+	var previous []engine.Line
+	for ply := 0; ply < len(positions); ply++ {
+		position := positions[ply]
+		if position.Status() != chess.NoMethod {
+			break
+		}
+		lines, err := g.evaluator.Evaluate(position.String(), 2)
+		if err != nil {
+			return nil, err
+		}
+		if len(lines) == 0 {
+			break
+		}
+		if ply > 0 && len(previous) > 0 && isTactic(previous[0].Score, lines) {
+			solution, err := g.solve(position, lines)
+			if err != nil {
+				return nil, err
+			}
+			found = append(found, &Puzzle{
+				ID:        fmt.Sprintf("%v-%v", archived.GameID, ply),
+				TeamID:    archived.TeamID,
+				GameID:    archived.GameID,
+				Ply:       ply,
+				FEN:       position.String(),
+				Solution:  solution,
+				Rating:    DefaultRating,
+				CreatedAt: g.timeProvider(),
+			})
+			// Skip the positions along the solution, they would be part of the same tactic
+			ply += len(solution) - 1
+			previous = nil
+			continue
+		}
+		previous = lines
+	}
+	return found, nil
+}
+
+// isTactic determines if the opponent's last move (evaluated before as the opponent) swung the evaluation enough,
+// and whether the best line of the side to move is the only good one.
+func isTactic(before engine.Score, lines []engine.Line) bool {
+	after := lines[0].Score.Value()
+	if after < minAdvantage || after-before.Negate().Value() < minSwing {
+		return false
+	}
+	return isOnlyMove(lines)
+}
+
+// isOnlyMove determines if the best line is clearly better than the second best.
+// A position with a single legal move has nothing to solve.
+func isOnlyMove(lines []engine.Line) bool {
+	if len(lines) < 2 {
+		return false
+	}
+	return lines[0].Score.Value()-lines[1].Score.Value() >= minMargin
+}
+
+// solve extends the best line for as long as each of the solver's moves remains the only good one
+func (g *Generator) solve(position *chess.Position, lines []engine.Line) ([]string, error) {
+	solution := []string{lines[0].Moves[0]}
+	for len(solution)+2 <= maxSolutionPlies {
+		current, err := play(position, solution)
+		if err != nil {
+			return nil, err
+		}
+		if current.Status() != chess.NoMethod {
+			break
+		}
+		replies, err := g.evaluator.Evaluate(current.String(), 1)
+		if err != nil {
+			return nil, err
+		}
+		if len(replies) == 0 {
+			break
+		}
+		reply := replies[0].Moves[0]
+		next, err := play(current, []string{reply})
+		if err != nil {
+			return nil, err
+		}
+		if next.Status() != chess.NoMethod {
+			break
+		}
+		lines, err := g.evaluator.Evaluate(next.String(), 2)
+		if err != nil {
+			return nil, err
+		}
+		if !isOnlyMove(lines) {
+			break
+		}
+		solution = append(solution, reply, lines[0].Moves[0])
+	}
+	return solution, nil
+}
+
+// play applies moves in long algebraic notation to a position
+func play(position *chess.Position, moves []string) (*chess.Position, error) {
+	for _, lan := range moves {
+		move, err := game.DecodeMove(position, lan)
+		if err != nil {
+			return nil, fmt.Errorf("invalid engine move %v: %v", lan, err)
+		}
+		position = position.Update(move)
+	}
+	return position, nil
+}
@@ -0,0 +1,248 @@
+package puzzles
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// MemoryStore implements the PuzzleStorage interface and holds all state in memory
+// Once the MemoryStore instance is released, all data in that storage is lost
+type MemoryStore struct {
+	mu       sync.RWMutex
+	puzzles  map[string]*Puzzle
+	daily    map[string]*DailyPuzzle
+	streaks  map[string]Streak
+	attempts map[string]*Attempt
+	ratings  map[string]PlayerRating
+	channels map[string]PuzzleChannel
+}
+
+// NewMemoryStore returns a MemoryStore pointer
+func NewMemoryStore() *MemoryStore {
+	store := MemoryStore{
+		puzzles:  make(map[string]*Puzzle, 10),
+		daily:    make(map[string]*DailyPuzzle, 10),
+		streaks:  make(map[string]Streak, 10),
+		attempts: make(map[string]*Attempt, 10),
+		ratings:  make(map[string]PlayerRating, 10),
+		channels: make(map[string]PuzzleChannel, 10),
+	}
+	return &store
+}
+
+// StorePuzzle stores a puzzle by its ID
+// Note: This will overwrite a previous puzzle of the same ID
+func (m *MemoryStore) StorePuzzle(puzzle *Puzzle) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	stored := *puzzle
+	m.puzzles[puzzle.ID] = &stored
+	return nil
+}
+
+// RetrievePuzzle finds a puzzle by its ID
+func (m *MemoryStore) RetrievePuzzle(ID string) (*Puzzle, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	puzzle, ok := m.puzzles[ID]
+	if !ok {
+		return nil, fmt.Errorf("Puzzle %v not found", ID)
+	}
+	retrieved := *puzzle
+	return &retrieved, nil
+}
+
+// RetrievePuzzles finds all puzzles of a team in the order they were created
+func (m *MemoryStore) RetrievePuzzles(teamID string, each func(*Puzzle) error) error {
+	m.mu.RLock()
+	matched := []*Puzzle{}
+	for _, puzzle := range m.puzzles {
+		if puzzle.TeamID == teamID {
+			retrieved := *puzzle
+			matched = append(matched, &retrieved)
+		}
+	}
+	m.mu.RUnlock()
+	sortPuzzles(matched)
+	for _, puzzle := range matched {
+		if err := each(puzzle); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RetrieveNextPuzzle finds the oldest puzzle of a team that wasn't posted yet
+func (m *MemoryStore) RetrieveNextPuzzle(teamID string) (*Puzzle, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var next *Puzzle
+	for _, puzzle := range m.puzzles {
+		if puzzle.TeamID != teamID || !puzzle.PostedAt.IsZero() {
+			continue
+		}
+		if next == nil || puzzle.CreatedAt.Before(next.CreatedAt) || (puzzle.CreatedAt.Equal(next.CreatedAt) && puzzle.ID < next.ID) {
+			next = puzzle
+		}
+	}
+	if next == nil {
+		return nil, nil
+	}
+	retrieved := *next
+	return &retrieved, nil
+}
+
+// StoreDailyPuzzle stores a puzzle of the day by the thread it was posted in
+// Note: This will overwrite a previous puzzle of the day posted in the same thread
+func (m *MemoryStore) StoreDailyPuzzle(daily *DailyPuzzle) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	stored := *daily
+	stored.Answered = copyAnswered(daily.Answered)
+	m.daily[daily.TeamID+"\x00"+daily.ThreadID] = &stored
+	return nil
+}
+
+// RetrieveDailyPuzzle finds the puzzle of the day posted in a thread
+func (m *MemoryStore) RetrieveDailyPuzzle(teamID string, threadID string) (*DailyPuzzle, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	daily, ok := m.daily[teamID+"\x00"+threadID]
+	if !ok {
+		return nil, fmt.Errorf("Puzzle of the day not found in thread %v", threadID)
+	}
+	retrieved := *daily
+	retrieved.Answered = copyAnswered(daily.Answered)
+	return &retrieved, nil
+}
+
+// StoreStreak stores the puzzle streak of a user within a team
+func (m *MemoryStore) StoreStreak(streak Streak) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.streaks[streak.TeamID+"\x00"+streak.UserID] = streak
+	return nil
+}
+
+// RetrieveStreak retrieves the puzzle streak of a user within a team
+func (m *MemoryStore) RetrieveStreak(teamID string, userID string) (Streak, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	streak, ok := m.streaks[teamID+"\x00"+userID]
+	if !ok {
+		return Streak{}, fmt.Errorf("Puzzle streak not found for user %v", userID)
+	}
+	return streak, nil
+}
+
+// StoreAttempt stores an attempt at a puzzle by the thread it is played in
+// Note: This will overwrite a previous attempt played in the same thread
+func (m *MemoryStore) StoreAttempt(attempt *Attempt) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	stored := *attempt
+	stored.Moves = append([]string(nil), attempt.Moves...)
+	m.attempts[attempt.TeamID+"\x00"+attempt.ThreadID] = &stored
+	return nil
+}
+
+// RetrieveAttempt finds the attempt at a puzzle played in a thread
+func (m *MemoryStore) RetrieveAttempt(teamID string, threadID string) (*Attempt, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	attempt, ok := m.attempts[teamID+"\x00"+threadID]
+	if !ok {
+		return nil, fmt.Errorf("Puzzle attempt not found in thread %v", threadID)
+	}
+	retrieved := *attempt
+	retrieved.Moves = append([]string(nil), attempt.Moves...)
+	return &retrieved, nil
+}
+
+// RetrieveAttempts finds all attempts of a user within a team in the order they started
+func (m *MemoryStore) RetrieveAttempts(teamID string, userID string, each func(*Attempt) error) error {
+	m.mu.RLock()
+	matched := []*Attempt{}
+	for _, attempt := range m.attempts {
+		if attempt.TeamID == teamID && attempt.UserID == userID {
+			retrieved := *attempt
+			retrieved.Moves = append([]string(nil), attempt.Moves...)
+			matched = append(matched, &retrieved)
+		}
+	}
+	m.mu.RUnlock()
+	sortAttempts(matched)
+	for _, attempt := range matched {
+		if err := each(attempt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StorePlayerRating stores the puzzle rating of a user within a team
+func (m *MemoryStore) StorePlayerRating(rating PlayerRating) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.ratings[rating.TeamID+"\x00"+rating.UserID] = rating
+	return nil
+}
+
+// RetrievePlayerRating retrieves the puzzle rating of a user within a team
+func (m *MemoryStore) RetrievePlayerRating(teamID string, userID string) (PlayerRating, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	rating, ok := m.ratings[teamID+"\x00"+userID]
+	if !ok {
+		return PlayerRating{}, fmt.Errorf("Puzzle rating not found for user %v", userID)
+	}
+	return rating, nil
+}
+
+// StorePuzzleChannel stores the channel a team has its puzzle of the day posted to
+func (m *MemoryStore) StorePuzzleChannel(channel PuzzleChannel) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.channels[channel.TeamID] = channel
+	return nil
+}
+
+// RetrievePuzzleChannel retrieves the channel a team has its puzzle of the day posted to
+func (m *MemoryStore) RetrievePuzzleChannel(teamID string) (PuzzleChannel, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	channel, ok := m.channels[teamID]
+	if !ok {
+		return PuzzleChannel{}, fmt.Errorf("Puzzle channel not found for team %v", teamID)
+	}
+	return channel, nil
+}
+
+func copyAnswered(answered map[string]bool) map[string]bool {
+	copied := make(map[string]bool, len(answered))
+	for userID, ok := range answered {
+		copied[userID] = ok
+	}
+	return copied
+}
+
+// sortPuzzles orders puzzles by when they were created, falling back to the ID for puzzles created together
+func sortPuzzles(puzzles []*Puzzle) {
+	sort.Slice(puzzles, func(i, j int) bool {
+		if puzzles[i].CreatedAt.Equal(puzzles[j].CreatedAt) {
+			return puzzles[i].ID < puzzles[j].ID
+		}
+		return puzzles[i].CreatedAt.Before(puzzles[j].CreatedAt)
+	})
+}
+
+// sortAttempts orders attempts by when they started, falling back to the thread for attempts started together
+func sortAttempts(attempts []*Attempt) {
+	sort.Slice(attempts, func(i, j int) bool {
+		if attempts[i].StartedAt.Equal(attempts[j].StartedAt) {
+			return attempts[i].ThreadID < attempts[j].ThreadID
+		}
+		return attempts[i].StartedAt.Before(attempts[j].StartedAt)
+	})
+}
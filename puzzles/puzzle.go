@@ -0,0 +1,118 @@
+// Package puzzles finds tactical puzzles in completed games and tracks the players solving them
+package puzzles
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cjsaylor/chessbot/game"
+	"github.com/notnil/chess"
+)
+
+// ErrIllegalMove is returned when an answer is not a legal move in the puzzle's position
+var ErrIllegalMove = game.ErrIllegalMove
+
+// Puzzle is a position from a game where the side to move has a single clearly best line
+type Puzzle struct {
+	ID     string
+	TeamID string
+	GameID string
+	// Ply is the number of moves played in the game before the puzzle's position
+	Ply int
+	// FEN is the position the solver starts from, with the solver to move
+	FEN string
+	// Solution is the line of play in long algebraic (UCI) notation, alternating solver and opponent moves and ending with a solver move
+	Solution []string
+	// Rating is the Elo rating of the puzzle, adjusted as players attempt it
+	Rating    int
+	CreatedAt time.Time
+	// PostedAt is when the puzzle was posted as a puzzle of the day, zero until then
+	PostedAt time.Time
+}
+
+// Game creates a game from the puzzle's starting position with the solver playing the side to move,
+// followed by the moves played so far in long algebraic notation
+func (p *Puzzle) Game(ID string, solver game.Player, opponent game.Player, moves ...string) (*game.Game, error) {
+	gm, err := game.NewGameFromFEN(ID, p.FEN, solver, opponent)
+	if err != nil {
+		return nil, err
+	}
+	if p.Color() == chess.White {
+		gm.SetPlayers(solver, opponent)
+	} else {
+		gm.SetPlayers(opponent, solver)
+	}
+	for _, move := range moves {
+		if _, err := gm.Move(move); err != nil {
+			return nil, err
+		}
+	}
+	return gm, nil
+}
+
+// Position is the puzzle's starting position
+func (p *Puzzle) Position() (*chess.Position, error) {
+	option, err := chess.FEN(p.FEN)
+	if err != nil {
+		return nil, err
+	}
+	return chess.NewGame(option).Position(), nil
+}
+
+// Color is the side the solver plays
+func (p *Puzzle) Color() chess.Color {
+	if fields := strings.Fields(p.FEN); len(fields) > 1 && fields[1] == "b" {
+		return chess.Black
+	}
+	return chess.White
+}
+
+// CheckAnswer determines if the answer (in algebraic or long algebraic notation) is the first move of the solution.
+// Any move that checkmates is also accepted.
+func (p *Puzzle) CheckAnswer(answer string) (bool, error) {
+	position, err := p.Position()
+	if err != nil {
+		return false, err
+	}
+	move, err := game.DecodeMove(position, answer)
+	if err != nil {
+		return false, err
+	}
+	if len(p.Solution) > 0 && (chess.LongAlgebraicNotation{}).Encode(position, move) == p.Solution[0] {
+		return true, nil
+	}
+	return position.Update(move).Status() == chess.Checkmate, nil
+}
+
+// SolutionText is the solution in algebraic notation with move numbers, such as "23. Qxf7+ Kh8 24. Qf8#"
+func (p *Puzzle) SolutionText() (string, error) {
+	position, err := p.Position()
+	if err != nil {
+		return "", err
+	}
+	number := 1
+	if fields := strings.Fields(p.FEN); len(fields) == 6 {
+		fmt.Sscan(fields[5], &number)
+	}
+	text := []string{}
+	for i, lan := range p.Solution {
+		move, err := game.DecodeMove(position, lan)
+		if err != nil {
+			return "", err
+		}
+		san := chess.AlgebraicNotation{}.Encode(position, move)
+		if position.Turn() == chess.White {
+			text = append(text, fmt.Sprintf("%v. %v", number, san))
+		} else if i == 0 {
+			text = append(text, fmt.Sprintf("%v... %v", number, san))
+		} else {
+			text = append(text, san)
+		}
+		if position.Turn() == chess.Black {
+			number++
+		}
+		position = position.Update(move)
+	}
+	return strings.Join(text, " "), nil
+}
@@ -0,0 +1,309 @@
+package puzzles_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cjsaylor/chessbot/engine"
+	"github.com/cjsaylor/chessbot/game"
+	"github.com/cjsaylor/chessbot/puzzles"
+	"github.com/notnil/chess"
+)
+
+// fakeEvaluator returns the lines of known positions, and two equal lines for any other position
+type fakeEvaluator map[string][]engine.Line
+
+func (f fakeEvaluator) Evaluate(fen string, lines int) ([]engine.Line, error) {
+	if known, ok := f[fen]; ok {
+		return known, nil
+	}
+	option, err := chess.FEN(fen)
+	if err != nil {
+		return nil, err
+	}
+	position := chess.NewGame(option).Position()
+	found := []engine.Line{}
+	for _, move := range position.ValidMoves() {
+		if len(found) == lines {
+			break
+		}
+		found = append(found, engine.Line{Moves: []string{chess.LongAlgebraicNotation{}.Encode(position, move)}})
+	}
+	return found, nil
+}
+
+func playMoves(t *testing.T, moves ...string) *chess.Game {
+	gm := chess.NewGame()
+	for _, move := range moves {
+		if err := gm.MoveStr(move); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return gm
+}
+
+func TestGeneratorFind(t *testing.T) {
+	blunder := playMoves(t, "e4", "e5", "Bc4", "Nc6", "Qh5", "Nf6")
+	evaluator := fakeEvaluator{
+		blunder.Position().String(): {
+			{Score: engine.Score{Mate: 1}, Moves: []string{"h5f7"}},
+			{Score: engine.Score{Centipawns: 40}, Moves: []string{"h5e2"}},
+		},
+	}
+	played := playMoves(t, "e4", "e5", "Bc4", "Nc6", "Qh5", "Nf6", "Qxf7#")
+	archived := &game.ArchivedGame{
+		GameID: "1234",
+		TeamID: "team",
+		PGN:    played.String(),
+	}
+	createdAt := time.Date(2019, time.March, 1, 12, 0, 0, 0, time.UTC)
+	generator := puzzles.NewGenerator(evaluator)
+	generator.SetTimeProvider(func() time.Time {
+		return createdAt
+	})
+	found, err := generator.Find(archived)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(found) != 1 {
+		t.Fatalf("expected a single puzzle, got %v", len(found))
+	}
+	puzzle := found[0]
+	if puzzle.ID != "1234-6" || puzzle.Ply != 6 || puzzle.TeamID != "team" || !puzzle.CreatedAt.Equal(createdAt) {
+		t.Errorf("unexpected puzzle %v", puzzle)
+	}
+	if puzzle.FEN != blunder.Position().String() {
+		t.Errorf("expected the puzzle to start after the blunder, got %v", puzzle.FEN)
+	}
+	if strings.Join(puzzle.Solution, " ") != "h5f7" {
+		t.Errorf("expected the mate to be the solution, got %v", puzzle.Solution)
+	}
+}
+
+func TestGeneratorIgnoresUnratedGames(t *testing.T) {
+	blunder := playMoves(t, "e4", "e5", "Bc4", "Nc6", "Qh5", "Nf6")
+	evaluator := fakeEvaluator{
+		blunder.Position().String(): {
+			{Score: engine.Score{Mate: 1}, Moves: []string{"h5f7"}},
+			{Score: engine.Score{Centipawns: 40}, Moves: []string{"h5e2"}},
+		},
+	}
+	played := playMoves(t, "e4", "e5", "Bc4", "Nc6", "Qh5", "Nf6", "Qxf7#")
+	played.AddTagPair("Coach", "Yes")
+	played.AddTagPair("Rated", "No")
+	found, err := puzzles.NewGenerator(evaluator).Find(&game.ArchivedGame{GameID: "1234", PGN: played.String()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(found) != 0 {
+		t.Errorf("expected no puzzles from a coached game, got %v", found)
+	}
+}
+
+func TestGeneratorIgnoresUnclearPositions(t *testing.T) {
+	blunder := playMoves(t, "e4", "e5", "Bc4", "Nc6", "Qh5", "Nf6")
+	evaluator := fakeEvaluator{
+		// Two moves win equally well, so neither is the solution
+		blunder.Position().String(): {
+			{Score: engine.Score{Centipawns: 900}, Moves: []string{"h5f7"}},
+			{Score: engine.Score{Centipawns: 850}, Moves: []string{"c4f7"}},
+		},
+	}
+	archived := &game.ArchivedGame{
+		GameID: "1234",
+		PGN:    playMoves(t, "e4", "e5", "Bc4", "Nc6", "Qh5", "Nf6", "Qxf7#").String(),
+	}
+	found, err := puzzles.NewGenerator(evaluator).Find(archived)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(found) != 0 {
+		t.Errorf("expected no puzzles, got %v", found)
+	}
+}
+
+func TestCheckAnswer(t *testing.T) {
+	puzzle := &puzzles.Puzzle{
+		FEN:      "6k1/5ppp/8/8/8/8/5PPP/3R2K1 w - - 0 30",
+		Solution: []string{"d1d8"},
+	}
+	for _, input := range []struct {
+		answer   string
+		expected bool
+	}{
+		{"Rd8#", true},
+		{"rd8", false},
+		{"d1d8", true},
+		{"Rd7", false},
+	} {
+		correct, err := puzzle.CheckAnswer(input.answer)
+		if err != nil && input.expected {
+			t.Error(err)
+		}
+		if correct != input.expected {
+			t.Errorf("expected %v to be %v, got %v", input.answer, input.expected, correct)
+		}
+	}
+	if _, err := puzzle.CheckAnswer("Qd8"); err != puzzles.ErrIllegalMove {
+		t.Errorf("expected an illegal move error, got %v", err)
+	}
+	if text, _ := puzzle.SolutionText(); text != "30. Rd8#" {
+		t.Errorf("expected the solution 30. Rd8#, got %v", text)
+	}
+}
+
+func TestStreakRecord(t *testing.T) {
+	day := time.Date(2019, time.March, 1, 9, 0, 0, 0, time.UTC)
+	streak := puzzles.Streak{}.Record(day)
+	streak = streak.Record(day.Add(2 * time.Hour))
+	if streak.Current != 1 {
+		t.Errorf("expected solving twice in a day to count once, got %v", streak.Current)
+	}
+	streak = streak.Record(day.AddDate(0, 0, 1))
+	streak = streak.Record(day.AddDate(0, 0, 2))
+	if streak.Current != 3 || streak.Best != 3 {
+		t.Errorf("expected a streak of 3, got %v (best %v)", streak.Current, streak.Best)
+	}
+	streak = streak.Record(day.AddDate(0, 0, 4))
+	if streak.Current != 1 || streak.Best != 3 {
+		t.Errorf("expected a missed day to reset the streak, got %v (best %v)", streak.Current, streak.Best)
+	}
+}
+
+func TestPuzzleChannelFailed(t *testing.T) {
+	now := time.Date(2019, time.March, 1, 9, 0, 0, 0, time.UTC)
+	channel := puzzles.PuzzleChannel{TeamID: "team", ChannelID: "C123"}
+	if !channel.Due(now) {
+		t.Error("expected a channel without failures to be due")
+	}
+	channel = channel.Failed(now)
+	if channel.Due(now.Add(59*time.Minute)) || !channel.Due(now.Add(time.Hour)) {
+		t.Errorf("expected a retry an hour after the first failure, got %v", channel.RetryAt)
+	}
+	channel = channel.Failed(now)
+	if !channel.RetryAt.Equal(now.Add(2 * time.Hour)) {
+		t.Errorf("expected the delay to double, got %v", channel.RetryAt)
+	}
+	for i := 0; i < 10; i++ {
+		channel = channel.Failed(now)
+	}
+	if channel.Failures != 12 || !channel.RetryAt.Equal(now.Add(24*time.Hour)) {
+		t.Errorf("expected the delay to be capped at a day, got %v after %v failures", channel.RetryAt, channel.Failures)
+	}
+	if (puzzles.PuzzleChannel{TeamID: "team"}).Due(now) {
+		t.Error("expected a team without a channel not to be due")
+	}
+}
+
+func TestPuzzleChannelPosted(t *testing.T) {
+	now := time.Date(2019, time.March, 1, 9, 0, 0, 0, time.UTC)
+	channel := puzzles.PuzzleChannel{TeamID: "team", ChannelID: "C123"}.Failed(now.Add(-time.Hour)).Posted(now)
+	if channel.Failures != 0 || !channel.PostedAt.Equal(now) {
+		t.Errorf("expected posting to clear the failures, got %+v", channel)
+	}
+	if channel.Due(now.Add(14*time.Hour + 59*time.Minute)) {
+		t.Error("expected the channel not to be due again on the day it was posted to")
+	}
+	if !channel.Due(now.Add(15 * time.Hour)) {
+		t.Error("expected the channel to be due the next day (UTC)")
+	}
+}
+
+const lichessCSV = `PuzzleId,FEN,Moves,Rating,RatingDeviation,Popularity,NbPlays,Themes,GameUrl,OpeningTags
+00sHx,q3k1nr/1pp1nQpp/3p4/1P2p3/4P3/B1PP1b2/B5PP/5K2 b k - 0 17,e8d7 a2e6 d7d8 f7f8,1760,80,83,72,mate mateIn2 middlegame short,https://lichess.org/yyznGmXs/black#34,Italian_Game Italian_Game_Classical_Variation
+broken,not a fen,e2e4 e7e5,1500
+00sHx,q3k1nr/1pp1nQpp/3p4/1P2p3/4P3/B1PP1b2/B5PP/5K2 b k - 0 17,e8d7 a2e6 d7d8 f7f8,1760
+`
+
+func TestImportCSV(t *testing.T) {
+	store := puzzles.NewMemoryStore()
+	report, err := puzzles.ImportCSV(store, "team", strings.NewReader(lichessCSV))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Imported != 1 || len(report.Skipped) != 2 {
+		t.Fatalf("expected 1 puzzle imported and 2 skipped, got %v and %v", report.Imported, report.Skipped)
+	}
+	if report.Skipped[0].Line != 3 {
+		t.Errorf("expected the invalid FEN on line 3 to be skipped, got %v", report.Skipped[0])
+	}
+	puzzle, err := store.RetrievePuzzle("team-00sHx")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if puzzle.FEN != "q5nr/1ppknQpp/3p4/1P2p3/4P3/B1PP1b2/B5PP/5K2 w - - 0 18" {
+		t.Errorf("expected the puzzle to start after the opponent's move, got %v", puzzle.FEN)
+	}
+	if strings.Join(puzzle.Solution, " ") != "a2e6 d7d8 f7f8" || puzzle.Rating != 1760 || puzzle.TeamID != "team" {
+		t.Errorf("unexpected puzzle %v", puzzle)
+	}
+}
+
+func TestAttemptPlay(t *testing.T) {
+	store := puzzles.NewMemoryStore()
+	puzzles.ImportCSV(store, "team", strings.NewReader(lichessCSV))
+	puzzle, _ := store.RetrievePuzzle("team-00sHx")
+
+	attempt := &puzzles.Attempt{PuzzleID: puzzle.ID}
+	if _, err := attempt.Play(puzzle, "Kd2"); err != puzzles.ErrIllegalMove {
+		t.Errorf("expected an illegal move error, got %v", err)
+	}
+	if correct, err := attempt.Play(puzzle, "Be6+"); err != nil || !correct {
+		t.Fatalf("expected Be6+ to be correct, got %v (%v)", correct, err)
+	}
+	if strings.Join(attempt.Moves, " ") != "a2e6 d7d8" || attempt.Finished() {
+		t.Errorf("expected the opponent's reply to be played, got %v", attempt.Moves)
+	}
+	if correct, err := attempt.Play(puzzle, "f7f8"); err != nil || !correct || !attempt.Solved {
+		t.Errorf("expected the puzzle to be solved, got %v (%v)", correct, err)
+	}
+	if _, err := attempt.Play(puzzle, "f7f8"); err != puzzles.ErrAttemptFinished {
+		t.Errorf("expected the attempt to be finished, got %v", err)
+	}
+	gm, err := puzzle.Game("thread", game.Player{ID: "solver"}, game.Player{ID: "bot"}, attempt.Moves...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gm.Players[game.White].ID != "solver" || gm.Outcome() != chess.WhiteWon {
+		t.Errorf("expected the solver to have checkmated as White, got %v", gm.Outcome())
+	}
+
+	failed := &puzzles.Attempt{PuzzleID: puzzle.ID}
+	if correct, err := failed.Play(puzzle, "Qxg7"); err != nil || correct || !failed.Failed {
+		t.Errorf("expected the attempt to fail, got %v (%v)", correct, err)
+	}
+}
+
+func TestSelect(t *testing.T) {
+	store := puzzles.NewMemoryStore()
+	for ID, rating := range map[string]int{"easy": 1100, "medium": 1500, "hard": 2000} {
+		store.StorePuzzle(&puzzles.Puzzle{ID: ID, TeamID: "team", Rating: rating})
+	}
+	puzzle, err := puzzles.Select(store, "team", "U123", 1600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if puzzle.ID != "medium" {
+		t.Errorf("expected the closest rated puzzle, got %v", puzzle.ID)
+	}
+	store.StoreAttempt(&puzzles.Attempt{TeamID: "team", ThreadID: "1", UserID: "U123", PuzzleID: "medium"})
+	if puzzle, _ = puzzles.Select(store, "team", "U123", 1600); puzzle.ID != "hard" {
+		t.Errorf("expected attempted puzzles to be skipped, got %v", puzzle.ID)
+	}
+	if _, err := puzzles.Select(store, "other", "U123", 1600); err != puzzles.ErrNoPuzzles {
+		t.Errorf("expected no puzzles for another team, got %v", err)
+	}
+}
+
+func TestPlayerRatingRecord(t *testing.T) {
+	puzzle := &puzzles.Puzzle{Rating: puzzles.DefaultRating}
+	rating := puzzles.NewPlayerRating("team", "U123").Record(puzzle, true)
+	if rating.Rating != 1516 || puzzle.Rating != 1484 || rating.Solved != 1 {
+		t.Errorf("expected an even match to move both ratings by 16, got %v and %v", rating.Rating, puzzle.Rating)
+	}
+	hard := &puzzles.Puzzle{Rating: 2400}
+	if failed := rating.Record(hard, false); failed.Rating != 1516 || failed.Failed != 1 {
+		t.Errorf("expected failing a much harder puzzle to barely change the rating, got %v", failed.Rating)
+	}
+}
@@ -0,0 +1,44 @@
+package puzzles
+
+import "math"
+
+// DefaultRating is the starting rating of players and of puzzles found in games
+const DefaultRating = 1500
+
+// ratingFactor is the most a rating changes (the Elo K-factor) after a single attempt
+const ratingFactor = 32
+
+// PlayerRating is the puzzle rating of a user within a team
+type PlayerRating struct {
+	TeamID string
+	UserID string
+	Rating int
+	Solved int
+	Failed int
+}
+
+// NewPlayerRating is the rating of a user that hasn't attempted any puzzles yet
+func NewPlayerRating(teamID string, userID string) PlayerRating {
+	return PlayerRating{
+		TeamID: teamID,
+		UserID: userID,
+		Rating: DefaultRating,
+	}
+}
+
+// Record adjusts the rating of the player after an attempt at a puzzle, as an Elo rated game between the two.
+// The puzzle's rating is adjusted in place by the opposite amount.
+func (r PlayerRating) Record(puzzle *Puzzle, solved bool) PlayerRating {
+	score := 0.0
+	if solved {
+		score = 1
+		r.Solved++
+	} else {
+		r.Failed++
+	}
+	expected := 1 / (1 + math.Pow(10, float64(puzzle.Rating-r.Rating)/400))
+	change := int(math.Round(ratingFactor * (score - expected)))
+	r.Rating += change
+	puzzle.Rating -= change
+	return r
+}
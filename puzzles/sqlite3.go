@@ -0,0 +1,408 @@
+package puzzles
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	// import sqlite package for use with the sql interface
+	_ "github.com/mattn/go-sqlite3"
+)
+
+const puzzleTableCreation = `
+	CREATE TABLE IF NOT EXISTS puzzles (
+		id text PRIMARY KEY,
+		team_id text NOT NULL,
+		game_id text NOT NULL,
+		ply integer NOT NULL,
+		fen text NOT NULL,
+		solution text NOT NULL,
+		rating integer NOT NULL,
+		created_at datetime NOT NULL,
+		posted_at datetime NOT NULL
+	);
+	CREATE INDEX IF NOT EXISTS puzzles_team_created ON puzzles (team_id, created_at);
+	CREATE INDEX IF NOT EXISTS puzzles_team_posted ON puzzles (team_id, posted_at, created_at);
+`
+
+const dailyPuzzleTableCreation = `
+	CREATE TABLE IF NOT EXISTS daily_puzzles (
+		team_id text NOT NULL,
+		thread_id text NOT NULL,
+		channel_id text NOT NULL,
+		puzzle_id text NOT NULL,
+		posted_at datetime NOT NULL,
+		answered text NOT NULL,
+		PRIMARY KEY (team_id, thread_id)
+	);
+`
+
+const streakTableCreation = `
+	CREATE TABLE IF NOT EXISTS puzzle_streaks (
+		team_id text NOT NULL,
+		user_id text NOT NULL,
+		current integer NOT NULL,
+		best integer NOT NULL,
+		last_solved datetime NOT NULL,
+		PRIMARY KEY (team_id, user_id)
+	);
+`
+
+const attemptTableCreation = `
+	CREATE TABLE IF NOT EXISTS puzzle_attempts (
+		team_id text NOT NULL,
+		thread_id text NOT NULL,
+		channel_id text NOT NULL,
+		user_id text NOT NULL,
+		puzzle_id text NOT NULL,
+		moves text NOT NULL,
+		solved boolean NOT NULL,
+		failed boolean NOT NULL,
+		started_at datetime NOT NULL,
+		PRIMARY KEY (team_id, thread_id)
+	);
+	CREATE INDEX IF NOT EXISTS puzzle_attempts_team_user ON puzzle_attempts (team_id, user_id, started_at);
+`
+
+const ratingTableCreation = `
+	CREATE TABLE IF NOT EXISTS puzzle_ratings (
+		team_id text NOT NULL,
+		user_id text NOT NULL,
+		rating integer NOT NULL,
+		solved integer NOT NULL,
+		failed integer NOT NULL,
+		PRIMARY KEY (team_id, user_id)
+	);
+`
+
+const channelTableCreation = `
+	CREATE TABLE IF NOT EXISTS puzzle_channels (
+		team_id text NOT NULL,
+		channel_id text NOT NULL,
+		failures integer NOT NULL,
+		retry_at datetime NOT NULL,
+		posted_at datetime NOT NULL,
+		PRIMARY KEY (team_id)
+	);
+`
+
+// SqliteStore is an implementation of the PuzzleStorage interface that persists using sqlite3
+type SqliteStore struct {
+	path string
+	db   *sql.DB
+}
+
+// NewSqliteStore creates (if not exists) the DB file and structure at the path specified
+// It implements the PuzzleStorage interface and is intended as a suitable
+// perminent storage of puzzles
+func NewSqliteStore(path string) (*SqliteStore, error) {
+	store := SqliteStore{
+		path: path,
+	}
+	db, err := sql.Open("sqlite3", fmt.Sprintf("%v?parseTime=1", path))
+	if err != nil {
+		return nil, err
+	}
+	// sqlite only supports a single writer, serialize access to avoid locking errors
+	db.SetMaxOpenConns(1)
+	if _, err = db.Exec(puzzleTableCreation); err != nil {
+		return nil, err
+	}
+	if _, err = db.Exec(dailyPuzzleTableCreation); err != nil {
+		return nil, err
+	}
+	if _, err = db.Exec(streakTableCreation); err != nil {
+		return nil, err
+	}
+	if _, err = db.Exec(attemptTableCreation); err != nil {
+		return nil, err
+	}
+	if _, err = db.Exec(ratingTableCreation); err != nil {
+		return nil, err
+	}
+	if _, err = db.Exec(channelTableCreation); err != nil {
+		return nil, err
+	}
+	store.db = db
+	return &store, nil
+}
+
+// StorePuzzle stores a puzzle by its ID
+// Note: This will overwrite a previous puzzle of the same ID
+func (s *SqliteStore) StorePuzzle(puzzle *Puzzle) error {
+	stmt, _ := s.db.Prepare(`
+	insert or replace into puzzles
+		(id, team_id, game_id, ply, fen, solution, rating, created_at, posted_at)
+		values (?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`)
+	defer stmt.Close()
+	_, err := stmt.Exec(
+		puzzle.ID,
+		puzzle.TeamID,
+		puzzle.GameID,
+		puzzle.Ply,
+		puzzle.FEN,
+		strings.Join(puzzle.Solution, " "),
+		puzzle.Rating,
+		puzzle.CreatedAt.UTC(),
+		puzzle.PostedAt.UTC(),
+	)
+	return err
+}
+
+// RetrievePuzzle finds a puzzle by its ID
+func (s *SqliteStore) RetrievePuzzle(ID string) (*Puzzle, error) {
+	row := s.db.QueryRow(`
+	select id, team_id, game_id, ply, fen, solution, rating, created_at, posted_at
+		from puzzles
+		where id = ?
+	`, ID)
+	return scanPuzzle(row)
+}
+
+// RetrievePuzzles finds all puzzles of a team in the order they were created.
+// Puzzles are streamed from the DB, so the callback must not use the store itself.
+func (s *SqliteStore) RetrievePuzzles(teamID string, each func(*Puzzle) error) error {
+	rows, err := s.db.Query(`
+	select id, team_id, game_id, ply, fen, solution, rating, created_at, posted_at
+		from puzzles
+		where team_id = ?
+		order by created_at, id
+	`, teamID)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		puzzle, err := scanPuzzle(rows)
+		if err != nil {
+			return err
+		}
+		if err := each(puzzle); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// RetrieveNextPuzzle finds the oldest puzzle of a team that wasn't posted yet
+func (s *SqliteStore) RetrieveNextPuzzle(teamID string) (*Puzzle, error) {
+	row := s.db.QueryRow(`
+	select id, team_id, game_id, ply, fen, solution, rating, created_at, posted_at
+		from puzzles
+		where team_id = ? and posted_at = ?
+		order by created_at, id
+		limit 1
+	`, teamID, time.Time{}.UTC())
+	puzzle, err := scanPuzzle(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return puzzle, err
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanPuzzle(row scanner) (*Puzzle, error) {
+	var puzzle Puzzle
+	var solution string
+	if err := row.Scan(
+		&puzzle.ID,
+		&puzzle.TeamID,
+		&puzzle.GameID,
+		&puzzle.Ply,
+		&puzzle.FEN,
+		&solution,
+		&puzzle.Rating,
+		&puzzle.CreatedAt,
+		&puzzle.PostedAt,
+	); err != nil {
+		return nil, err
+	}
+	puzzle.Solution = strings.Fields(solution)
+	return &puzzle, nil
+}
+
+// StoreDailyPuzzle stores a puzzle of the day by the thread it was posted in
+// Note: This will overwrite a previous puzzle of the day posted in the same thread
+func (s *SqliteStore) StoreDailyPuzzle(daily *DailyPuzzle) error {
+	answered, err := json.Marshal(daily.Answered)
+	if err != nil {
+		return err
+	}
+	stmt, _ := s.db.Prepare(`
+	insert or replace into daily_puzzles
+		(team_id, thread_id, channel_id, puzzle_id, posted_at, answered)
+		values (?, ?, ?, ?, ?, ?)
+	`)
+	defer stmt.Close()
+	_, err = stmt.Exec(daily.TeamID, daily.ThreadID, daily.ChannelID, daily.PuzzleID, daily.PostedAt.UTC(), string(answered))
+	return err
+}
+
+// RetrieveDailyPuzzle finds the puzzle of the day posted in a thread
+func (s *SqliteStore) RetrieveDailyPuzzle(teamID string, threadID string) (*DailyPuzzle, error) {
+	var daily DailyPuzzle
+	var answered string
+	err := s.db.QueryRow(`
+	select team_id, thread_id, channel_id, puzzle_id, posted_at, answered
+		from daily_puzzles
+		where team_id = ? and thread_id = ?
+	`, teamID, threadID).Scan(&daily.TeamID, &daily.ThreadID, &daily.ChannelID, &daily.PuzzleID, &daily.PostedAt, &answered)
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal([]byte(answered), &daily.Answered)
+	return &daily, err
+}
+
+// StoreStreak stores the puzzle streak of a user within a team
+func (s *SqliteStore) StoreStreak(streak Streak) error {
+	stmt, _ := s.db.Prepare(`
+	insert or replace into puzzle_streaks
+		(team_id, user_id, current, best, last_solved)
+		values (?, ?, ?, ?, ?)
+	`)
+	defer stmt.Close()
+	_, err := stmt.Exec(streak.TeamID, streak.UserID, streak.Current, streak.Best, streak.LastSolved.UTC())
+	return err
+}
+
+// RetrieveStreak retrieves the puzzle streak of a user within a team
+func (s *SqliteStore) RetrieveStreak(teamID string, userID string) (Streak, error) {
+	var streak Streak
+	err := s.db.QueryRow(`
+	select team_id, user_id, current, best, last_solved
+		from puzzle_streaks
+		where team_id = ? and user_id = ?
+	`, teamID, userID).Scan(&streak.TeamID, &streak.UserID, &streak.Current, &streak.Best, &streak.LastSolved)
+	return streak, err
+}
+
+// StoreAttempt stores an attempt at a puzzle by the thread it is played in
+// Note: This will overwrite a previous attempt played in the same thread
+func (s *SqliteStore) StoreAttempt(attempt *Attempt) error {
+	stmt, _ := s.db.Prepare(`
+	insert or replace into puzzle_attempts
+		(team_id, thread_id, channel_id, user_id, puzzle_id, moves, solved, failed, started_at)
+		values (?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`)
+	defer stmt.Close()
+	_, err := stmt.Exec(
+		attempt.TeamID,
+		attempt.ThreadID,
+		attempt.ChannelID,
+		attempt.UserID,
+		attempt.PuzzleID,
+		strings.Join(attempt.Moves, " "),
+		attempt.Solved,
+		attempt.Failed,
+		attempt.StartedAt.UTC(),
+	)
+	return err
+}
+
+// RetrieveAttempt finds the attempt at a puzzle played in a thread
+func (s *SqliteStore) RetrieveAttempt(teamID string, threadID string) (*Attempt, error) {
+	row := s.db.QueryRow(`
+	select team_id, thread_id, channel_id, user_id, puzzle_id, moves, solved, failed, started_at
+		from puzzle_attempts
+		where team_id = ? and thread_id = ?
+	`, teamID, threadID)
+	return scanAttempt(row)
+}
+
+// RetrieveAttempts finds all attempts of a user within a team in the order they started.
+// Attempts are streamed from the DB, so the callback must not use the store itself.
+func (s *SqliteStore) RetrieveAttempts(teamID string, userID string, each func(*Attempt) error) error {
+	rows, err := s.db.Query(`
+	select team_id, thread_id, channel_id, user_id, puzzle_id, moves, solved, failed, started_at
+		from puzzle_attempts
+		where team_id = ? and user_id = ?
+		order by started_at, thread_id
+	`, teamID, userID)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		attempt, err := scanAttempt(rows)
+		if err != nil {
+			return err
+		}
+		if err := each(attempt); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+func scanAttempt(row scanner) (*Attempt, error) {
+	var attempt Attempt
+	var moves string
+	if err := row.Scan(
+		&attempt.TeamID,
+		&attempt.ThreadID,
+		&attempt.ChannelID,
+		&attempt.UserID,
+		&attempt.PuzzleID,
+		&moves,
+		&attempt.Solved,
+		&attempt.Failed,
+		&attempt.StartedAt,
+	); err != nil {
+		return nil, err
+	}
+	attempt.Moves = strings.Fields(moves)
+	return &attempt, nil
+}
+
+// StorePlayerRating stores the puzzle rating of a user within a team
+func (s *SqliteStore) StorePlayerRating(rating PlayerRating) error {
+	stmt, _ := s.db.Prepare(`
+	insert or replace into puzzle_ratings
+		(team_id, user_id, rating, solved, failed)
+		values (?, ?, ?, ?, ?)
+	`)
+	defer stmt.Close()
+	_, err := stmt.Exec(rating.TeamID, rating.UserID, rating.Rating, rating.Solved, rating.Failed)
+	return err
+}
+
+// RetrievePlayerRating retrieves the puzzle rating of a user within a team
+func (s *SqliteStore) RetrievePlayerRating(teamID string, userID string) (PlayerRating, error) {
+	var rating PlayerRating
+	err := s.db.QueryRow(`
+	select team_id, user_id, rating, solved, failed
+		from puzzle_ratings
+		where team_id = ? and user_id = ?
+	`, teamID, userID).Scan(&rating.TeamID, &rating.UserID, &rating.Rating, &rating.Solved, &rating.Failed)
+	return rating, err
+}
+
+// StorePuzzleChannel stores the channel a team has its puzzle of the day posted to
+func (s *SqliteStore) StorePuzzleChannel(channel PuzzleChannel) error {
+	stmt, _ := s.db.Prepare(`
+	insert or replace into puzzle_channels
+		(team_id, channel_id, failures, retry_at, posted_at)
+		values (?, ?, ?, ?, ?)
+	`)
+	defer stmt.Close()
+	_, err := stmt.Exec(channel.TeamID, channel.ChannelID, channel.Failures, channel.RetryAt, channel.PostedAt.UTC())
+	return err
+}
+
+// RetrievePuzzleChannel retrieves the channel a team has its puzzle of the day posted to
+func (s *SqliteStore) RetrievePuzzleChannel(teamID string) (PuzzleChannel, error) {
+	var channel PuzzleChannel
+	err := s.db.QueryRow(`
+	select team_id, channel_id, failures, retry_at, posted_at
+		from puzzle_channels
+		where team_id = ?
+	`, teamID).Scan(&channel.TeamID, &channel.ChannelID, &channel.Failures, &channel.RetryAt, &channel.PostedAt)
+	return channel, err
+}
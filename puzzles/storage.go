@@ -0,0 +1,23 @@
+package puzzles
+
+// PuzzleStorage is an interface to be implemented for persisting puzzles, the puzzles of the day posted and the streaks of their solvers,
+// as well as the attempts and ratings of players playing puzzles and the channel each team has its puzzle of the day posted to.
+// Puzzles of a team are retrieved in the order they were created and attempts of a user in the order they started,
+// each handed to the provided callback one at a time. The next puzzle of a team is the oldest one not posted yet, or nil once all were posted.
+type PuzzleStorage interface {
+	StorePuzzle(puzzle *Puzzle) error
+	RetrievePuzzle(ID string) (*Puzzle, error)
+	RetrievePuzzles(teamID string, each func(*Puzzle) error) error
+	RetrieveNextPuzzle(teamID string) (*Puzzle, error)
+	StoreDailyPuzzle(daily *DailyPuzzle) error
+	RetrieveDailyPuzzle(teamID string, threadID string) (*DailyPuzzle, error)
+	StoreStreak(streak Streak) error
+	RetrieveStreak(teamID string, userID string) (Streak, error)
+	StoreAttempt(attempt *Attempt) error
+	RetrieveAttempt(teamID string, threadID string) (*Attempt, error)
+	RetrieveAttempts(teamID string, userID string, each func(*Attempt) error) error
+	StorePlayerRating(rating PlayerRating) error
+	RetrievePlayerRating(teamID string, userID string) (PlayerRating, error)
+	StorePuzzleChannel(channel PuzzleChannel) error
+	RetrievePuzzleChannel(teamID string) (PuzzleChannel, error)
+}
@@ -0,0 +1,25 @@
+package puzzles_test
+
+import (
+	"testing"
+
+	"github.com/cjsaylor/chessbot/puzzles"
+	"github.com/cjsaylor/chessbot/storagetest"
+	"github.com/cjsaylor/chessbot/storagetest/puzzlestest"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestStoreConformance(t *testing.T) {
+	storagetest.RunStores(t, storagetest.Stores{
+		Memory: func() interface{} { return puzzles.NewMemoryStore() },
+		Sqlite: func(path string) (interface{}, error) { return puzzles.NewSqliteStore(path) },
+		Bolt:   func(db *bolt.DB) (interface{}, error) { return puzzles.NewBoltStore(db) },
+	}, func(t *testing.T, open storagetest.Opener) {
+		puzzlestest.Run(t, func(t *testing.T) puzzlestest.Backend {
+			store := open(t)
+			return puzzlestest.Backend{
+				PuzzleStorage: store.(puzzles.PuzzleStorage),
+			}
+		})
+	})
+}
@@ -0,0 +1,99 @@
+package puzzles
+
+import "time"
+
+// DailyPuzzle is a puzzle of the day posted in a team's channel.
+// Answers are replies in the thread of the post.
+type DailyPuzzle struct {
+	TeamID    string
+	ChannelID string
+	ThreadID  string
+	PuzzleID  string
+	PostedAt  time.Time
+	// Answered holds the users that already answered, each has a single attempt
+	Answered map[string]bool
+}
+
+// Answer records a user's attempt at the puzzle, returning false if they already answered
+func (d *DailyPuzzle) Answer(userID string) bool {
+	if d.Answered[userID] {
+		return false
+	}
+	if d.Answered == nil {
+		d.Answered = make(map[string]bool)
+	}
+	d.Answered[userID] = true
+	return true
+}
+
+// PuzzleChannel is the channel a team has its puzzle of the day posted to.
+// Failures to post are retried with an increasing delay, so a channel the bot can't post to doesn't get retried constantly.
+type PuzzleChannel struct {
+	TeamID    string
+	ChannelID string
+	// Failures is the number of consecutive failures to post, and RetryAt when posting is attempted again after the last one
+	Failures int
+	RetryAt  time.Time
+	// PostedAt is when the last puzzle of the day was posted
+	PostedAt time.Time
+}
+
+// Posted records the puzzle of the day posted at a time, which clears the failures to post before it
+func (c PuzzleChannel) Posted(at time.Time) PuzzleChannel {
+	c.Failures = 0
+	c.RetryAt = time.Time{}
+	c.PostedAt = at
+	return c
+}
+
+// Failed records a failure to post the puzzle of the day, waiting an hour before trying again and twice as long after every failure since, up to a day
+func (c PuzzleChannel) Failed(at time.Time) PuzzleChannel {
+	c.Failures++
+	delay := 24 * time.Hour
+	if c.Failures <= 5 {
+		delay = time.Hour << uint(c.Failures-1)
+	}
+	c.RetryAt = at.Add(delay)
+	return c
+}
+
+// Due determines if the puzzle of the day can be posted to the channel, which is not the case without a channel,
+// once it was posted on the day (UTC) or while waiting to retry a failure
+func (c PuzzleChannel) Due(now time.Time) bool {
+	return c.ChannelID != "" && !now.Before(c.RetryAt) && (c.PostedAt.IsZero() || truncateDay(c.PostedAt).Before(truncateDay(now)))
+}
+
+// Streak counts the consecutive days a user solved the puzzle of the day
+type Streak struct {
+	TeamID     string
+	UserID     string
+	Current    int
+	Best       int
+	LastSolved time.Time
+}
+
+// Record counts a puzzle of the day posted on a day as solved.
+// Solving the puzzle of the day after the last one solved extends the streak, any other day starts a new one.
+func (s Streak) Record(day time.Time) Streak {
+	solved := truncateDay(day)
+	last := truncateDay(s.LastSolved)
+	switch {
+	case !s.LastSolved.IsZero() && !solved.After(last):
+		return s
+	case !s.LastSolved.IsZero() && last.AddDate(0, 0, 1).Equal(solved):
+		s.Current++
+	default:
+		s.Current = 1
+	}
+	if s.Current > s.Best {
+		s.Best = s.Current
+	}
+	s.LastSolved = day
+	return s
+}
+
+// truncateDay is the start of the UTC day of a time
+func truncateDay(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
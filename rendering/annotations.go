@@ -0,0 +1,237 @@
+package rendering
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"net/url"
+	"strings"
+
+	"github.com/fogleman/gg"
+	"github.com/notnil/chess"
+)
+
+// annotationParams are the URL query parameters annotations are encoded in, in the order they are signed
+var annotationParams = []string{"arrows", "highlights", "glyphs"}
+
+const defaultAnnotationColor = "green"
+
+// annotationColors are the colors available for arrows and highlighted squares
+var annotationColors = map[string]color.RGBA{
+	"green":  {21, 120, 27, 255},
+	"red":    {136, 32, 32, 255},
+	"blue":   {0, 48, 136, 255},
+	"yellow": {230, 143, 0, 255},
+}
+
+// glyphColors are the badge colors of each supported move quality glyph
+var glyphColors = map[string]color.RGBA{
+	"!!": {38, 194, 163, 255},
+	"!":  {92, 139, 176, 255},
+	"!?": {117, 163, 72, 255},
+	"?!": {247, 198, 49, 255},
+	"?":  {232, 127, 38, 255},
+	"??": {202, 52, 49, 255},
+}
+
+// Arrow points from one square to another, such as a candidate move
+type Arrow struct {
+	From  chess.Square
+	To    chess.Square
+	Color string
+}
+
+// Highlight marks a square with a color
+type Highlight struct {
+	Square chess.Square
+	Color  string
+}
+
+// Glyph marks a square with a move quality glyph (!!, !, !?, ?!, ?, ??)
+type Glyph struct {
+	Square chess.Square
+	Symbol string
+}
+
+// Annotations are drawn on top of a rendered board
+type Annotations struct {
+	Arrows     []Arrow
+	Highlights []Highlight
+	Glyphs     []Glyph
+}
+
+// IsEmpty determines if there is nothing to draw
+func (a Annotations) IsEmpty() bool {
+	return len(a.Arrows) == 0 && len(a.Highlights) == 0 && len(a.Glyphs) == 0
+}
+
+// ParseAnnotations parses comma separated annotation lists.
+// Arrows are formatted as "e2e4" or "e2e4:red", highlights as "e4" or "e4:red" and glyphs as "e4:!!".
+func ParseAnnotations(arrows string, highlights string, glyphs string) (Annotations, error) {
+	annotations := Annotations{}
+	for _, item := range splitList(arrows) {
+		move, color := splitColor(item)
+		if len(move) != 4 {
+			return annotations, fmt.Errorf("invalid arrow %v", item)
+		}
+		from, err := SquareFromAN(move[:2])
+		if err != nil {
+			return annotations, fmt.Errorf("invalid arrow %v", item)
+		}
+		to, err := SquareFromAN(move[2:])
+		if err != nil || from == to {
+			return annotations, fmt.Errorf("invalid arrow %v", item)
+		}
+		if _, ok := annotationColors[color]; !ok {
+			return annotations, fmt.Errorf("unknown color %v", color)
+		}
+		annotations.Arrows = append(annotations.Arrows, Arrow{From: from, To: to, Color: color})
+	}
+	for _, item := range splitList(highlights) {
+		an, color := splitColor(item)
+		square, err := SquareFromAN(an)
+		if err != nil {
+			return annotations, fmt.Errorf("invalid highlight %v", item)
+		}
+		if _, ok := annotationColors[color]; !ok {
+			return annotations, fmt.Errorf("unknown color %v", color)
+		}
+		annotations.Highlights = append(annotations.Highlights, Highlight{Square: square, Color: color})
+	}
+	for _, item := range splitList(glyphs) {
+		parts := strings.SplitN(item, ":", 2)
+		if len(parts) != 2 {
+			return annotations, fmt.Errorf("invalid glyph %v", item)
+		}
+		square, err := SquareFromAN(parts[0])
+		if err != nil {
+			return annotations, fmt.Errorf("invalid glyph %v", item)
+		}
+		if _, ok := glyphColors[parts[1]]; !ok {
+			return annotations, fmt.Errorf("unknown glyph %v", parts[1])
+		}
+		annotations.Glyphs = append(annotations.Glyphs, Glyph{Square: square, Symbol: parts[1]})
+	}
+	return annotations, nil
+}
+
+// AnnotationsFromQuery parses the annotations encoded in a board URL query
+func AnnotationsFromQuery(query url.Values) (Annotations, error) {
+	return ParseAnnotations(query.Get("arrows"), query.Get("highlights"), query.Get("glyphs"))
+}
+
+// encode adds the annotations to a board URL query
+func (a Annotations) encode(query url.Values) {
+	arrows, highlights, glyphs := []string{}, []string{}, []string{}
+	for _, arrow := range a.Arrows {
+		arrows = append(arrows, fmt.Sprintf("%v%v:%v", arrow.From, arrow.To, arrow.Color))
+	}
+	for _, highlight := range a.Highlights {
+		highlights = append(highlights, fmt.Sprintf("%v:%v", highlight.Square, highlight.Color))
+	}
+	for _, glyph := range a.Glyphs {
+		glyphs = append(glyphs, fmt.Sprintf("%v:%v", glyph.Square, glyph.Symbol))
+	}
+	for i, values := range [][]string{arrows, highlights, glyphs} {
+		if len(values) > 0 {
+			query.Set(annotationParams[i], strings.Join(values, ","))
+		}
+	}
+}
+
+func splitList(list string) []string {
+	items := []string{}
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, strings.ToLower(item))
+		}
+	}
+	return items
+}
+
+func splitColor(item string) (string, string) {
+	parts := strings.SplitN(item, ":", 2)
+	if len(parts) == 1 {
+		return parts[0], defaultAnnotationColor
+	}
+	return parts[0], parts[1]
+}
+
+// squareOrigin returns the top left corner of a square as drawn on the board
+func squareOrigin(square chess.Square, gridSize float64, inverted bool) (float64, float64) {
+	file, rank := int(square.File()), 7-int(square.Rank())
+	if inverted {
+		file, rank = 7-file, 7-rank
+	}
+	return float64(file) * gridSize, float64(rank) * gridSize
+}
+
+func squareCenter(square chess.Square, gridSize float64, inverted bool) gg.Point {
+	x, y := squareOrigin(square, gridSize, inverted)
+	return gg.Point{X: x + gridSize/2, Y: y + gridSize/2}
+}
+
+// arrowPolygon outlines an arrow from the center of one square to the center of another
+func arrowPolygon(arrow Arrow, gridSize float64, inverted bool) []gg.Point {
+	start := squareCenter(arrow.From, gridSize, inverted)
+	tip := squareCenter(arrow.To, gridSize, inverted)
+	length := math.Hypot(tip.X-start.X, tip.Y-start.Y)
+	dx, dy := (tip.X-start.X)/length, (tip.Y-start.Y)/length
+	shaftWidth, headWidth, headLength := gridSize*0.15, gridSize*0.45, gridSize*0.4
+	base := gg.Point{X: tip.X - dx*headLength, Y: tip.Y - dy*headLength}
+	offset := func(p gg.Point, width float64) gg.Point {
+		return gg.Point{X: p.X - dy*width/2, Y: p.Y + dx*width/2}
+	}
+	return []gg.Point{
+		offset(start, shaftWidth),
+		offset(base, shaftWidth),
+		offset(base, headWidth),
+		tip,
+		offset(base, -headWidth),
+		offset(base, -shaftWidth),
+		offset(start, -shaftWidth),
+	}
+}
+
+// glyphBadge is the center and radius of the badge drawn in the top right corner of a square
+func glyphBadge(glyph Glyph, gridSize float64, inverted bool) (gg.Point, float64) {
+	x, y := squareOrigin(glyph.Square, gridSize, inverted)
+	radius := gridSize * 0.2
+	return gg.Point{X: x + gridSize - radius - 1, Y: y + radius + 1}, radius
+}
+
+func drawHighlights(context *gg.Context, annotations Annotations, gridSize float64, inverted bool) {
+	for _, highlight := range annotations.Highlights {
+		x, y := squareOrigin(highlight.Square, gridSize, inverted)
+		c := annotationColors[highlight.Color]
+		context.DrawRectangle(x, y, gridSize, gridSize)
+		context.SetRGBA255(int(c.R), int(c.G), int(c.B), 128)
+		context.Fill()
+	}
+}
+
+func drawArrowsAndGlyphs(context *gg.Context, annotations Annotations, gridSize float64, inverted bool) {
+	for _, arrow := range annotations.Arrows {
+		for _, point := range arrowPolygon(arrow, gridSize, inverted) {
+			context.LineTo(point.X, point.Y)
+		}
+		context.ClosePath()
+		c := annotationColors[arrow.Color]
+		context.SetRGBA255(int(c.R), int(c.G), int(c.B), 200)
+		context.Fill()
+	}
+	if len(annotations.Glyphs) == 0 {
+		return
+	}
+	if face, ok := loadFontFace(labelFonts, gridSize*0.22); ok {
+		context.SetFontFace(face)
+	}
+	for _, glyph := range annotations.Glyphs {
+		center, radius := glyphBadge(glyph, gridSize, inverted)
+		context.DrawCircle(center.X, center.Y, radius)
+		context.SetColor(glyphColors[glyph.Symbol])
+		context.Fill()
+		context.SetRGB255(255, 255, 255)
+		context.DrawStringAnchored(glyph.Symbol, center.X, center.Y, 0.5, 0.35)
+	}
+}
@@ -0,0 +1,204 @@
+package rendering
+
+import (
+	"image"
+	"image/color"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/flopp/go-findfont"
+	"github.com/fogleman/gg"
+	"github.com/golang/freetype/truetype"
+	"github.com/notnil/chess"
+	"golang.org/x/image/font"
+)
+
+const pieceRatio = 0.8
+
+// labelFonts are tried in order for coordinates and glyphs
+var labelFonts = []string{"arial.ttf", "DejaVuSans.ttf"}
+
+// unicodePieceFonts are tried in order for drawing the unicode piece set, they must include the chess symbols
+var unicodePieceFonts = []string{"DejaVuSans.ttf", "Symbola.ttf", "seguisym.ttf"}
+
+// unicodePieces are the unicode chess symbols by piece type, filled and outlined
+var unicodePieces = map[chess.PieceType][2]string{
+	chess.King:   {"♚", "♔"},
+	chess.Queen:  {"♛", "♕"},
+	chess.Rook:   {"♜", "♖"},
+	chess.Bishop: {"♝", "♗"},
+	chess.Knight: {"♞", "♘"},
+	chess.Pawn:   {"♟", "♙"},
+}
+
+var (
+	fonts       sync.Map
+	pieceImages sync.Map
+)
+
+// boardState is the position and markings common to every board renderer
+type boardState struct {
+	board       *chess.Board
+	turn        chess.Color
+	moveNumber  int
+	checkTile   chess.Square
+	lastMove    []chess.Square
+	annotations Annotations
+	frame       Frame
+}
+
+func newBoardState(fen string) (boardState, error) {
+	gameState, err := chess.FEN(fen)
+	if err != nil {
+		return boardState{}, err
+	}
+	position := chess.NewGame(gameState).Position()
+	moveNumber := 1
+	if fields := strings.Fields(fen); len(fields) == 6 {
+		if number, err := strconv.Atoi(fields[5]); err == nil {
+			moveNumber = number
+		}
+	}
+	return boardState{
+		board:      position.Board(),
+		turn:       position.Turn(),
+		moveNumber: moveNumber,
+		checkTile:  chess.NoSquare,
+	}, nil
+}
+
+// SetCheckTile sets the square of the king in check
+func (s *boardState) SetCheckTile(square chess.Square) {
+	s.checkTile = square
+}
+
+// SetLastMove sets the squares of the last move
+func (s *boardState) SetLastMove(from chess.Square, to chess.Square) {
+	s.lastMove = []chess.Square{from, to}
+}
+
+// SetFrame sets the players and clocks shown around framed boards
+func (s *boardState) SetFrame(frame Frame) {
+	s.frame = frame
+}
+
+// SetAnnotations sets the arrows, highlighted squares and glyphs to draw on the board
+func (s *boardState) SetAnnotations(annotations Annotations) {
+	s.annotations = annotations
+}
+
+func (s *boardState) tileColor(square chess.Square, theme Theme) color.RGBA {
+	switch {
+	case square == s.checkTile:
+		return theme.Check
+	case len(s.lastMove) == 2 && square == s.lastMove[0]:
+		return theme.Highlight
+	case len(s.lastMove) == 2 && square == s.lastMove[1]:
+		return theme.HighlightDim
+	case (int(square.File())+int(square.Rank()))%2 == 1:
+		return theme.Light
+	}
+	return theme.Dark
+}
+
+// coordinateLabel is a file or rank symbol drawn along the edge of the board
+type coordinateLabel struct {
+	text  string
+	x, y  float64
+	color color.RGBA
+	// alignRight labels end at x rather than start at it
+	alignRight bool
+}
+
+// coordinateLabels places the file symbols along the bottom and rank symbols along the right of the board.
+// Labels use the color of the opposite square so they stay legible.
+func coordinateLabels(options Options) []coordinateLabel {
+	files, ranks := "abcdefgh", "87654321"
+	if options.Inverted {
+		files, ranks = "hgfedcba", "12345678"
+	}
+	theme := Themes[options.Theme]
+	size := float64(options.BoardSize)
+	gridSize := size / 8
+	labels := []coordinateLabel{}
+	for i := 0; i < 8; i++ {
+		color := theme.Light
+		if i%2 == 1 {
+			color = theme.Dark
+		}
+		labels = append(labels, coordinateLabel{
+			text:  files[i : i+1],
+			x:     float64(i)*gridSize + 2,
+			y:     size - 3,
+			color: color,
+		}, coordinateLabel{
+			text:       ranks[i : i+1],
+			x:          size - 2,
+			y:          float64(i)*gridSize + labelSize(gridSize),
+			color:      color,
+			alignRight: true,
+		})
+	}
+	return labels
+}
+
+func labelSize(gridSize float64) float64 {
+	return gridSize * 0.22
+}
+
+// loadFontFace finds the first of the font files installed on the system at a given size
+func loadFontFace(names []string, points float64) (font.Face, bool) {
+	for _, name := range names {
+		if parsed, ok := fonts.Load(name); ok {
+			return truetype.NewFace(parsed.(*truetype.Font), &truetype.Options{Size: points}), true
+		}
+		path, err := findfont.Find(name)
+		if err != nil {
+			continue
+		}
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		parsed, err := truetype.Parse(data)
+		if err != nil {
+			continue
+		}
+		fonts.Store(name, parsed)
+		return truetype.NewFace(parsed, &truetype.Options{Size: points}), true
+	}
+	return nil, false
+}
+
+// loadPieceImage loads (and caches) the image of a piece from a piece set directory
+func loadPieceImage(assetPath string, piece chess.Piece) (image.Image, error) {
+	path := assetPath + pieceFiles[pieceSymbol(piece)]
+	if img, ok := pieceImages.Load(path); ok {
+		return img.(image.Image), nil
+	}
+	img, err := gg.LoadPNG(path)
+	if err != nil {
+		return nil, err
+	}
+	pieceImages.Store(path, img)
+	return img, nil
+}
+
+// pieceSymbol is the FEN symbol of a piece, upper case for white
+func pieceSymbol(piece chess.Piece) string {
+	symbols := map[chess.PieceType]string{
+		chess.King:   "k",
+		chess.Queen:  "q",
+		chess.Rook:   "r",
+		chess.Bishop: "b",
+		chess.Knight: "n",
+		chess.Pawn:   "p",
+	}
+	symbol := symbols[piece.Type()]
+	if piece.Color() == chess.White {
+		return string(symbol[0] - 'a' + 'A')
+	}
+	return symbol
+}
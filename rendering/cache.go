@@ -0,0 +1,168 @@
+package rendering
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+// RenderCache is a bounded least recently used cache of encoded board images.
+// When a directory is configured, entries are also written to disk so the cache survives restarts.
+type RenderCache struct {
+	mu       sync.Mutex
+	capacity int
+	dir      string
+	entries  map[string]*list.Element
+	order    *list.List
+	hits     uint64
+	misses   uint64
+}
+
+// CacheStats are the counters of a RenderCache
+type CacheStats struct {
+	Hits    uint64
+	Misses  uint64
+	Entries int
+}
+
+type cacheEntry struct {
+	key  string
+	data []byte
+}
+
+// NewRenderCache creates a cache holding up to capacity images.
+// If dir is not empty, images previously persisted there are loaded (most recent first) and new images are persisted.
+func NewRenderCache(capacity int, dir string) (*RenderCache, error) {
+	c := &RenderCache{
+		capacity: capacity,
+		dir:      dir,
+		entries:  make(map[string]*list.Element, capacity),
+		order:    list.New(),
+	}
+	if dir == "" {
+		return c, nil
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].ModTime().Before(files[j].ModTime())
+	})
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+		data, err := ioutil.ReadFile(filepath.Join(dir, file.Name()))
+		if err != nil {
+			return nil, err
+		}
+		c.add(file.Name(), data, false)
+	}
+	return c, nil
+}
+
+// Get retrieves an image by its key, marking it as recently used
+func (c *RenderCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	element, ok := c.entries[key]
+	if !ok {
+		c.misses++
+		return nil, false
+	}
+	c.hits++
+	c.order.MoveToFront(element)
+	return element.Value.(*cacheEntry).data, true
+}
+
+// Add stores an image by its key, evicting the least recently used image when the cache is full
+func (c *RenderCache) Add(key string, data []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.add(key, data, c.dir != "")
+}
+
+func (c *RenderCache) add(key string, data []byte, persist bool) {
+	if c.capacity <= 0 {
+		return
+	}
+	if element, ok := c.entries[key]; ok {
+		element.Value.(*cacheEntry).data = data
+		c.order.MoveToFront(element)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&cacheEntry{key: key, data: data})
+	if persist {
+		if err := ioutil.WriteFile(filepath.Join(c.dir, key), data, 0600); err != nil {
+			log.Println(err)
+		}
+	}
+	for c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		entry := c.order.Remove(oldest).(*cacheEntry)
+		delete(c.entries, entry.key)
+		if c.dir != "" {
+			if err := os.Remove(filepath.Join(c.dir, entry.key)); err != nil && !os.IsNotExist(err) {
+				log.Println(err)
+			}
+		}
+	}
+}
+
+// Stats returns the hit and miss counts and the number of cached images
+func (c *RenderCache) Stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return CacheStats{
+		Hits:    c.hits,
+		Misses:  c.misses,
+		Entries: c.order.Len(),
+	}
+}
+
+// renderKey identifies a rendered board by its format and the canonical form of every parameter affecting the image.
+// Signing parameters are excluded, so links to the same board signed at different times share an image.
+func renderKey(contentType string, query url.Values, annotations Annotations, frame Frame, options Options) string {
+	canonical := url.Values{}
+	for _, param := range []string{"fen", "from", "to", "check"} {
+		if value := query.Get(param); value != "" {
+			canonical.Set(param, value)
+		}
+	}
+	if options.Inverted {
+		canonical.Set("inverted", "true")
+	}
+	annotations.encode(canonical)
+	frame.encode(canonical)
+	options.encode(canonical)
+	sum := sha256.Sum256([]byte(contentType + "\n" + canonical.Encode()))
+	return hex.EncodeToString(sum[:])
+}
+
+// CacheStatsHandler serves the counters of a render cache as JSON.
+// Operational details aren't meant for the public, so it should only be served on a private listener.
+type CacheStatsHandler struct {
+	Cache *RenderCache
+}
+
+func (h CacheStatsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(h.Cache.Stats())
+}
@@ -0,0 +1,98 @@
+package rendering_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/cjsaylor/chessbot/rendering"
+)
+
+func TestRenderCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	cache, _ := rendering.NewRenderCache(2, "")
+	cache.Add("a", []byte("a"))
+	cache.Add("b", []byte("b"))
+	cache.Get("a")
+	cache.Add("c", []byte("c"))
+	if _, ok := cache.Get("b"); ok {
+		t.Error("expected the least recently used image to be evicted")
+	}
+	if data, ok := cache.Get("a"); !ok || string(data) != "a" {
+		t.Error("expected the recently used image to be cached")
+	}
+	stats := cache.Stats()
+	if stats.Hits != 2 || stats.Misses != 1 || stats.Entries != 2 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+}
+
+func TestRenderCachePersistence(t *testing.T) {
+	dir, err := ioutil.TempDir("", "chessbot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	cache, err := rendering.NewRenderCache(2, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache.Add("a", []byte("a"))
+	time.Sleep(10 * time.Millisecond)
+	cache.Add("b", []byte("b"))
+	cache, err = rendering.NewRenderCache(1, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data, ok := cache.Get("b"); !ok || string(data) != "b" {
+		t.Error("expected the most recent image to be loaded from disk")
+	}
+	if _, ok := cache.Get("a"); ok {
+		t.Error("expected the oldest image to be evicted when loading")
+	}
+	if files, _ := ioutil.ReadDir(dir); len(files) != 1 {
+		t.Errorf("expected evicted images to be removed from disk, found %v files", len(files))
+	}
+}
+
+func TestBoardRenderHandlerETag(t *testing.T) {
+	renderLink := rendering.NewRenderLink("", "secret")
+	cache, _ := rendering.NewRenderCache(10, "")
+	handler := rendering.BoardRenderHandler{LinkRenderer: renderLink, AssetPath: "../assets/", Cache: cache}
+	link := svgLink(renderLink)
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, link, nil))
+	etag := recorder.Header().Get("ETag")
+	if recorder.Code != http.StatusOK || etag == "" {
+		t.Fatalf("expected a rendered board with an ETag, got status %v", recorder.Code)
+	}
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, svgLink(renderLink.WithTTL(time.Hour)), nil))
+	if recorder.Header().Get("ETag") != etag || recorder.Body.Len() == 0 {
+		t.Error("expected links to the same board to share the cached image")
+	}
+
+	request := httptest.NewRequest(http.MethodGet, link, nil)
+	request.Header.Set("If-None-Match", etag)
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	if recorder.Code != http.StatusNotModified || recorder.Body.Len() != 0 {
+		t.Errorf("expected status %v without a body, got %v", http.StatusNotModified, recorder.Code)
+	}
+
+	if stats := cache.Stats(); stats.Hits != 1 || stats.Misses != 1 {
+		t.Errorf("expected 1 hit and 1 miss, got %+v", stats)
+	}
+
+	recorder = httptest.NewRecorder()
+	rendering.CacheStatsHandler{Cache: cache}.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/cache", nil))
+	var served rendering.CacheStats
+	if err := json.NewDecoder(recorder.Body).Decode(&served); err != nil || served != cache.Stats() {
+		t.Errorf("expected the cache stats to be served, got %+v (%v)", served, err)
+	}
+}
@@ -0,0 +1,168 @@
+package rendering
+
+import (
+	"errors"
+	"fmt"
+	"image/color"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/notnil/chess"
+)
+
+// frameParams are the URL query parameters the players of framed boards are encoded in
+var frameParams = []string{"white", "black", "wclock", "bclock"}
+
+// pieceValues are the conventional material values of each piece type
+var pieceValues = map[chess.PieceType]int{
+	chess.Queen:  9,
+	chess.Rook:   5,
+	chess.Bishop: 3,
+	chess.Knight: 3,
+	chess.Pawn:   1,
+}
+
+// startingPieces are the number of pieces of each type a side starts the game with
+var startingPieces = map[chess.PieceType]int{
+	chess.Queen:  1,
+	chess.Rook:   2,
+	chess.Bishop: 2,
+	chess.Knight: 2,
+	chess.Pawn:   8,
+}
+
+// capturablePieces are the pieces of each side that can be captured, most valuable first
+var capturablePieces = map[chess.Color][]chess.Piece{
+	chess.White: {chess.WhiteQueen, chess.WhiteRook, chess.WhiteBishop, chess.WhiteKnight, chess.WhitePawn},
+	chess.Black: {chess.BlackQueen, chess.BlackRook, chess.BlackBishop, chess.BlackKnight, chess.BlackPawn},
+}
+
+// Colors of the player bars of framed boards
+var (
+	frameBackground = color.RGBA{38, 36, 33, 255}
+	frameText       = color.RGBA{255, 255, 255, 255}
+	frameDimText    = color.RGBA{160, 160, 160, 255}
+)
+
+// errInvalidClock is returned for a clock that isn't a number of seconds
+var errInvalidClock = errors.New("invalid clock")
+
+// Frame holds the players shown above and below framed boards
+type Frame struct {
+	White string
+	Black string
+	// WhiteClock and BlackClock are the remaining time of each player, and aren't shown when zero
+	WhiteClock time.Duration
+	BlackClock time.Duration
+}
+
+// FrameFromQuery parses the players of a framed board encoded in a board URL query
+func FrameFromQuery(query url.Values) (Frame, error) {
+	frame := Frame{
+		White: query.Get("white"),
+		Black: query.Get("black"),
+	}
+	clocks := map[string]*time.Duration{
+		"wclock": &frame.WhiteClock,
+		"bclock": &frame.BlackClock,
+	}
+	for param, clock := range clocks {
+		value := query.Get(param)
+		if value == "" {
+			continue
+		}
+		seconds, err := strconv.Atoi(value)
+		if err != nil || seconds < 0 {
+			return frame, errInvalidClock
+		}
+		*clock = time.Duration(seconds) * time.Second
+	}
+	return frame, nil
+}
+
+// encode adds the players to a board URL query, clocks are encoded in seconds
+func (f Frame) encode(query url.Values) {
+	if f.White != "" {
+		query.Set("white", f.White)
+	}
+	if f.Black != "" {
+		query.Set("black", f.Black)
+	}
+	if f.WhiteClock > 0 {
+		query.Set("wclock", strconv.Itoa(int(f.WhiteClock/time.Second)))
+	}
+	if f.BlackClock > 0 {
+		query.Set("bclock", strconv.Itoa(int(f.BlackClock/time.Second)))
+	}
+}
+
+// frameBar is the row of a framed board showing one player
+type frameBar struct {
+	y      float64
+	name   string
+	clock  string
+	toMove bool
+	// captured are the opponent's pieces captured by the player
+	captured []chess.Piece
+	// advantage is the material the player is ahead by, if any
+	advantage int
+}
+
+// frameHeight is the height of each player bar of a framed board
+func frameHeight(options Options) float64 {
+	return float64(options.BoardSize) / 8 * 0.75
+}
+
+// frameBars lays out the player bars above and below the board, the player at the bottom is the one the board is oriented for
+func (s *boardState) frameBars(options Options) []frameBar {
+	material := map[chess.Color]int{}
+	remaining := map[chess.Piece]int{}
+	for _, piece := range s.board.SquareMap() {
+		material[piece.Color()] += pieceValues[piece.Type()]
+		remaining[piece]++
+	}
+	names := map[chess.Color]string{chess.White: s.frame.White, chess.Black: s.frame.Black}
+	clocks := map[chess.Color]time.Duration{chess.White: s.frame.WhiteClock, chess.Black: s.frame.BlackClock}
+	top, bottom := chess.Black, chess.White
+	if options.Inverted {
+		top, bottom = chess.White, chess.Black
+	}
+	bars := []frameBar{}
+	for i, side := range []chess.Color{top, bottom} {
+		bar := frameBar{
+			y:         float64(i) * (frameHeight(options) + float64(options.BoardSize)),
+			name:      names[side],
+			clock:     formatClock(clocks[side]),
+			toMove:    s.turn == side,
+			advantage: material[side] - material[side.Other()],
+		}
+		if bar.name == "" {
+			bar.name = side.Name()
+		}
+		for _, piece := range capturablePieces[side.Other()] {
+			for i := remaining[piece]; i < startingPieces[piece.Type()]; i++ {
+				bar.captured = append(bar.captured, piece)
+			}
+		}
+		bars = append(bars, bar)
+	}
+	return bars
+}
+
+// moveLabel is the move number shown in the top player bar
+func (s *boardState) moveLabel() string {
+	return fmt.Sprintf("Move %v", s.moveNumber)
+}
+
+// formatClock formats the remaining time of a clock as minutes and seconds (and hours when needed)
+func formatClock(clock time.Duration) string {
+	if clock <= 0 {
+		return ""
+	}
+	seconds := int(clock / time.Second)
+	if seconds >= 3600 {
+		return fmt.Sprintf("%d:%02d:%02d", seconds/3600, seconds/60%60, seconds%60)
+	}
+	return fmt.Sprintf("%d:%02d", seconds/60, seconds%60)
+}
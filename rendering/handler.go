@@ -2,16 +2,34 @@
 package rendering
 
 import (
+	"bytes"
 	"image/png"
 	"log"
 	"net/http"
-
-	"github.com/cjsaylor/chessimage"
+	"net/url"
+	"strings"
 )
 
 // BoardRenderHandler handles all image requests from Slack
+// Boards are rendered as SVG when requested by the ".svg" extension or an Accept header preferring SVG,
+// otherwise they are rendered as PNG.
 type BoardRenderHandler struct {
 	LinkRenderer RenderLink
+	// AssetPath is the directory of piece images, defaults to "./assets/"
+	AssetPath string
+	// Cache of encoded images, rendering every request when nil
+	Cache *RenderCache
+}
+
+// wantsSVG determines the image format from the path extension, falling back to the Accept header
+func wantsSVG(r *http.Request) bool {
+	switch {
+	case strings.HasSuffix(r.URL.Path, ".svg"):
+		return true
+	case strings.HasSuffix(r.URL.Path, ".png"):
+		return false
+	}
+	return strings.Contains(r.Header.Get("Accept"), "image/svg+xml")
 }
 
 // ServeHTTP is a request handler
@@ -20,8 +38,7 @@ func (b BoardRenderHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusMethodNotAllowed)
 		return
 	}
-	query := r.URL.Query()
-	fen := query.Get("fen")
+	fen := r.URL.Query().Get("fen")
 	if fen == "" {
 		w.WriteHeader(http.StatusBadRequest)
 		return
@@ -30,30 +47,110 @@ func (b BoardRenderHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusForbidden)
 		return
 	}
-	board, err := chessimage.NewRendererFromFEN(fen)
+	query := b.LinkRenderer.SignedQuery(*r.URL)
+	annotations, err := AnnotationsFromQuery(query)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
+		w.WriteHeader(http.StatusBadRequest)
 		return
 	}
-	if from := query.Get("from"); from != "" {
-		to := query.Get("to")
-		tFrom, _ := chessimage.TileFromAN(from)
-		tTo, _ := chessimage.TileFromAN(to)
-		board.SetLastMove(chessimage.LastMove{
-			From: tFrom,
-			To:   tTo,
-		})
+	options, err := OptionsFromQuery(query, b.AssetPath)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
 	}
-	if check := query.Get("check"); check != "" {
-		tCheck, _ := chessimage.TileFromAN(check)
-		board.SetCheckTile(tCheck)
+	frame, err := FrameFromQuery(query)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
 	}
-
-	inverted := query.Get("inverted") == "true"
-	image, err := board.Render(chessimage.Options{AssetPath: "./assets/", Inverted: inverted})
+	if !strings.HasSuffix(r.URL.Path, ".svg") && !strings.HasSuffix(r.URL.Path, ".png") {
+		w.Header().Add("Vary", "Accept")
+	}
+	contentType := "image/png"
+	if wantsSVG(r) {
+		contentType = "image/svg+xml"
+	}
+	key := renderKey(contentType, query, annotations, frame, options)
+	etag := `"` + key + `"`
+	w.Header().Set("ETag", etag)
+	w.Header().Add("Cache-Control", "max-age=7776000")
+	if matchesETag(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	if b.Cache != nil {
+		if image, ok := b.Cache.Get(key); ok {
+			w.Header().Add("Content-Type", contentType)
+			w.Write(image)
+			return
+		}
+	}
+	image, err := renderBoard(contentType, fen, query, annotations, frame, options)
 	if err != nil {
 		log.Println(err)
+		w.Header().Del("ETag")
+		w.Header().Del("Cache-Control")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
 	}
-	w.Header().Add("Cache-Control", "max-age=7776000")
-	png.Encode(w, image)
+	if b.Cache != nil {
+		b.Cache.Add(key, image)
+	}
+	w.Header().Add("Content-Type", contentType)
+	w.Write(image)
+}
+
+// renderBoard encodes the board of the FEN as an image of the content type
+func renderBoard(contentType string, fen string, query url.Values, annotations Annotations, frame Frame, options Options) ([]byte, error) {
+	var image bytes.Buffer
+	if contentType == "image/svg+xml" {
+		board, err := NewSVGRendererFromFEN(fen)
+		if err != nil {
+			return nil, err
+		}
+		applyMarkings(&board.boardState, query, annotations, frame)
+		if err := board.Render(&image, options); err != nil {
+			return nil, err
+		}
+		return image.Bytes(), nil
+	}
+	board, err := NewPNGRendererFromFEN(fen)
+	if err != nil {
+		return nil, err
+	}
+	applyMarkings(&board.boardState, query, annotations, frame)
+	rendered, err := board.Render(options)
+	if err != nil {
+		return nil, err
+	}
+	if err := png.Encode(&image, rendered); err != nil {
+		return nil, err
+	}
+	return image.Bytes(), nil
+}
+
+// matchesETag determines if an If-None-Match header matches the entity tag
+func matchesETag(ifNoneMatch string, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// applyMarkings sets the last move and check highlights of the board URL query along with its annotations and players
+func applyMarkings(state *boardState, query url.Values, annotations Annotations, frame Frame) {
+	if from := query.Get("from"); from != "" {
+		sFrom, _ := SquareFromAN(from)
+		sTo, _ := SquareFromAN(query.Get("to"))
+		state.SetLastMove(sFrom, sTo)
+	}
+	if check := query.Get("check"); check != "" {
+		sCheck, _ := SquareFromAN(check)
+		state.SetCheckTile(sCheck)
+	}
+	state.SetAnnotations(annotations)
+	state.SetFrame(frame)
 }
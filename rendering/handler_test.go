@@ -0,0 +1,216 @@
+package rendering_test
+
+import (
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cjsaylor/chessbot/game"
+	"github.com/cjsaylor/chessbot/rendering"
+)
+
+func svgLink(renderLink rendering.RenderLink) string {
+	gm := game.NewGame("1", game.Player{ID: "a"}, game.Player{ID: "b"})
+	gm.Move("e2e4")
+	link, _ := renderLink.CreateLink(gm)
+	link.Path = "/board.svg"
+	return link.String()
+}
+
+func TestRenderSVGByExtension(t *testing.T) {
+	renderLink := rendering.NewRenderLink("", "secret")
+	recorder := httptest.NewRecorder()
+	handler := rendering.BoardRenderHandler{LinkRenderer: renderLink, AssetPath: "../assets/"}
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, svgLink(renderLink), nil))
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected status %v, got %v", http.StatusOK, recorder.Code)
+	}
+	if contentType := recorder.Header().Get("Content-Type"); contentType != "image/svg+xml" {
+		t.Errorf("expected an SVG content type, got %v", contentType)
+	}
+	body := recorder.Body.String()
+	if !strings.HasPrefix(body, "<svg") || !strings.HasSuffix(body, "</svg>") {
+		t.Errorf("expected an SVG document, got %v", body)
+	}
+	if squares := strings.Count(body, "<rect "); squares != 64 {
+		t.Errorf("expected 64 squares, got %v", squares)
+	}
+	if pieces := strings.Count(body, "<use "); pieces != 32 {
+		t.Errorf("expected 32 pieces, got %v", pieces)
+	}
+	if pawns := strings.Count(body, `xlink:href="#piece-p"`); pawns != 8 {
+		t.Errorf("expected 8 black pawns, got %v", pawns)
+	}
+	if !strings.Contains(body, `fill="#cdd27a"`) {
+		t.Error("expected the last move to be highlighted")
+	}
+}
+
+func TestRenderSVGByAcceptHeader(t *testing.T) {
+	renderLink := rendering.NewRenderLink("", "secret")
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, strings.Replace(svgLink(renderLink), "/board.svg", "/board", 1), nil)
+	request.Header.Set("Accept", "image/svg+xml,image/*;q=0.8")
+	rendering.BoardRenderHandler{LinkRenderer: renderLink, AssetPath: "../assets/"}.ServeHTTP(recorder, request)
+	if contentType := recorder.Header().Get("Content-Type"); contentType != "image/svg+xml" {
+		t.Errorf("expected an SVG content type, got %v", contentType)
+	}
+	if vary := recorder.Header().Get("Vary"); vary != "Accept" {
+		t.Errorf("expected the response to vary by Accept, got %q", vary)
+	}
+}
+
+func TestRenderSVGRejectsUnsignedLinks(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	handler := rendering.BoardRenderHandler{LinkRenderer: rendering.NewRenderLink("", "secret")}
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/board.svg?fen=8/8/8/8/8/8/8/8+w+-+-+0+1&signature=bad", nil))
+	if recorder.Code != http.StatusForbidden {
+		t.Errorf("expected status %v, got %v", http.StatusForbidden, recorder.Code)
+	}
+}
+
+func TestAnnotatedLinkSignsAnnotations(t *testing.T) {
+	renderLink := rendering.NewRenderLink("", "secret")
+	annotations, err := rendering.ParseAnnotations("e2e4,g1f3:red", "d5:blue", "e4:!!")
+	if err != nil {
+		t.Fatal(err)
+	}
+	gm := game.NewGame("1", game.Player{ID: "a"}, game.Player{ID: "b"})
+	link, _ := renderLink.CreateAnnotatedLink(gm, annotations)
+	if !renderLink.ValidateLink(*link) {
+		t.Error("expected the annotated link to be valid")
+	}
+	q := link.Query()
+	q.Set("arrows", "e2e4,d1h5")
+	link.RawQuery = q.Encode()
+	if renderLink.ValidateLink(*link) {
+		t.Error("expected a link with tampered annotations to be invalid")
+	}
+	q.Del("arrows")
+	q.Del("highlights")
+	q.Del("glyphs")
+	link.RawQuery = q.Encode()
+	if renderLink.ValidateLink(*link) {
+		t.Error("expected a link with removed annotations to be invalid")
+	}
+
+	link, _ = renderLink.CreateAnnotatedLink(gm, annotations)
+	link.Path = "/board.svg"
+	recorder := httptest.NewRecorder()
+	rendering.BoardRenderHandler{LinkRenderer: renderLink, AssetPath: "../assets/"}.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, link.String(), nil))
+	body := recorder.Body.String()
+	if arrows := strings.Count(body, "<polygon "); arrows != 2 {
+		t.Errorf("expected 2 arrows, got %v", arrows)
+	}
+	if !strings.Contains(body, ">!!</text>") {
+		t.Error("expected the glyph to be drawn")
+	}
+}
+
+func TestInvalidAnnotations(t *testing.T) {
+	for _, lists := range [][]string{
+		{"e2e9", "", ""},
+		{"e2e2", "", ""},
+		{"e2e4:purple", "", ""},
+		{"", "z1", ""},
+		{"", "", "e4:!!!"},
+		{"", "", "e4"},
+	} {
+		if _, err := rendering.ParseAnnotations(lists[0], lists[1], lists[2]); err == nil {
+			t.Errorf("expected an error parsing %q", lists)
+		}
+	}
+}
+
+func TestDisplayOptions(t *testing.T) {
+	renderLink := rendering.NewRenderLink("", "secret")
+	gm := game.NewGame("1", game.Player{ID: "a"}, game.Player{ID: "b"})
+	link, _ := renderLink.WithOptions(rendering.Options{
+		Theme:           "green",
+		PieceSet:        rendering.UnicodePieceSet,
+		BoardSize:       256,
+		HideCoordinates: true,
+	}).CreateLink(gm)
+	q := link.Query()
+	if q.Get("theme") != "green" || q.Get("pieces") != "unicode" || q.Get("size") != "256" || q.Get("coords") != "false" {
+		t.Errorf("expected the display options to be encoded in the link, got %v", link.RawQuery)
+	}
+	link.Path = "/board.svg"
+	recorder := httptest.NewRecorder()
+	handler := rendering.BoardRenderHandler{LinkRenderer: renderLink, AssetPath: "../assets/"}
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, link.String(), nil))
+	body := recorder.Body.String()
+	if !strings.Contains(body, `width="256"`) || !strings.Contains(body, `fill="#769656"`) {
+		t.Error("expected a green board of 256 pixels")
+	}
+	if strings.Contains(body, ">a</text>") {
+		t.Error("expected coordinates to be hidden")
+	}
+	if pawns := strings.Count(body, "♟"); pawns != 16 {
+		t.Errorf("expected 16 unicode pawns, got %v", pawns)
+	}
+
+	q.Set("theme", "blue")
+	link.RawQuery = q.Encode()
+	if renderLink.ValidateLink(*link) {
+		t.Error("expected a link with a tampered theme to be invalid")
+	}
+}
+
+func TestInvalidDisplayOptions(t *testing.T) {
+	for _, options := range []rendering.Options{
+		{Theme: "neon"},
+		{PieceSet: "missing"},
+		{BoardSize: 64},
+		{BoardSize: 4096},
+	} {
+		options.AssetPath = "../assets/"
+		if err := options.Validate(); err == nil {
+			t.Errorf("expected an error validating %v", options)
+		}
+	}
+}
+
+func TestFramedBoard(t *testing.T) {
+	renderLink := rendering.NewRenderLink("", "secret")
+	gm := game.NewGame("1", game.Player{ID: "a"}, game.Player{ID: "b"})
+	for _, move := range []string{"e2e4", "d7d5", "e4d5"} {
+		gm.Move(move)
+	}
+	link, _ := renderLink.WithOptions(rendering.Options{Framed: true}).WithFrame(rendering.Frame{
+		White:      "Alice",
+		Black:      "Bob",
+		WhiteClock: 5 * time.Minute,
+	}).CreateLink(gm)
+	handler := rendering.BoardRenderHandler{LinkRenderer: renderLink, AssetPath: "../assets/"}
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, link.String(), nil))
+	board, err := png.Decode(recorder.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if size := board.Bounds().Size(); size.X != 512 || size.Y != 608 {
+		t.Errorf("expected a 512x608 framed board, got %v", size)
+	}
+
+	link.Path = "/board.svg"
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, link.String(), nil))
+	body := recorder.Body.String()
+	for _, expected := range []string{">Alice<", ">Bob<", ">5:00<", ">Move 2<", ">+1<"} {
+		if !strings.Contains(body, expected) {
+			t.Errorf("expected the framed board to include %v", expected)
+		}
+	}
+
+	q := link.Query()
+	q.Set("white", "Mallory")
+	link.RawQuery = q.Encode()
+	if renderLink.ValidateLink(*link) {
+		t.Error("expected a link with a tampered player name to be invalid")
+	}
+}
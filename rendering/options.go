@@ -0,0 +1,233 @@
+package rendering
+
+import (
+	"errors"
+	"fmt"
+	"image/color"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+)
+
+// Board size limits (in pixels) of rendered boards
+const (
+	DefaultBoardSize = 512
+	MinBoardSize     = 128
+	MaxBoardSize     = 1024
+)
+
+// Piece sets that are always available. Additional sets are any directory
+// within the asset path that contains an image for each of the 12 pieces.
+const (
+	ClassicPieceSet = "classic"
+	UnicodePieceSet = "unicode"
+)
+
+const (
+	defaultAssetPath = "./assets/"
+	defaultTheme     = "brown"
+)
+
+// optionParams are the URL query parameters display options are encoded in
+var optionParams = []string{"theme", "pieces", "size", "coords", "frame"}
+
+// Theme is a color scheme of the board
+type Theme struct {
+	Light        color.RGBA
+	Dark         color.RGBA
+	Highlight    color.RGBA
+	HighlightDim color.RGBA
+	Check        color.RGBA
+}
+
+// Themes are the selectable board color schemes by name
+var Themes = map[string]Theme{
+	"brown": {
+		Light:        color.RGBA{239, 218, 183, 255},
+		Dark:         color.RGBA{180, 135, 102, 255},
+		Highlight:    color.RGBA{205, 210, 122, 255},
+		HighlightDim: color.RGBA{170, 160, 75, 255},
+		Check:        color.RGBA{227, 30, 32, 255},
+	},
+	"green": {
+		Light:        color.RGBA{238, 238, 210, 255},
+		Dark:         color.RGBA{118, 150, 86, 255},
+		Highlight:    color.RGBA{246, 246, 130, 255},
+		HighlightDim: color.RGBA{186, 202, 68, 255},
+		Check:        color.RGBA{227, 30, 32, 255},
+	},
+	"blue": {
+		Light:        color.RGBA{222, 227, 230, 255},
+		Dark:         color.RGBA{140, 162, 173, 255},
+		Highlight:    color.RGBA{155, 199, 0, 255},
+		HighlightDim: color.RGBA{110, 160, 20, 255},
+		Check:        color.RGBA{227, 30, 32, 255},
+	},
+	"gray": {
+		Light:        color.RGBA{220, 220, 220, 255},
+		Dark:         color.RGBA{150, 150, 150, 255},
+		Highlight:    color.RGBA{205, 210, 122, 255},
+		HighlightDim: color.RGBA{170, 160, 75, 255},
+		Check:        color.RGBA{227, 30, 32, 255},
+	},
+	"purple": {
+		Light:        color.RGBA{232, 222, 240, 255},
+		Dark:         color.RGBA{145, 118, 171, 255},
+		Highlight:    color.RGBA{239, 200, 110, 255},
+		HighlightDim: color.RGBA{214, 165, 70, 255},
+		Check:        color.RGBA{227, 30, 32, 255},
+	},
+}
+
+// ThemeNames lists the names of all themes in alphabetical order
+func ThemeNames() []string {
+	names := make([]string, 0, len(Themes))
+	for name := range Themes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// pieceFiles are the asset file names of each piece, by FEN symbol
+var pieceFiles = map[string]string{
+	"b": "bd.png",
+	"B": "bl.png",
+	"k": "kd.png",
+	"K": "kl.png",
+	"n": "nd.png",
+	"N": "nl.png",
+	"p": "pd.png",
+	"P": "pl.png",
+	"q": "qd.png",
+	"Q": "ql.png",
+	"r": "rd.png",
+	"R": "rl.png",
+}
+
+// PieceSets lists the piece sets available within an asset path (or the default asset path if empty)
+func PieceSets(assetPath string) []string {
+	if assetPath == "" {
+		assetPath = defaultAssetPath
+	}
+	sets := []string{ClassicPieceSet, UnicodePieceSet}
+	dirs, _ := ioutil.ReadDir(assetPath)
+	for _, dir := range dirs {
+		if dir.IsDir() && isPieceSet(filepath.Join(assetPath, dir.Name())) {
+			sets = append(sets, dir.Name())
+		}
+	}
+	return sets
+}
+
+func isPieceSet(dir string) bool {
+	for _, file := range pieceFiles {
+		if _, err := os.Stat(filepath.Join(dir, file)); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// Options holds all possible rendering options of a board
+type Options struct {
+	AssetPath       string
+	Theme           string
+	PieceSet        string
+	BoardSize       int
+	HideCoordinates bool
+	Inverted        bool
+	// Framed boards are surrounded by the players, their clocks and captured material
+	Framed bool
+}
+
+func (o Options) withDefaults() Options {
+	if o.AssetPath == "" {
+		o.AssetPath = defaultAssetPath
+	}
+	if _, ok := Themes[o.Theme]; !ok {
+		o.Theme = defaultTheme
+	}
+	if o.PieceSet == "" {
+		o.PieceSet = ClassicPieceSet
+	}
+	if o.BoardSize <= 0 {
+		o.BoardSize = DefaultBoardSize
+	}
+	// boards are drawn in whole pixel squares
+	o.BoardSize -= o.BoardSize % 8
+	return o
+}
+
+// pieceAssetPath is the directory the images of the option's piece set are found in
+func (o Options) pieceAssetPath() string {
+	if o.PieceSet == ClassicPieceSet || o.PieceSet == UnicodePieceSet {
+		return o.AssetPath
+	}
+	return filepath.Join(o.AssetPath, o.PieceSet) + string(filepath.Separator)
+}
+
+// Validate ensures the theme, piece set and board size are supported
+func (o Options) Validate() error {
+	if _, ok := Themes[o.Theme]; o.Theme != "" && !ok {
+		return fmt.Errorf("unknown theme %v", o.Theme)
+	}
+	if o.PieceSet != "" {
+		found := false
+		for _, set := range PieceSets(o.AssetPath) {
+			found = found || set == o.PieceSet
+		}
+		if !found {
+			return fmt.Errorf("unknown piece set %v", o.PieceSet)
+		}
+	}
+	if o.BoardSize != 0 && (o.BoardSize < MinBoardSize || o.BoardSize > MaxBoardSize) {
+		return fmt.Errorf("board size must be between %v and %v", MinBoardSize, MaxBoardSize)
+	}
+	return nil
+}
+
+// errInvalidBoardSize is returned for a board size that isn't a number
+var errInvalidBoardSize = errors.New("invalid board size")
+
+// OptionsFromQuery parses the display options encoded in a board URL query
+func OptionsFromQuery(query url.Values, assetPath string) (Options, error) {
+	options := Options{
+		AssetPath:       assetPath,
+		Theme:           query.Get("theme"),
+		PieceSet:        query.Get("pieces"),
+		HideCoordinates: query.Get("coords") == "false",
+		Inverted:        query.Get("inverted") == "true",
+		Framed:          query.Get("frame") == "true",
+	}
+	if size := query.Get("size"); size != "" {
+		boardSize, err := strconv.Atoi(size)
+		if err != nil {
+			return options, errInvalidBoardSize
+		}
+		options.BoardSize = boardSize
+	}
+	return options, options.Validate()
+}
+
+// encode adds the display options (other than inversion) that differ from the defaults to a board URL query
+func (o Options) encode(query url.Values) {
+	if o.Theme != "" && o.Theme != defaultTheme {
+		query.Set("theme", o.Theme)
+	}
+	if o.PieceSet != "" && o.PieceSet != ClassicPieceSet {
+		query.Set("pieces", o.PieceSet)
+	}
+	if o.BoardSize != 0 && o.BoardSize != DefaultBoardSize {
+		query.Set("size", strconv.Itoa(o.BoardSize))
+	}
+	if o.HideCoordinates {
+		query.Set("coords", "false")
+	}
+	if o.Framed {
+		query.Set("frame", "true")
+	}
+}
@@ -0,0 +1,97 @@
+package rendering
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"image"
+	"io"
+)
+
+// pdfWriter keeps track of the byte offset of each object written to a PDF document for its cross-reference table
+type pdfWriter struct {
+	out     *bufio.Writer
+	written int
+	offsets []int
+}
+
+func (p *pdfWriter) write(format string, args ...interface{}) {
+	n, _ := fmt.Fprintf(p.out, format, args...)
+	p.written += n
+}
+
+// object writes the next indirect object, objects are numbered from 1 in the order they are written
+func (p *pdfWriter) object(body string, stream []byte) {
+	p.offsets = append(p.offsets, p.written)
+	p.write("%v 0 obj\n%v\n", len(p.offsets), body)
+	if stream != nil {
+		p.write("stream\n")
+		n, _ := p.out.Write(stream)
+		p.written += n
+		p.write("\nendstream\n")
+	}
+	p.write("endobj\n")
+}
+
+// writePDF writes a PDF document with each image filling a page, sized for printing at the resolution in dots per inch
+func writePDF(w io.Writer, pages []image.Image, dpi float64) error {
+	p := &pdfWriter{out: bufio.NewWriter(w)}
+	p.write("%%PDF-1.4\n")
+	// Objects 1 and 2 are the catalog and page tree, followed by the page, content and image objects of each page
+	kids := ""
+	for i := range pages {
+		kids += fmt.Sprintf("%v 0 R ", 3+i*3)
+	}
+	p.object("<< /Type /Catalog /Pages 2 0 R >>", nil)
+	p.object(fmt.Sprintf("<< /Type /Pages /Kids [%v] /Count %v >>", kids, len(pages)), nil)
+	for i, page := range pages {
+		bounds := page.Bounds()
+		width := float64(bounds.Dx()) * 72 / dpi
+		height := float64(bounds.Dy()) * 72 / dpi
+		pageObject, contentObject, imageObject := 3+i*3, 4+i*3, 5+i*3
+		p.object(fmt.Sprintf(
+			"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.2f %.2f] /Contents %v 0 R /Resources << /XObject << /Page%v %v 0 R >> >> >>",
+			width, height, contentObject, pageObject, imageObject,
+		), nil)
+		content := fmt.Sprintf("q %.2f 0 0 %.2f 0 0 cm /Page%v Do Q", width, height, pageObject)
+		p.object(fmt.Sprintf("<< /Length %v >>", len(content)), []byte(content))
+		pixels, err := compressedPixels(page)
+		if err != nil {
+			return err
+		}
+		p.object(fmt.Sprintf(
+			"<< /Type /XObject /Subtype /Image /Width %v /Height %v /ColorSpace /DeviceRGB /BitsPerComponent 8 /Filter /FlateDecode /Length %v >>",
+			bounds.Dx(), bounds.Dy(), len(pixels),
+		), pixels)
+	}
+	xref := p.written
+	p.write("xref\n0 %v\n0000000000 65535 f \n", len(p.offsets)+1)
+	for _, offset := range p.offsets {
+		p.write("%010d 00000 n \n", offset)
+	}
+	p.write("trailer\n<< /Size %v /Root 1 0 R >>\nstartxref\n%v\n%%%%EOF\n", len(p.offsets)+1, xref)
+	return p.out.Flush()
+}
+
+// compressedPixels are the zlib compressed RGB samples of an image, row by row
+func compressedPixels(img image.Image) ([]byte, error) {
+	var compressed bytes.Buffer
+	writer := zlib.NewWriter(&compressed)
+	bounds := img.Bounds()
+	row := make([]byte, 0, bounds.Dx()*3)
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		row = row[:0]
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			r, g, b, _ := img.At(x, y).RGBA()
+			row = append(row, byte(r>>8), byte(g>>8), byte(b>>8))
+		}
+		if _, err := writer.Write(row); err != nil {
+			return nil, err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return compressed.Bytes(), nil
+}
@@ -0,0 +1,250 @@
+package rendering
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+
+	"github.com/cjsaylor/chessimage"
+	"github.com/fogleman/gg"
+	"github.com/notnil/chess"
+	"golang.org/x/image/draw"
+	"golang.org/x/image/font"
+)
+
+// PNGRenderer renders a board as a raster image in the theme and piece set of the options
+type PNGRenderer struct {
+	boardState
+	fen string
+}
+
+// NewPNGRendererFromFEN prepares a PNG renderer for use with given FEN string
+func NewPNGRendererFromFEN(fen string) (*PNGRenderer, error) {
+	state, err := newBoardState(fen)
+	if err != nil {
+		return nil, err
+	}
+	return &PNGRenderer{boardState: state, fen: fen}, nil
+}
+
+// Render draws the board with its highlights, pieces and annotations, surrounded by the players when framed
+func (r *PNGRenderer) Render(options Options) (image.Image, error) {
+	options = options.withDefaults()
+	board, err := r.renderBoard(options)
+	if err != nil || !options.Framed {
+		return board, err
+	}
+	return r.renderFrame(board, options)
+}
+
+// emptyBoardFEN is rendered by chessimage when the pieces are drawn as unicode symbols instead of images
+const emptyBoardFEN = "8/8/8/8/8/8/8/8 w - - 0 1"
+
+// renderBoard renders the board with chessimage, then recolors it in the theme and draws the annotations on top
+func (r *PNGRenderer) renderBoard(options Options) (image.Image, error) {
+	gridSize := float64(options.BoardSize / 8)
+	pieceFace, unicode := font.Face(nil), false
+	if options.PieceSet == UnicodePieceSet {
+		// Without a font that includes the chess symbols, fall back to the classic piece images
+		pieceFace, unicode = loadFontFace(unicodePieceFonts, gridSize*pieceRatio)
+	}
+	fen := r.fen
+	if unicode {
+		fen = emptyBoardFEN
+	}
+	board, err := chessimage.NewRendererFromFEN(fen)
+	if err != nil {
+		return nil, err
+	}
+	if len(r.lastMove) == 2 {
+		from, _ := chessimage.TileFromAN(r.lastMove[0].String())
+		to, _ := chessimage.TileFromAN(r.lastMove[1].String())
+		board.SetLastMove(chessimage.LastMove{
+			From: from,
+			To:   to,
+		})
+	}
+	if r.checkTile != chess.NoSquare {
+		check, _ := chessimage.TileFromAN(r.checkTile.String())
+		board.SetCheckTile(check)
+	}
+	rendered, err := board.Render(chessimage.Options{
+		AssetPath: options.pieceAssetPath(),
+		BoardSize: options.BoardSize,
+		Inverted:  options.Inverted,
+	})
+	if err != nil {
+		return nil, err
+	}
+	context := gg.NewContextForImage(applyTheme(rendered, Themes[options.Theme], options.HideCoordinates))
+	drawHighlights(context, r.annotations, gridSize, options.Inverted)
+	if unicode {
+		r.drawUnicodePieces(context, pieceFace, gridSize, options.Inverted)
+	}
+	drawArrowsAndGlyphs(context, r.annotations, gridSize, options.Inverted)
+	return context.Image(), nil
+}
+
+func (r *PNGRenderer) drawUnicodePieces(context *gg.Context, face font.Face, gridSize float64, inverted bool) {
+	context.SetFontFace(face)
+	for square, piece := range r.board.SquareMap() {
+		center := squareCenter(square, gridSize, inverted)
+		glyphs := unicodePieces[piece.Type()]
+		if piece.Color() == chess.White {
+			// fill the inside of the outlined symbol so white pieces stand out on dark squares
+			context.SetRGB255(255, 255, 255)
+			context.DrawStringAnchored(glyphs[0], center.X, center.Y, 0.5, 0.4)
+			context.SetRGB255(0, 0, 0)
+			context.DrawStringAnchored(glyphs[1], center.X, center.Y, 0.5, 0.4)
+			continue
+		}
+		context.SetRGB255(0, 0, 0)
+		context.DrawStringAnchored(glyphs[0], center.X, center.Y, 0.5, 0.4)
+	}
+}
+
+// chessimagePalette is the color scheme chessimage renders boards in, which the brown theme matches
+var chessimagePalette = Themes[defaultTheme]
+
+// Bounds (in pixels from the square corner) of the coordinates chessimage draws in the bottom left of the first rank
+// and the top right of the last file
+const (
+	coordinateWidth  = 12
+	coordinateHeight = 16
+)
+
+// applyTheme recolors a board rendered by chessimage from its colors to the colors of a theme.
+// Squares are swapped exactly, and the anti-aliased coordinates (drawn between the light and dark color) are
+// blended in the theme colors, or painted over with the square color when coordinates are hidden.
+func applyTheme(board image.Image, theme Theme, hideCoordinates bool) image.Image {
+	if theme == chessimagePalette && !hideCoordinates {
+		return board
+	}
+	swaps := map[color.RGBA]color.RGBA{
+		chessimagePalette.Light:        theme.Light,
+		chessimagePalette.Dark:         theme.Dark,
+		chessimagePalette.Highlight:    theme.Highlight,
+		chessimagePalette.HighlightDim: theme.HighlightDim,
+		chessimagePalette.Check:        theme.Check,
+	}
+	bounds := board.Bounds()
+	size := bounds.Dx()
+	gridSize := size / 8
+	recolored := image.NewRGBA(bounds)
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			pixel := color.RGBAModel.Convert(board.At(x, y)).(color.RGBA)
+			inCoordinates := (y >= size-coordinateHeight && x%gridSize < coordinateWidth) ||
+				(x >= size-coordinateWidth && y%gridSize < coordinateHeight)
+			if weight, ok := lightDarkBlend(pixel); ok && inCoordinates {
+				if hideCoordinates {
+					// the top left corner of each square is never covered by pieces or coordinates
+					square := color.RGBAModel.Convert(board.At(x-x%gridSize, y-y%gridSize)).(color.RGBA)
+					if swapped, ok := swaps[square]; ok {
+						pixel = swapped
+					}
+				} else {
+					pixel = blend(theme.Light, theme.Dark, weight)
+				}
+			} else if swapped, ok := swaps[pixel]; ok {
+				pixel = swapped
+			}
+			recolored.SetRGBA(x, y, pixel)
+		}
+	}
+	return recolored
+}
+
+// lightDarkBlend determines whether a color is a blend of chessimage's light and dark square colors, and the weight of the dark color
+func lightDarkBlend(c color.RGBA) (float64, bool) {
+	light, dark := chessimagePalette.Light, chessimagePalette.Dark
+	channels := [3][3]float64{
+		{float64(c.R), float64(light.R), float64(dark.R)},
+		{float64(c.G), float64(light.G), float64(dark.G)},
+		{float64(c.B), float64(light.B), float64(dark.B)},
+	}
+	var projection, length float64
+	for _, channel := range channels {
+		projection += (channel[0] - channel[1]) * (channel[2] - channel[1])
+		length += (channel[2] - channel[1]) * (channel[2] - channel[1])
+	}
+	weight := projection / length
+	if weight < 0 || weight > 1 {
+		return 0, false
+	}
+	for _, channel := range channels {
+		if math.Abs(channel[1]+weight*(channel[2]-channel[1])-channel[0]) > 3 {
+			return 0, false
+		}
+	}
+	return weight, true
+}
+
+func blend(from color.RGBA, to color.RGBA, weight float64) color.RGBA {
+	mix := func(a uint8, b uint8) uint8 {
+		return uint8(math.Round(float64(a) + weight*(float64(b)-float64(a))))
+	}
+	return color.RGBA{mix(from.R, to.R), mix(from.G, to.G), mix(from.B, to.B), 255}
+}
+
+// renderFrame draws the board between the bars of each player, showing their name, captured pieces, material advantage and clock
+func (r *PNGRenderer) renderFrame(board image.Image, options Options) (image.Image, error) {
+	barHeight := frameHeight(options)
+	context := gg.NewContext(options.BoardSize, options.BoardSize+2*int(barHeight))
+	context.SetColor(frameBackground)
+	context.Clear()
+	context.DrawImage(board, 0, int(barHeight))
+	if face, ok := loadFontFace(labelFonts, barHeight*0.35); ok {
+		context.SetFontFace(face)
+	}
+	padding := barHeight * 0.25
+	pieceSize := int(barHeight * 0.5)
+	for i, bar := range r.frameBars(options) {
+		middle := bar.y + barHeight/2
+		x := padding
+		if bar.toMove {
+			context.DrawCircle(x+padding/2, middle, padding/2)
+			context.SetColor(frameText)
+			context.Fill()
+		}
+		x += padding * 2
+		context.SetColor(frameText)
+		context.DrawStringAnchored(bar.name, x, middle, 0, 0.35)
+		width, _ := context.MeasureString(bar.name)
+		x += width + padding
+		if len(bar.captured) > 0 {
+			// a light backdrop keeps the captured black pieces visible on the bar
+			width := float64(pieceSize) * (0.6*float64(len(bar.captured)-1) + 1)
+			context.DrawRoundedRectangle(x-2, middle-float64(pieceSize)/2-2, width+4, float64(pieceSize)+4, 4)
+			context.SetColor(frameDimText)
+			context.Fill()
+		}
+		for _, piece := range bar.captured {
+			img, err := loadPieceImage(options.pieceAssetPath(), piece)
+			if err != nil {
+				return nil, err
+			}
+			resized := image.NewRGBA(image.Rect(0, 0, pieceSize, pieceSize))
+			draw.BiLinear.Scale(resized, resized.Bounds(), img, img.Bounds(), draw.Over, nil)
+			context.DrawImage(resized, int(x), int(middle)-pieceSize/2)
+			x += float64(pieceSize) * 0.6
+		}
+		if bar.advantage > 0 {
+			context.SetColor(frameDimText)
+			context.DrawStringAnchored(fmt.Sprintf("+%v", bar.advantage), x+float64(pieceSize)*0.7, middle, 0, 0.35)
+		}
+		right := float64(options.BoardSize) - padding
+		if bar.clock != "" {
+			context.SetColor(frameText)
+			context.DrawStringAnchored(bar.clock, right, middle, 1, 0.35)
+			width, _ := context.MeasureString(bar.clock)
+			right -= width + padding*2
+		}
+		if i == 0 {
+			context.SetColor(frameDimText)
+			context.DrawStringAnchored(r.moveLabel(), right, middle, 1, 0.35)
+		}
+	}
+	return context.Image(), nil
+}
@@ -0,0 +1,53 @@
+package rendering_test
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/cjsaylor/chessbot/rendering"
+	"github.com/notnil/chess"
+)
+
+func TestPNGRendererTheme(t *testing.T) {
+	board, err := rendering.NewPNGRendererFromFEN("rnbqkbnr/pppppppp/8/8/4P3/8/PPPP1PPP/RNBQKBNR b KQkq e3 0 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	board.SetLastMove(chess.E2, chess.E4)
+	for _, name := range rendering.ThemeNames() {
+		theme := rendering.Themes[name]
+		rendered, err := board.Render(rendering.Options{AssetPath: "../assets/", Theme: name, BoardSize: 256})
+		if err != nil {
+			t.Fatal(err)
+		}
+		squares := []struct {
+			description string
+			x, y        int
+			expected    color.RGBA
+		}{
+			{"light square d5", 3*32 + 2, 3*32 + 2, theme.Light},
+			{"dark square e5", 4*32 + 2, 3*32 + 2, theme.Dark},
+			{"moved from e2", 4*32 + 2, 6*32 + 2, theme.Highlight},
+			{"moved to e4", 4*32 + 2, 4*32 + 2, theme.HighlightDim},
+		}
+		for _, square := range squares {
+			if tile := color.RGBAModel.Convert(rendered.At(square.x, square.y)); tile != square.expected {
+				t.Errorf("expected the %v of the %v theme to be %v, got %v", square.description, name, square.expected, tile)
+			}
+		}
+	}
+}
+
+func TestPNGRendererSizeInWholeSquares(t *testing.T) {
+	board, err := rendering.NewPNGRendererFromFEN("8/8/8/8/8/2k5/8/KQ6 w - - 0 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rendered, err := board.Render(rendering.Options{AssetPath: "../assets/", BoardSize: 300})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if size := rendered.Bounds().Dx(); size != 296 {
+		t.Errorf("expected the board to be rounded down to 296 pixels, got %v", size)
+	}
+}
@@ -0,0 +1,78 @@
+package rendering_test
+
+import (
+	"image/color"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cjsaylor/chessbot/game"
+	"github.com/cjsaylor/chessbot/rendering"
+)
+
+func TestRenderPosition(t *testing.T) {
+	store := game.NewMemoryStore()
+	gm := game.NewGame("1", game.Player{ID: "a"}, game.Player{ID: "b"})
+	for _, move := range []string{"f2f3", "e7e5", "g2g4", "d8h4"} {
+		if _, err := gm.Move(move); err != nil {
+			t.Fatal(err)
+		}
+	}
+	store.StoreGame("1", gm)
+	positionLink := rendering.NewPositionLink("", "secret")
+	handler := rendering.NewPositionHandler(store, positionLink, "../assets/")
+
+	link, _ := positionLink.CreateLink(gm, 4)
+	if link.Path != "/game/1/position/4.png" {
+		t.Errorf("unexpected position path %v", link.Path)
+	}
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, link.String(), nil))
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected status %v, got %v", http.StatusOK, recorder.Code)
+	}
+	board, err := png.Decode(recorder.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the white king on e1 is checkmated
+	if tile := color.RGBAModel.Convert(board.At(4*64+2, 7*64+2)); tile != rendering.Themes["brown"].Check {
+		t.Errorf("expected the king in check to be highlighted, got %v", tile)
+	}
+
+	link, _ = positionLink.CreateLink(gm, 5)
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, link.String(), nil))
+	if recorder.Code != http.StatusNotFound {
+		t.Errorf("expected status %v for a ply past the end of the game, got %v", http.StatusNotFound, recorder.Code)
+	}
+
+	link, _ = positionLink.CreateLink(gm, 2)
+	tampered := strings.Replace(link.String(), "/position/2.png", "/position/3.png", 1)
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, tampered, nil))
+	if recorder.Code != http.StatusForbidden {
+		t.Errorf("expected status %v for a tampered ply, got %v", http.StatusForbidden, recorder.Code)
+	}
+
+	plain, _ := positionLink.CreateLink(gm, 4)
+	gm.AnnotateMove(4, game.MoveAnnotation{NAG: 3})
+	annotated, _ := positionLink.CreateLink(gm, 4)
+	if annotated.String() == plain.String() || annotated.Query().Get("nag") != "3" {
+		t.Errorf("expected the link to change with the annotation of the ply, got %v", annotated)
+	}
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, annotated.String(), nil))
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected status %v, got %v", http.StatusOK, recorder.Code)
+	}
+	if board, err = png.Decode(recorder.Body); err != nil {
+		t.Fatal(err)
+	}
+	// the badge of the glyph is drawn in the top right corner of h4
+	if badge := color.RGBAModel.Convert(board.At(498, 279)); badge != (color.RGBA{38, 194, 163, 255}) {
+		t.Errorf("expected the glyph of the annotated move to be drawn, got %v", badge)
+	}
+}
@@ -0,0 +1,100 @@
+package rendering
+
+import (
+	"bytes"
+	"image/png"
+	"log"
+	"net/http"
+
+	"github.com/cjsaylor/chessbot/game"
+	"github.com/notnil/chess"
+)
+
+// PositionHandler serves the board of a game after any of its plies
+type PositionHandler struct {
+	gameStorage  game.GameStorage
+	positionLink PositionLink
+	assetPath    string
+}
+
+// NewPositionHandler returns an instance of a game position endpoint handler
+func NewPositionHandler(store game.GameStorage, positionLink PositionLink, assetPath string) *PositionHandler {
+	return &PositionHandler{
+		gameStorage:  store,
+		positionLink: positionLink,
+		assetPath:    assetPath,
+	}
+}
+
+func (h PositionHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	gameID, ply, err := parsePositionPath(r.URL.Path)
+	if err != nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if !h.positionLink.ValidateLink(*r.URL) {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	options, err := OptionsFromQuery(r.URL.Query(), h.assetPath)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	gm, err := h.gameStorage.RetrieveGame(gameID)
+	if err != nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	plies := gm.Plies()
+	if ply > len(plies) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	fen := gm.StartingFEN()
+	if ply > 0 {
+		fen = plies[ply-1].FEN
+	}
+	board, err := NewPNGRendererFromFEN(fen)
+	if err != nil {
+		log.Println(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	if ply > 0 {
+		played := plies[ply-1]
+		board.SetLastMove(played.Move.S1(), played.Move.S2())
+		board.SetCheckTile(played.CheckedKing)
+		board.SetAnnotations(plyAnnotations(played))
+	}
+	options.Inverted = board.turn == chess.Black
+	rendered, err := board.Render(options)
+	var image bytes.Buffer
+	if err == nil {
+		err = png.Encode(&image, rendered)
+	}
+	if err != nil {
+		log.Println(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Add("Content-Type", "image/png")
+	// A takeback can replace the plies of a game in progress, but a completed game's positions never change
+	if gm.Outcome() != chess.NoOutcome {
+		w.Header().Add("Cache-Control", "max-age=7776000")
+	}
+	w.Write(image.Bytes())
+}
+
+// plyAnnotations marks the destination of the move of a ply with the glyph the players annotated it with, if any
+func plyAnnotations(ply game.Ply) Annotations {
+	annotations := Annotations{}
+	if glyph := ply.Annotation.Glyph(); glyph != "" {
+		annotations.Glyphs = []Glyph{{Square: ply.Move.S2(), Symbol: glyph}}
+	}
+	return annotations
+}
@@ -0,0 +1,103 @@
+package rendering
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cjsaylor/chessbot/game"
+)
+
+// errInvalidPositionPath is returned for a path that isn't of the form /game/{id}/position/{ply}.png
+var errInvalidPositionPath = errors.New("invalid position path")
+
+// PositionLink is a simple struct for creating valid external URLs of a game position after a given ply
+type PositionLink struct {
+	hostName string
+	signer   URLSigner
+	options  Options
+}
+
+// WithOptions returns a copy of the position link creator that creates links with the display options.
+// Inversion is determined by the side to move of the position.
+func (p PositionLink) WithOptions(options Options) PositionLink {
+	p.options = options
+	return p
+}
+
+// WithTTL returns a copy of the position link creator whose links expire after the duration
+func (p PositionLink) WithTTL(ttl time.Duration) PositionLink {
+	p.signer = p.signer.WithTTL(ttl)
+	return p
+}
+
+// CreateLink returns an externally accessible URL of the game position after the ply (0 being the starting position)
+func (p PositionLink) CreateLink(gm *game.Game, ply int) (*url.URL, error) {
+	u, err := url.Parse(fmt.Sprintf("%v/game/%v/position/%v.png", p.hostName, url.PathEscape(gm.ID), ply))
+	if err != nil {
+		return nil, err
+	}
+	q := u.Query()
+	p.options.encode(q)
+	// Annotating the move changes the image, so the link of an annotated ply changes with its NAG
+	if plies := gm.Plies(); ply > 0 && ply <= len(plies) && plies[ply-1].Annotation.NAG != 0 {
+		q.Set("nag", strconv.Itoa(plies[ply-1].Annotation.NAG))
+	}
+	signed := positionQuery(q, gm.ID, ply)
+	p.signer.Sign(signed)
+	for _, param := range []string{"kid", "expires", "signature"} {
+		if value := signed.Get(param); value != "" {
+			q.Set(param, value)
+		}
+	}
+	u.RawQuery = q.Encode()
+	return u, nil
+}
+
+// ValidateLink ensures that the game, ply and display options of the link are signed properly with the app signing key
+func (p PositionLink) ValidateLink(u url.URL) bool {
+	gameID, ply, err := parsePositionPath(u.Path)
+	if err != nil {
+		return false
+	}
+	return p.signer.Verify(positionQuery(u.Query(), gameID, ply)) == nil
+}
+
+// positionQuery adds the game and ply of the path to a copy of the query, so the path is covered by the signature
+func positionQuery(query url.Values, gameID string, ply int) url.Values {
+	signed := url.Values{}
+	for param, values := range query {
+		signed[param] = values
+	}
+	signed.Set("game_id", gameID)
+	signed.Set("ply", strconv.Itoa(ply))
+	return signed
+}
+
+// parsePositionPath extracts the game ID and ply from a /game/{id}/position/{ply}.png path
+func parsePositionPath(path string) (string, int, error) {
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	if len(parts) != 4 || parts[0] != "game" || parts[2] != "position" || !strings.HasSuffix(parts[3], ".png") {
+		return "", 0, errInvalidPositionPath
+	}
+	gameID, err := url.PathUnescape(parts[1])
+	if err != nil || gameID == "" {
+		return "", 0, errInvalidPositionPath
+	}
+	ply, err := strconv.Atoi(strings.TrimSuffix(parts[3], ".png"))
+	if err != nil || ply < 0 {
+		return "", 0, errInvalidPositionPath
+	}
+	return gameID, ply, nil
+}
+
+// NewPositionLink creates a new PositionLink struct instance
+func NewPositionLink(hostname string, signingKey string, previousKeys ...string) PositionLink {
+	return PositionLink{
+		hostName: hostname,
+		signer:   NewURLSigner("position", signingKey, previousKeys...),
+	}
+}
@@ -2,25 +2,68 @@ package rendering
 
 import (
 	"crypto/sha256"
+	"crypto/subtle"
 	"encoding/hex"
 	"fmt"
 	"net/url"
+	"strings"
+	"time"
 
 	"github.com/cjsaylor/chessbot/game"
 	"github.com/notnil/chess"
 )
 
+// legacySignedParams are the board URL query parameters covered by legacy signatures (besides the FEN), in the order they are signed
+var legacySignedParams = append(append([]string{}, annotationParams...), optionParams...)
+
 // RenderLink is a simple struct for creating valid external board URLs
 type RenderLink struct {
-	hostName   string
-	signingKey string
+	hostName       string
+	signer         URLSigner
+	legacyDeadline time.Time
+	options        Options
+	frame          Frame
+}
+
+// WithOptions returns a copy of the link renderer that creates links with the display options.
+// Inversion is determined by the side to move and asset paths are configured by the board handler.
+func (r RenderLink) WithOptions(options Options) RenderLink {
+	r.options = options
+	return r
+}
+
+// Options are the display options links are created with
+func (r RenderLink) Options() Options {
+	return r.options
+}
+
+// WithFrame returns a copy of the link renderer that creates links with the players shown around framed boards.
+// The players are only included when the display options are framed.
+func (r RenderLink) WithFrame(frame Frame) RenderLink {
+	r.frame = frame
+	return r
+}
+
+// WithTTL returns a copy of the link renderer whose links expire after the duration
+func (r RenderLink) WithTTL(ttl time.Duration) RenderLink {
+	r.signer = r.signer.WithTTL(ttl)
+	return r
+}
+
+// WithLegacyDeadline returns a copy of the link renderer that accepts links signed
+// with the legacy (pre-HMAC) signature until the deadline. Legacy links are rejected by default.
+func (r RenderLink) WithLegacyDeadline(deadline time.Time) RenderLink {
+	r.legacyDeadline = deadline
+	return r
 }
 
 // CreateLink returns an externally accessible board URL at the current game state
 func (r RenderLink) CreateLink(gm *game.Game) (*url.URL, error) {
-	fen := gm.FEN()
-	sig := sha256.New()
-	sig.Write([]byte(fen + r.signingKey))
+	return r.CreateAnnotatedLink(gm, Annotations{})
+}
+
+// CreateAnnotatedLink returns an externally accessible board URL at the current game state with annotations drawn on top
+func (r RenderLink) CreateAnnotatedLink(gm *game.Game, annotations Annotations) (*url.URL, error) {
 	from, to, check := "", "", ""
 	if lastMove := gm.LastMove(); lastMove != nil {
 		from = lastMove.S1().String()
@@ -30,31 +73,99 @@ func (r RenderLink) CreateLink(gm *game.Game) (*url.URL, error) {
 			check = square.String()
 		}
 	}
+	return r.createLink(gm.FEN(), from, to, check, gm.Turn() == game.Black, annotations)
+}
+
+// CreatePositionLink returns an externally accessible board URL of a position, seen from the side to move
+func (r RenderLink) CreatePositionLink(fen string, annotations Annotations) (*url.URL, error) {
+	gameState, err := chess.FEN(fen)
+	if err != nil {
+		return nil, err
+	}
+	turn := chess.NewGame(gameState).Position().Turn()
+	return r.createLink(fen, "", "", "", turn == chess.Black, annotations)
+}
+
+func (r RenderLink) createLink(fen string, from string, to string, check string, inverted bool, annotations Annotations) (*url.URL, error) {
 	u, _ := url.Parse(fmt.Sprintf("%v/board.png", r.hostName))
 	q := u.Query()
 	q.Add("fen", fen)
-	q.Add("signature", hex.EncodeToString(sig.Sum(nil)))
 	q.Add("from", from)
 	q.Add("to", to)
 	q.Add("check", check)
-	if gm.Turn() == game.Black {
+	if inverted {
 		q.Add("inverted", "true")
 	}
+	annotations.encode(q)
+	r.options.encode(q)
+	if r.options.Framed {
+		r.frame.encode(q)
+	}
+	r.signer.Sign(q)
 	u.RawQuery = q.Encode()
 	return u, nil
 }
 
 // ValidateLink ensures that the link signatuer is signed properly with the app signing key
 func (r RenderLink) ValidateLink(url url.URL) bool {
+	return r.Verify(url) == nil
+}
+
+// Verify ensures that every parameter of the link is signed with a known key and that the link has not expired
+func (r RenderLink) Verify(url url.URL) error {
+	query := url.Query()
+	if r.signer.IsSigned(query) {
+		return r.signer.Verify(query)
+	}
+	if time.Now().After(r.legacyDeadline) {
+		return ErrInvalidSignature
+	}
+	for _, key := range r.signer.legacyKeys() {
+		if subtle.ConstantTimeCompare([]byte(legacySignature(query, key)), []byte(query.Get("signature"))) == 1 {
+			return nil
+		}
+	}
+	return ErrInvalidSignature
+}
+
+// SignedQuery returns the parameters of a valid link that are covered by its signature.
+// Legacy signatures don't cover the last move, check, inversion or players, so those are left out
+// and the board is seen from the side to move, as legacy links were created.
+func (r RenderLink) SignedQuery(u url.URL) url.Values {
+	query := u.Query()
+	if r.signer.IsSigned(query) {
+		return query
+	}
+	signed := url.Values{}
+	for _, param := range append([]string{"fen"}, legacySignedParams...) {
+		if value := query.Get(param); value != "" {
+			signed.Set(param, value)
+		}
+	}
+	if fields := strings.Fields(signed.Get("fen")); len(fields) > 1 && fields[1] == "b" {
+		signed.Set("inverted", "true")
+	}
+	return signed
+}
+
+// legacySignature signs the FEN, annotations and display options of a board URL query with a plain hash.
+// It is only used to validate links created before signing with the URLSigner.
+func legacySignature(query url.Values, key []byte) string {
+	payload := query.Get("fen")
+	for _, param := range legacySignedParams {
+		if value := query.Get(param); value != "" {
+			payload += "&" + param + "=" + value
+		}
+	}
 	sig := sha256.New()
-	sig.Write([]byte(url.Query().Get("fen") + r.signingKey))
-	return hex.EncodeToString(sig.Sum(nil)) == url.Query().Get("signature")
+	sig.Write([]byte(payload + string(key)))
+	return hex.EncodeToString(sig.Sum(nil))
 }
 
 // NewRenderLink creates a new RenderLink struct instance
-func NewRenderLink(hostname string, signingKey string) RenderLink {
+func NewRenderLink(hostname string, signingKey string, previousKeys ...string) RenderLink {
 	return RenderLink{
-		hostName:   hostname,
-		signingKey: signingKey,
+		hostName: hostname,
+		signer:   NewURLSigner("board", signingKey, previousKeys...),
 	}
 }
@@ -0,0 +1,114 @@
+package rendering_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/cjsaylor/chessbot/game"
+	"github.com/cjsaylor/chessbot/rendering"
+)
+
+func testGame(t *testing.T) *game.Game {
+	gm := game.NewGame("1", game.Player{ID: "a"}, game.Player{ID: "b"})
+	if _, err := gm.Move("e2e4"); err != nil {
+		t.Fatal(err)
+	}
+	return gm
+}
+
+func TestRenderLinkRejectsTampering(t *testing.T) {
+	renderLink := rendering.NewRenderLink("", "secret")
+	link, _ := renderLink.CreateLink(testGame(t))
+	if !renderLink.ValidateLink(*link) {
+		t.Fatal("expected the link to be valid")
+	}
+	for param, value := range map[string]string{
+		"from":     "d2",
+		"to":       "d4",
+		"check":    "e8",
+		"inverted": "false",
+		"kid":      "00000000",
+	} {
+		tampered := *link
+		q := tampered.Query()
+		q.Set(param, value)
+		tampered.RawQuery = q.Encode()
+		if renderLink.ValidateLink(tampered) {
+			t.Errorf("expected a link with a tampered %v parameter to be invalid", param)
+		}
+	}
+}
+
+func TestRenderLinkExpires(t *testing.T) {
+	renderLink := rendering.NewRenderLink("", "secret")
+	link, _ := renderLink.WithTTL(time.Hour).CreateLink(testGame(t))
+	if link.Query().Get("expires") == "" {
+		t.Fatal("expected the link to have an expiry")
+	}
+	if err := renderLink.Verify(*link); err != nil {
+		t.Errorf("expected the link to be valid, got %v", err)
+	}
+	link, _ = renderLink.WithTTL(-time.Minute).CreateLink(testGame(t))
+	if err := renderLink.Verify(*link); err != rendering.ErrLinkExpired {
+		t.Errorf("expected %v, got %v", rendering.ErrLinkExpired, err)
+	}
+}
+
+func TestRenderLinkKeyRotation(t *testing.T) {
+	link, _ := rendering.NewRenderLink("", "old").CreateLink(testGame(t))
+	if !rendering.NewRenderLink("", "new", "old").ValidateLink(*link) {
+		t.Error("expected a link signed with a previous key to be valid")
+	}
+	if err := rendering.NewRenderLink("", "new").Verify(*link); err != rendering.ErrUnknownLinkKey {
+		t.Errorf("expected %v, got %v", rendering.ErrUnknownLinkKey, err)
+	}
+}
+
+func TestRenderLinkLegacySignatures(t *testing.T) {
+	fen := "rnbqkbnr/pppppppp/8/8/4P3/8/PPPP1PPP/RNBQKBNR b KQkq e3 0 1"
+	sig := sha256.Sum256([]byte(fen + "secret"))
+	link := url.URL{Path: "/board.png", RawQuery: url.Values{
+		"fen":       {fen},
+		"from":      {"e2"},
+		"to":        {"e4"},
+		"signature": {hex.EncodeToString(sig[:])},
+	}.Encode()}
+	renderLink := rendering.NewRenderLink("", "secret")
+	if renderLink.ValidateLink(link) {
+		t.Error("expected legacy links to be rejected unless they are accepted until a deadline")
+	}
+	deadline := time.Now().Add(time.Hour)
+	if !rendering.NewRenderLink("", "new", "secret").WithLegacyDeadline(deadline).ValidateLink(link) {
+		t.Error("expected a legacy link signed with a previous key to be valid")
+	}
+	if !renderLink.WithLegacyDeadline(deadline).ValidateLink(link) {
+		t.Error("expected a legacy link to be valid before the deadline")
+	}
+	if renderLink.WithLegacyDeadline(time.Now().Add(-time.Hour)).ValidateLink(link) {
+		t.Error("expected a legacy link to be invalid after the deadline")
+	}
+	tampered := link
+	q := tampered.Query()
+	q.Set("check", "e1")
+	q.Set("inverted", "false")
+	tampered.RawQuery = q.Encode()
+	if signed := renderLink.SignedQuery(tampered); signed.Get("from") != "" || signed.Get("check") != "" || signed.Get("inverted") != "true" {
+		t.Errorf("expected only the signed parameters of a legacy link, seen from the side to move, got %v", signed)
+	}
+}
+
+func TestLinkSignaturesAreBoundToTheirPurpose(t *testing.T) {
+	gm := testGame(t)
+	replay, _ := rendering.NewReplayLink("", "secret").CreateLink(gm)
+	scoresheetLink := rendering.NewScoresheetLink("", "secret")
+	if scoresheetLink.ValidateLink(url.URL{Path: "/scoresheet.pdf", RawQuery: replay.RawQuery}) {
+		t.Error("expected a replay link to be invalid as a scoresheet link")
+	}
+	scoresheet, _ := scoresheetLink.CreateLink(gm, "pdf", rendering.ScoresheetOptions{})
+	if !scoresheetLink.ValidateLink(*scoresheet) {
+		t.Error("expected the scoresheet link to be valid")
+	}
+}
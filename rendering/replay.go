@@ -0,0 +1,102 @@
+package rendering
+
+import (
+	"image"
+	"image/color"
+	"image/color/palette"
+	"image/draw"
+	"image/gif"
+	"io"
+	"time"
+
+	"github.com/cjsaylor/chessbot/game"
+	"github.com/notnil/chess"
+)
+
+const (
+	defaultReplayFrameDelay = time.Second
+	defaultReplayBoardSize  = 256
+)
+
+// ReplayOptions holds all possible rendering options for game replays
+type ReplayOptions struct {
+	Options
+	FrameDelay time.Duration
+	// FinalFrameDelay is how long the final position is shown before the replay loops. Defaults to three frames.
+	FinalFrameDelay time.Duration
+}
+
+func (o ReplayOptions) withDefaults() ReplayOptions {
+	if o.BoardSize <= 0 {
+		o.BoardSize = defaultReplayBoardSize
+	}
+	o.Options = o.Options.withDefaults()
+	if o.FrameDelay <= 0 {
+		o.FrameDelay = defaultReplayFrameDelay
+	}
+	if o.FinalFrameDelay <= 0 {
+		o.FinalFrameDelay = 3 * o.FrameDelay
+	}
+	return o
+}
+
+// replayPalette includes the exact theme colors so the tiles aren't dithered when quantized
+func replayPalette(theme Theme) color.Palette {
+	return append(color.Palette{
+		theme.Light,
+		theme.Dark,
+		theme.Highlight,
+		theme.HighlightDim,
+		theme.Check,
+	}, palette.WebSafe...)
+}
+
+// RenderReplay encodes an animated GIF of a game, with a frame for the starting position and each ply
+func RenderReplay(w io.Writer, gm *game.Game, options ReplayOptions) error {
+	options = options.withDefaults()
+	colors := replayPalette(Themes[options.Theme])
+	animation := &gif.GIF{}
+	addFrame := func(board *PNGRenderer) error {
+		frame, err := board.Render(options.Options)
+		if err != nil {
+			return err
+		}
+		paletted := image.NewPaletted(frame.Bounds(), colors)
+		draw.Draw(paletted, paletted.Rect, frame, frame.Bounds().Min, draw.Src)
+		animation.Image = append(animation.Image, paletted)
+		animation.Delay = append(animation.Delay, int(options.FrameDelay/(10*time.Millisecond)))
+		return nil
+	}
+	start, err := NewPNGRendererFromFEN(gm.StartingFEN())
+	if err != nil {
+		return err
+	}
+	if err := addFrame(start); err != nil {
+		return err
+	}
+	for _, ply := range gm.Plies() {
+		board, err := NewPNGRendererFromFEN(ply.FEN)
+		if err != nil {
+			return err
+		}
+		board.SetLastMove(ply.Move.S1(), ply.Move.S2())
+		if ply.CheckedKing != chess.NoSquare {
+			board.SetCheckTile(ply.CheckedKing)
+		}
+		board.SetAnnotations(plyAnnotations(ply))
+		if err := addFrame(board); err != nil {
+			return err
+		}
+	}
+	animation.Delay[len(animation.Delay)-1] = int(options.FinalFrameDelay / (10 * time.Millisecond))
+	return gif.EncodeAll(w, animation)
+}
+
+// RenderReplayFromPGN encodes an animated GIF of a game expressed by a PGN
+func RenderReplayFromPGN(w io.Writer, pgn string, options ReplayOptions) error {
+	gm, err := game.NewGameFromPGN("", pgn, game.Player{}, game.Player{})
+	if err != nil {
+		return err
+	}
+	return RenderReplay(w, gm, options)
+}
@@ -0,0 +1,66 @@
+package rendering_test
+
+import (
+	"bytes"
+	"image/gif"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cjsaylor/chessbot/game"
+	"github.com/cjsaylor/chessbot/rendering"
+)
+
+func TestRenderReplay(t *testing.T) {
+	gm := game.NewGame("1", game.Player{ID: "a"}, game.Player{ID: "b"})
+	for _, move := range []string{"f2f3", "e7e5", "g2g4", "d8h4"} {
+		if _, err := gm.Move(move); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var out bytes.Buffer
+	err := rendering.RenderReplay(&out, gm, rendering.ReplayOptions{
+		Options: rendering.Options{
+			AssetPath: "../assets/",
+			BoardSize: 128,
+		},
+		FrameDelay: 500 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	replay, err := gif.DecodeAll(&out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(replay.Image) != 5 {
+		t.Fatalf("expected a frame for the start and each of the 4 plies, got %v", len(replay.Image))
+	}
+	if replay.Image[0].Bounds().Dx() != 128 {
+		t.Errorf("expected a board size of 128, got %v", replay.Image[0].Bounds().Dx())
+	}
+	if replay.Delay[0] != 50 || replay.Delay[4] != 150 {
+		t.Errorf("expected frame delays of 50 and a final delay of 150, got %v", replay.Delay)
+	}
+}
+
+func TestReplayRejectsUnsignedLinks(t *testing.T) {
+	store := game.NewMemoryStore()
+	store.StoreGame("1", game.NewGame("1", game.Player{ID: "a"}, game.Player{ID: "b"}))
+	replayLink := rendering.NewReplayLink("", "secret")
+	handler := rendering.NewReplayHandler(store, replayLink, rendering.ReplayOptions{})
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/replay.gif?game_id=1", nil))
+	if recorder.Code != http.StatusForbidden {
+		t.Errorf("expected status %v, got %v", http.StatusForbidden, recorder.Code)
+	}
+
+	link, _ := replayLink.CreateLink(&game.Game{ID: "2"})
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, link.String(), nil))
+	if recorder.Code != http.StatusNotFound {
+		t.Errorf("expected status %v, got %v", http.StatusNotFound, recorder.Code)
+	}
+}
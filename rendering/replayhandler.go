@@ -0,0 +1,54 @@
+package rendering
+
+import (
+	"bytes"
+	"log"
+	"net/http"
+
+	"github.com/cjsaylor/chessbot/game"
+	"github.com/notnil/chess"
+)
+
+// ReplayHandler serves animated replays of games
+type ReplayHandler struct {
+	gameStorage game.GameStorage
+	replayLink  ReplayLink
+	options     ReplayOptions
+}
+
+// NewReplayHandler returns an instance of a game replay endpoint handler
+func NewReplayHandler(store game.GameStorage, replayLink ReplayLink, options ReplayOptions) *ReplayHandler {
+	return &ReplayHandler{
+		gameStorage: store,
+		replayLink:  replayLink,
+		options:     options,
+	}
+}
+
+func (h ReplayHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if !h.replayLink.ValidateLink(*r.URL) {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	gm, err := h.gameStorage.RetrieveGame(r.URL.Query().Get("game_id"))
+	if err != nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	var replay bytes.Buffer
+	if err := RenderReplay(&replay, gm, h.options); err != nil {
+		log.Println(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Add("Content-Type", "image/gif")
+	// Games in progress will have more moves later, but a completed game's replay never changes
+	if gm.Outcome() != chess.NoOutcome {
+		w.Header().Add("Cache-Control", "max-age=7776000")
+	}
+	w.Write(replay.Bytes())
+}
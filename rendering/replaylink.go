@@ -0,0 +1,72 @@
+package rendering
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/cjsaylor/chessbot/game"
+)
+
+// ReplayLink is a simple struct for creating valid external game replay URLs
+type ReplayLink struct {
+	hostName       string
+	signer         URLSigner
+	legacyDeadline time.Time
+}
+
+// WithTTL returns a copy of the replay link creator whose links expire after the duration
+func (r ReplayLink) WithTTL(ttl time.Duration) ReplayLink {
+	r.signer = r.signer.WithTTL(ttl)
+	return r
+}
+
+// WithLegacyDeadline returns a copy of the replay link creator that accepts links signed
+// with the legacy (pre-HMAC) signature until the deadline. Legacy links are rejected by default.
+func (r ReplayLink) WithLegacyDeadline(deadline time.Time) ReplayLink {
+	r.legacyDeadline = deadline
+	return r
+}
+
+// CreateLink returns an externally accessible URL of an animated replay of the game
+func (r ReplayLink) CreateLink(gm *game.Game) (*url.URL, error) {
+	u, err := url.Parse(fmt.Sprintf("%v/replay.gif", r.hostName))
+	if err != nil {
+		return nil, err
+	}
+	q := u.Query()
+	q.Add("game_id", gm.ID)
+	r.signer.Sign(q)
+	u.RawQuery = q.Encode()
+	return u, nil
+}
+
+// ValidateLink ensures that the link signature is signed properly with the app signing key
+func (r ReplayLink) ValidateLink(url url.URL) bool {
+	query := url.Query()
+	if r.signer.IsSigned(query) {
+		return r.signer.Verify(query) == nil
+	}
+	if time.Now().After(r.legacyDeadline) {
+		return false
+	}
+	for _, key := range r.signer.legacyKeys() {
+		sig := sha256.New()
+		sig.Write([]byte("replay:" + query.Get("game_id") + string(key)))
+		if subtle.ConstantTimeCompare([]byte(hex.EncodeToString(sig.Sum(nil))), []byte(query.Get("signature"))) == 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// NewReplayLink creates a new ReplayLink struct instance
+func NewReplayLink(hostname string, signingKey string, previousKeys ...string) ReplayLink {
+	return ReplayLink{
+		hostName: hostname,
+		signer:   NewURLSigner("replay", signingKey, previousKeys...),
+	}
+}
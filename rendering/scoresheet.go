@@ -0,0 +1,273 @@
+package rendering
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+	"strings"
+
+	"github.com/cjsaylor/chessbot/game"
+	"github.com/fogleman/gg"
+	"github.com/notnil/chess"
+)
+
+// Scoresheet pages are A4 sized at 150 dots per inch
+const (
+	scoresheetDPI        = 150
+	scoresheetWidth      = 1240
+	scoresheetHeight     = 1754
+	scoresheetMargin     = 90
+	scoresheetRowHeight  = 36
+	scoresheetRows       = 35
+	scoresheetTableTop   = 380
+	scoresheetDiagrams   = 6
+	scoresheetDiagramTop = 330
+	scoresheetBoardSize  = 376
+	scoresheetFontSize   = 22
+	scoresheetTitleSize  = 40
+	scoresheetColumnGap  = 40
+	scoresheetNumberSize = 70
+)
+
+// scoresheetFontFaces are tried in order for the text of scoresheets
+var scoresheetFontFaces = []string{"DejaVuSerif.ttf", "times.ttf", "DejaVuSans.ttf", "arial.ttf"}
+
+var (
+	scoresheetInk  = color.RGBA{0, 0, 0, 255}
+	scoresheetRule = color.RGBA{170, 170, 170, 255}
+)
+
+// ScoresheetOptions holds all possible rendering options of a scoresheet
+type ScoresheetOptions struct {
+	// Options are used for rendering the diagrams
+	Options
+	// White and Black are the names of the players, overriding the tags of the game
+	White string
+	Black string
+	// Diagrams are the plies to draw the board after, 0 being the starting position
+	Diagrams []int
+}
+
+// Scoresheet is the record of a game laid out as a traditional two column scoresheet of numbered moves in standard algebraic notation
+type Scoresheet struct {
+	tags      map[string]string
+	moves     []string
+	positions []*chess.Position
+	played    []*chess.Move
+}
+
+// NewScoresheetFromPGN prepares a scoresheet of a single game expressed by a PGN, such as the output of Game.Export()
+func NewScoresheetFromPGN(pgn string) (*Scoresheet, error) {
+	option, err := chess.PGN(strings.NewReader(game.StripNAGs(pgn)))
+	if err != nil {
+		return nil, err
+	}
+	gm := chess.NewGame(option)
+	sheet := &Scoresheet{
+		tags:      map[string]string{},
+		positions: gm.Positions(),
+		played:    gm.Moves(),
+	}
+	for _, tag := range gm.TagPairs() {
+		sheet.tags[tag.Key] = tag.Value
+	}
+	if _, ok := sheet.tags["Result"]; !ok {
+		sheet.tags["Result"] = gm.Outcome().String()
+	}
+	for i, move := range sheet.played {
+		sheet.moves = append(sheet.moves, chess.AlgebraicNotation{}.Encode(sheet.positions[i], move))
+	}
+	return sheet, nil
+}
+
+// SetTag sets a tag shown in the scoresheet header (such as the Date), unless the game already has it
+func (s *Scoresheet) SetTag(key string, value string) {
+	if s.tags[key] == "" {
+		s.tags[key] = value
+	}
+}
+
+// Pages draws the scoresheet, with pages of diagrams following the pages of moves
+func (s *Scoresheet) Pages(options ScoresheetOptions) ([]image.Image, error) {
+	rowsPerPage := scoresheetRows * 2
+	rows := (len(s.moves) + 1) / 2
+	pageCount := (rows + rowsPerPage - 1) / rowsPerPage
+	if pageCount == 0 {
+		pageCount = 1
+	}
+	pages := []image.Image{}
+	for page := 0; page < pageCount; page++ {
+		pages = append(pages, s.movePage(options, page*rowsPerPage, page+1, pageCount))
+	}
+	for start := 0; start < len(options.Diagrams); start += scoresheetDiagrams {
+		end := start + scoresheetDiagrams
+		if end > len(options.Diagrams) {
+			end = len(options.Diagrams)
+		}
+		page, err := s.diagramPage(options, options.Diagrams[start:end])
+		if err != nil {
+			return nil, err
+		}
+		pages = append(pages, page)
+	}
+	return pages, nil
+}
+
+// RenderPNG writes every page of the scoresheet, one below the other, as a single PNG image
+func (s *Scoresheet) RenderPNG(w io.Writer, options ScoresheetOptions) error {
+	pages, err := s.Pages(options)
+	if err != nil {
+		return err
+	}
+	sheet := image.NewRGBA(image.Rect(0, 0, scoresheetWidth, scoresheetHeight*len(pages)))
+	for i, page := range pages {
+		offset := image.Pt(0, i*scoresheetHeight)
+		draw.Draw(sheet, page.Bounds().Add(offset), page, image.Point{}, draw.Src)
+	}
+	return png.Encode(w, sheet)
+}
+
+// RenderPDF writes the scoresheet as a PDF document of A4 pages
+func (s *Scoresheet) RenderPDF(w io.Writer, options ScoresheetOptions) error {
+	pages, err := s.Pages(options)
+	if err != nil {
+		return err
+	}
+	return writePDF(w, pages, scoresheetDPI)
+}
+
+// newScoresheetPage starts a blank page with the title and game tags
+func (s *Scoresheet) newScoresheetPage(options ScoresheetOptions, title string) *gg.Context {
+	context := gg.NewContext(scoresheetWidth, scoresheetHeight)
+	context.SetColor(color.White)
+	context.Clear()
+	context.SetColor(scoresheetInk)
+	setScoresheetFont(context, scoresheetTitleSize)
+	context.DrawString(title, scoresheetMargin, scoresheetMargin+scoresheetTitleSize)
+	white, black := s.tags["White"], s.tags["Black"]
+	if options.White != "" {
+		white = options.White
+	}
+	if options.Black != "" {
+		black = options.Black
+	}
+	setScoresheetFont(context, scoresheetFontSize)
+	columns := [][][2]string{
+		{{"White", white}, {"Black", black}, {"Event", s.tags["Event"]}},
+		{{"Date", s.tags["Date"]}, {"Result", s.tags["Result"]}, {"Site", s.tags["Site"]}},
+	}
+	columnWidth := float64(scoresheetWidth-2*scoresheetMargin) / 2
+	for i, tags := range columns {
+		x := scoresheetMargin + float64(i)*columnWidth
+		for j, tag := range tags {
+			y := float64(scoresheetMargin+scoresheetTitleSize+60) + float64(j)*scoresheetRowHeight*1.2
+			context.SetColor(scoresheetInk)
+			context.DrawString(tag[0]+":", x, y)
+			context.DrawString(tag[1], x+110, y)
+			context.SetColor(scoresheetRule)
+			context.DrawLine(x+105, y+6, x+columnWidth-scoresheetColumnGap, y+6)
+			context.Stroke()
+		}
+	}
+	return context
+}
+
+// movePage draws two columns of numbered moves, starting with the row of the given move number (counting from 0)
+func (s *Scoresheet) movePage(options ScoresheetOptions, firstRow int, page int, pageCount int) image.Image {
+	context := s.newScoresheetPage(options, "Scoresheet")
+	setScoresheetFont(context, scoresheetFontSize)
+	tableWidth := float64(scoresheetWidth-2*scoresheetMargin-scoresheetColumnGap) / 2
+	moveWidth := (tableWidth - scoresheetNumberSize) / 2
+	for column := 0; column < 2; column++ {
+		left := scoresheetMargin + float64(column)*(tableWidth+scoresheetColumnGap)
+		context.SetColor(scoresheetInk)
+		context.DrawStringAnchored("White", left+scoresheetNumberSize+moveWidth/2, scoresheetTableTop-10, 0.5, 0)
+		context.DrawStringAnchored("Black", left+scoresheetNumberSize+moveWidth*1.5, scoresheetTableTop-10, 0.5, 0)
+		for row := 0; row < scoresheetRows; row++ {
+			number := firstRow + column*scoresheetRows + row
+			top := float64(scoresheetTableTop + row*scoresheetRowHeight)
+			context.SetColor(scoresheetRule)
+			context.DrawRectangle(left, top, scoresheetNumberSize, scoresheetRowHeight)
+			context.DrawRectangle(left+scoresheetNumberSize, top, moveWidth, scoresheetRowHeight)
+			context.DrawRectangle(left+scoresheetNumberSize+moveWidth, top, moveWidth, scoresheetRowHeight)
+			context.Stroke()
+			context.SetColor(scoresheetInk)
+			baseline := top + scoresheetRowHeight*0.7
+			context.DrawStringAnchored(fmt.Sprintf("%v", number+1), left+scoresheetNumberSize-10, baseline, 1, 0)
+			for side := 0; side < 2; side++ {
+				if ply := number*2 + side; ply < len(s.moves) {
+					context.DrawString(s.moves[ply], left+scoresheetNumberSize+float64(side)*moveWidth+10, baseline)
+				}
+			}
+		}
+	}
+	if pageCount > 1 {
+		context.DrawStringAnchored(
+			fmt.Sprintf("Page %v of %v", page, pageCount),
+			scoresheetWidth/2, scoresheetHeight-scoresheetMargin/2, 0.5, 0,
+		)
+	}
+	return context.Image()
+}
+
+// diagramPage draws the boards after each of the plies in a grid, captioned with the move played
+func (s *Scoresheet) diagramPage(options ScoresheetOptions, plies []int) (image.Image, error) {
+	context := s.newScoresheetPage(options, "Diagrams")
+	boardOptions := options.Options
+	boardOptions.BoardSize = scoresheetBoardSize
+	boardOptions.Inverted = false
+	boardOptions.Framed = false
+	gap := float64(scoresheetWidth - 2*scoresheetMargin - 2*scoresheetBoardSize)
+	for i, ply := range plies {
+		if ply < 0 || ply >= len(s.positions) {
+			return nil, fmt.Errorf("ply %v is not part of the game", ply)
+		}
+		board, err := NewPNGRendererFromFEN(s.positions[ply].String())
+		if err != nil {
+			return nil, err
+		}
+		caption := "Starting position"
+		if ply > 0 {
+			move := s.played[ply-1]
+			board.SetLastMove(move.S1(), move.S2())
+			if move.HasTag(chess.Check) {
+				board.SetCheckTile(checkedKing(s.positions[ply]))
+			}
+			separator := "."
+			if ply%2 == 0 {
+				separator = "..."
+			}
+			caption = fmt.Sprintf("After %v%v %v", (ply+1)/2, separator, s.moves[ply-1])
+		}
+		rendered, err := board.Render(boardOptions)
+		if err != nil {
+			return nil, err
+		}
+		x := scoresheetMargin + float64(i%2)*(scoresheetBoardSize+gap)
+		y := float64(scoresheetDiagramTop) + float64(i/2)*(scoresheetBoardSize+60)
+		context.SetColor(scoresheetInk)
+		setScoresheetFont(context, scoresheetFontSize)
+		context.DrawString(caption, x, y-12)
+		context.DrawImage(rendered, int(x), int(y))
+	}
+	return context.Image(), nil
+}
+
+// checkedKing is the square of the king of the side to move
+func checkedKing(position *chess.Position) chess.Square {
+	for square, piece := range position.Board().SquareMap() {
+		if piece.Type() == chess.King && piece.Color() == position.Turn() {
+			return square
+		}
+	}
+	return chess.NoSquare
+}
+
+func setScoresheetFont(context *gg.Context, points float64) {
+	if face, ok := loadFontFace(scoresheetFontFaces, points); ok {
+		context.SetFontFace(face)
+	}
+}
@@ -0,0 +1,66 @@
+package rendering_test
+
+import (
+	"bytes"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cjsaylor/chessbot/game"
+	"github.com/cjsaylor/chessbot/rendering"
+)
+
+func TestScoresheet(t *testing.T) {
+	store := game.NewMemoryStore()
+	gm := game.NewGame("1", game.Player{ID: "a"}, game.Player{ID: "b"})
+	for _, move := range []string{"f2f3", "e7e5", "g2g4", "d8h4"} {
+		if _, err := gm.Move(move); err != nil {
+			t.Fatal(err)
+		}
+	}
+	store.StoreGame("1", gm)
+	scoresheetLink := rendering.NewScoresheetLink("", "secret")
+	handler := rendering.NewScoresheetHandler(store, scoresheetLink, "../assets/")
+	options := rendering.ScoresheetOptions{White: "Alice", Black: "Bob", Diagrams: []int{4}}
+
+	link, _ := scoresheetLink.CreateLink(gm, "pdf", options)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, link.String(), nil))
+	if contentType := recorder.Header().Get("Content-Type"); recorder.Code != http.StatusOK || contentType != "application/pdf" {
+		t.Fatalf("expected a PDF, got status %v and content type %v", recorder.Code, contentType)
+	}
+	pdf := recorder.Body.Bytes()
+	if !bytes.HasPrefix(pdf, []byte("%PDF-")) || !bytes.HasSuffix(pdf, []byte("%%EOF\n")) {
+		t.Error("expected a complete PDF document")
+	}
+	if !bytes.Contains(pdf, []byte("/Count 2")) {
+		t.Error("expected a page of moves and a page of diagrams")
+	}
+
+	link, _ = scoresheetLink.CreateLink(gm, "png", options)
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, link.String(), nil))
+	sheet, err := png.Decode(recorder.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if size := sheet.Bounds().Size(); size.X != 1240 || size.Y != 2*1754 {
+		t.Errorf("expected two A4 pages at 150 DPI, got %v", size)
+	}
+
+	tampered := strings.Replace(link.String(), "diagrams=4", "diagrams=2", 1)
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, tampered, nil))
+	if recorder.Code != http.StatusForbidden {
+		t.Errorf("expected status %v for tampered diagrams, got %v", http.StatusForbidden, recorder.Code)
+	}
+
+	link, _ = scoresheetLink.CreateLink(gm, "png", rendering.ScoresheetOptions{Diagrams: []int{9}})
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, link.String(), nil))
+	if recorder.Code != http.StatusBadRequest {
+		t.Errorf("expected status %v for a diagram past the end of the game, got %v", http.StatusBadRequest, recorder.Code)
+	}
+}
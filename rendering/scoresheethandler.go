@@ -0,0 +1,83 @@
+package rendering
+
+import (
+	"bytes"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/cjsaylor/chessbot/game"
+	"github.com/notnil/chess"
+)
+
+// ScoresheetHandler serves printable scoresheets of games as PDF or PNG, by the extension of the path
+type ScoresheetHandler struct {
+	gameStorage    game.GameStorage
+	scoresheetLink ScoresheetLink
+	assetPath      string
+}
+
+// NewScoresheetHandler returns an instance of a scoresheet endpoint handler
+func NewScoresheetHandler(store game.GameStorage, scoresheetLink ScoresheetLink, assetPath string) *ScoresheetHandler {
+	return &ScoresheetHandler{
+		gameStorage:    store,
+		scoresheetLink: scoresheetLink,
+		assetPath:      assetPath,
+	}
+}
+
+func (h ScoresheetHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if !h.scoresheetLink.ValidateLink(*r.URL) {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	options, err := ScoresheetOptionsFromQuery(r.URL.Query())
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	options.AssetPath = h.assetPath
+	gm, err := h.gameStorage.RetrieveGame(r.URL.Query().Get("game_id"))
+	if err != nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	sheet, err := NewScoresheetFromPGN(gm.Export())
+	if err != nil {
+		log.Println(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	if lastMoved := gm.LastMoved(); !lastMoved.IsZero() {
+		sheet.SetTag("Date", lastMoved.Format("2006.01.02"))
+	}
+	for _, ply := range options.Diagrams {
+		if ply < 0 || ply > len(gm.Plies()) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+	}
+	var out bytes.Buffer
+	contentType := "image/png"
+	if strings.HasSuffix(r.URL.Path, ".pdf") {
+		contentType = "application/pdf"
+		err = sheet.RenderPDF(&out, options)
+	} else {
+		err = sheet.RenderPNG(&out, options)
+	}
+	if err != nil {
+		log.Println(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Add("Content-Type", contentType)
+	// Games in progress will have more moves later, but a completed game's scoresheet never changes
+	if gm.Outcome() != chess.NoOutcome {
+		w.Header().Add("Cache-Control", "max-age=7776000")
+	}
+	w.Write(out.Bytes())
+}
@@ -0,0 +1,81 @@
+package rendering
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cjsaylor/chessbot/game"
+)
+
+// ScoresheetLink is a simple struct for creating valid external scoresheet URLs
+type ScoresheetLink struct {
+	hostName string
+	signer   URLSigner
+}
+
+// WithTTL returns a copy of the scoresheet link creator whose links expire after the duration
+func (s ScoresheetLink) WithTTL(ttl time.Duration) ScoresheetLink {
+	s.signer = s.signer.WithTTL(ttl)
+	return s
+}
+
+// CreateLink returns an externally accessible URL of the scoresheet of a game as a "pdf" or "png".
+// The player names and diagrams of the options are signed, while the format may be changed by the path extension.
+func (s ScoresheetLink) CreateLink(gm *game.Game, format string, options ScoresheetOptions) (*url.URL, error) {
+	u, err := url.Parse(fmt.Sprintf("%v/scoresheet.%v", s.hostName, format))
+	if err != nil {
+		return nil, err
+	}
+	q := u.Query()
+	q.Add("game_id", gm.ID)
+	if options.White != "" {
+		q.Add("white", options.White)
+	}
+	if options.Black != "" {
+		q.Add("black", options.Black)
+	}
+	if len(options.Diagrams) > 0 {
+		plies := []string{}
+		for _, ply := range options.Diagrams {
+			plies = append(plies, strconv.Itoa(ply))
+		}
+		q.Add("diagrams", strings.Join(plies, ","))
+	}
+	s.signer.Sign(q)
+	u.RawQuery = q.Encode()
+	return u, nil
+}
+
+// ValidateLink ensures that the link signature is signed properly with the app signing key
+func (s ScoresheetLink) ValidateLink(u url.URL) bool {
+	return s.signer.Verify(u.Query()) == nil
+}
+
+// ScoresheetOptionsFromQuery parses the player names and diagram plies encoded in a scoresheet URL query
+func ScoresheetOptionsFromQuery(query url.Values) (ScoresheetOptions, error) {
+	options := ScoresheetOptions{
+		White: query.Get("white"),
+		Black: query.Get("black"),
+	}
+	if diagrams := query.Get("diagrams"); diagrams != "" {
+		for _, value := range strings.Split(diagrams, ",") {
+			ply, err := strconv.Atoi(value)
+			if err != nil {
+				return options, fmt.Errorf("invalid diagram ply %v", value)
+			}
+			options.Diagrams = append(options.Diagrams, ply)
+		}
+	}
+	return options, nil
+}
+
+// NewScoresheetLink creates a new ScoresheetLink struct instance
+func NewScoresheetLink(hostname string, signingKey string, previousKeys ...string) ScoresheetLink {
+	return ScoresheetLink{
+		hostName: hostname,
+		signer:   NewURLSigner("scoresheet", signingKey, previousKeys...),
+	}
+}
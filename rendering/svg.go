@@ -0,0 +1,271 @@
+package rendering
+
+import (
+	"bufio"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"html"
+	"image/color"
+	"io"
+	"io/ioutil"
+	"strings"
+	"sync"
+
+	"github.com/notnil/chess"
+)
+
+// svgPieceGlyphs are the filled unicode chess glyphs, white pieces are drawn with an inverted fill.
+// The trailing variation selector forces text (rather than emoji) presentation.
+var svgPieceGlyphs = map[chess.PieceType]string{
+	chess.King:   "♚︎",
+	chess.Queen:  "♛︎",
+	chess.Rook:   "♜︎",
+	chess.Bishop: "♝︎",
+	chess.Knight: "♞︎",
+	chess.Pawn:   "♟︎",
+}
+
+// encodedPieceImages caches the base64 encoded piece images embedded in SVG documents
+var encodedPieceImages sync.Map
+
+// ErrInvalidSquare is an error representing a square that isn't in algebraic notation (a1 through h8)
+var ErrInvalidSquare = errors.New("invalid square")
+
+// SVGRenderer renders a board as a scalable vector graphic, supporting the same
+// last move and check highlights as the PNG renderer
+type SVGRenderer struct {
+	boardState
+}
+
+// NewSVGRendererFromFEN prepares an SVG renderer for use with given FEN string
+func NewSVGRendererFromFEN(fen string) (*SVGRenderer, error) {
+	state, err := newBoardState(fen)
+	if err != nil {
+		return nil, err
+	}
+	return &SVGRenderer{boardState: state}, nil
+}
+
+// SquareFromAN converts a square in algebraic notation (e4) to a chess square
+func SquareFromAN(an string) (chess.Square, error) {
+	if len(an) != 2 || an[0] < 'a' || an[0] > 'h' || an[1] < '1' || an[1] > '8' {
+		return chess.NoSquare, ErrInvalidSquare
+	}
+	return chess.Square(int(an[1]-'1')*8 + int(an[0]-'a')), nil
+}
+
+// Render writes the SVG document of the board to w, surrounded by the players when framed
+func (r *SVGRenderer) Render(w io.Writer, options Options) error {
+	options = options.withDefaults()
+	theme := Themes[options.Theme]
+	gridSize := float64(options.BoardSize) / 8
+	position := func(square chess.Square) (float64, float64) {
+		return squareOrigin(square, gridSize, options.Inverted)
+	}
+	height := float64(options.BoardSize)
+	if options.Framed {
+		height += 2 * frameHeight(options)
+	}
+	out := bufio.NewWriter(w)
+	fmt.Fprintf(
+		out,
+		`<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink" width="%v" height="%v" viewBox="0 0 %v %v">`,
+		options.BoardSize, height, options.BoardSize, height,
+	)
+	if options.Framed {
+		r.renderFrame(out, options)
+		fmt.Fprintf(out, `<g transform="translate(0 %v)">`, frameHeight(options))
+	}
+	for square := chess.A1; square <= chess.H8; square++ {
+		x, y := position(square)
+		fmt.Fprintf(
+			out,
+			`<rect x="%v" y="%v" width="%v" height="%v" fill="%v"/>`,
+			x, y, gridSize, gridSize, hexColor(r.tileColor(square, theme)),
+		)
+	}
+	for _, highlight := range r.annotations.Highlights {
+		x, y := position(highlight.Square)
+		fmt.Fprintf(
+			out,
+			`<rect x="%v" y="%v" width="%v" height="%v" fill="%v" fill-opacity="0.5"/>`,
+			x, y, gridSize, gridSize, hexColor(annotationColors[highlight.Color]),
+		)
+	}
+	if !options.HideCoordinates {
+		renderCoordinates(out, options)
+	}
+	if err := r.renderPieces(out, gridSize, options); err != nil {
+		return err
+	}
+	r.renderAnnotations(out, gridSize, options)
+	if options.Framed {
+		fmt.Fprint(out, "</g>")
+	}
+	fmt.Fprint(out, "</svg>")
+	return out.Flush()
+}
+
+func (r *SVGRenderer) renderPieces(out io.Writer, gridSize float64, options Options) error {
+	squares := r.board.SquareMap()
+	if options.PieceSet == UnicodePieceSet {
+		for square, piece := range squares {
+			center := squareCenter(square, gridSize, options.Inverted)
+			fill, stroke := "#000000", "none"
+			if piece.Color() == chess.White {
+				fill, stroke = "#ffffff", "#000000"
+			}
+			fmt.Fprintf(
+				out,
+				`<text x="%v" y="%v" font-size="%v" text-anchor="middle" dominant-baseline="central" fill="%v" stroke="%v" stroke-width="%v">%v</text>`,
+				center.X, center.Y, gridSize*pieceRatio, fill, stroke, gridSize/40, svgPieceGlyphs[piece.Type()],
+			)
+		}
+		return nil
+	}
+	// Each piece image is embedded once and referenced by every square it is on
+	pieceSize := gridSize * pieceRatio
+	defined := map[string]bool{}
+	fmt.Fprint(out, "<defs>")
+	for _, piece := range squares {
+		symbol := pieceSymbol(piece)
+		if defined[symbol] {
+			continue
+		}
+		encoded, err := encodedPieceImage(options.pieceAssetPath() + pieceFiles[symbol])
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(
+			out,
+			`<image id="piece-%v" width="%v" height="%v" xlink:href="data:image/png;base64,%v"/>`,
+			symbol, pieceSize, pieceSize, encoded,
+		)
+		defined[symbol] = true
+	}
+	fmt.Fprint(out, "</defs>")
+	offset := (gridSize - pieceSize) / 2
+	for square, piece := range squares {
+		x, y := squareOrigin(square, gridSize, options.Inverted)
+		fmt.Fprintf(out, `<use xlink:href="#piece-%v" x="%v" y="%v"/>`, pieceSymbol(piece), x+offset, y+offset)
+	}
+	return nil
+}
+
+// renderFrame draws the bars of each player, showing their name, captured pieces, material advantage and clock
+func (r *SVGRenderer) renderFrame(out io.Writer, options Options) {
+	barHeight := frameHeight(options)
+	fontSize := barHeight * 0.35
+	padding := barHeight * 0.25
+	fmt.Fprintf(
+		out,
+		`<rect width="%v" height="%v" fill="%v"/>`,
+		options.BoardSize, float64(options.BoardSize)+2*barHeight, hexColor(frameBackground),
+	)
+	for i, bar := range r.frameBars(options) {
+		middle := bar.y + barHeight/2
+		if bar.toMove {
+			fmt.Fprintf(out, `<circle cx="%v" cy="%v" r="%v" fill="%v"/>`, padding*1.5, middle, padding/2, hexColor(frameText))
+		}
+		fmt.Fprintf(
+			out,
+			`<text x="%v" y="%v" font-family="Arial, sans-serif" font-size="%v" dominant-baseline="central" fill="%v">%v`,
+			padding*3, middle, fontSize, hexColor(frameText), html.EscapeString(bar.name),
+		)
+		if len(bar.captured) > 0 {
+			captured := ""
+			for _, piece := range bar.captured {
+				captured += svgPieceGlyphs[piece.Type()]
+			}
+			// outline black pieces in white so they stand out on the bar
+			fill, stroke := "#000000", "#ffffff"
+			if piece := bar.captured[0]; piece.Color() == chess.White {
+				fill, stroke = "#ffffff", "#000000"
+			}
+			fmt.Fprintf(
+				out,
+				`<tspan dx="%v" font-size="%v" fill="%v" stroke="%v" stroke-width="%v" letter-spacing="%v">%v</tspan>`,
+				padding, barHeight*0.5, fill, stroke, barHeight/80, -barHeight*0.15, captured,
+			)
+		}
+		if bar.advantage > 0 {
+			fmt.Fprintf(out, `<tspan dx="%v" fill="%v">+%v</tspan>`, padding, hexColor(frameDimText), bar.advantage)
+		}
+		fmt.Fprint(out, "</text>")
+		if i > 0 && bar.clock == "" {
+			continue
+		}
+		fmt.Fprintf(
+			out,
+			`<text x="%v" y="%v" font-family="Arial, sans-serif" font-size="%v" dominant-baseline="central" text-anchor="end" fill="%v">`,
+			float64(options.BoardSize)-padding, middle, fontSize, hexColor(frameText),
+		)
+		if i == 0 {
+			fmt.Fprintf(out, `<tspan fill="%v">%v</tspan>`, hexColor(frameDimText), r.moveLabel())
+		}
+		if bar.clock != "" {
+			fmt.Fprintf(out, `<tspan dx="%v">%v</tspan>`, padding*2, bar.clock)
+		}
+		fmt.Fprint(out, "</text>")
+	}
+}
+
+func encodedPieceImage(path string) (string, error) {
+	if encoded, ok := encodedPieceImages.Load(path); ok {
+		return encoded.(string), nil
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	encoded := base64.StdEncoding.EncodeToString(data)
+	encodedPieceImages.Store(path, encoded)
+	return encoded, nil
+}
+
+func renderCoordinates(out io.Writer, options Options) {
+	fontSize := labelSize(float64(options.BoardSize) / 8)
+	for _, label := range coordinateLabels(options) {
+		anchor := "start"
+		if label.alignRight {
+			anchor = "end"
+		}
+		fmt.Fprintf(
+			out,
+			`<text x="%v" y="%v" font-family="Arial, sans-serif" font-size="%v" fill="%v" text-anchor="%v">%v</text>`,
+			label.x, label.y, fontSize, hexColor(label.color), anchor, label.text,
+		)
+	}
+}
+
+func (r *SVGRenderer) renderAnnotations(out io.Writer, gridSize float64, options Options) {
+	for _, arrow := range r.annotations.Arrows {
+		points := []string{}
+		for _, point := range arrowPolygon(arrow, gridSize, options.Inverted) {
+			points = append(points, fmt.Sprintf("%v,%v", point.X, point.Y))
+		}
+		fmt.Fprintf(
+			out,
+			`<polygon points="%v" fill="%v" fill-opacity="0.8"/>`,
+			strings.Join(points, " "), hexColor(annotationColors[arrow.Color]),
+		)
+	}
+	for _, glyph := range r.annotations.Glyphs {
+		center, radius := glyphBadge(glyph, gridSize, options.Inverted)
+		fmt.Fprintf(
+			out,
+			`<circle cx="%v" cy="%v" r="%v" fill="%v"/>`,
+			center.X, center.Y, radius, hexColor(glyphColors[glyph.Symbol]),
+		)
+		fmt.Fprintf(
+			out,
+			`<text x="%v" y="%v" font-family="Arial, sans-serif" font-weight="bold" font-size="%v" text-anchor="middle" dominant-baseline="central" fill="#ffffff">%v</text>`,
+			center.X, center.Y, gridSize*0.22, glyph.Symbol,
+		)
+	}
+}
+
+func hexColor(c color.RGBA) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
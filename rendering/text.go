@@ -0,0 +1,129 @@
+package rendering
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cjsaylor/chessbot/game"
+	"github.com/notnil/chess"
+)
+
+// Styles of text boards
+const (
+	UnicodeTextStyle = "unicode"
+	ASCIITextStyle   = "ascii"
+)
+
+// describedPieceOrder is the order pieces are listed in board descriptions
+var describedPieceOrder = []chess.PieceType{chess.King, chess.Queen, chess.Rook, chess.Bishop, chess.Knight, chess.Pawn}
+
+// TextOptions holds all possible rendering options of a text board
+type TextOptions struct {
+	// Style is either unicode (default) chess symbols or ascii piece letters
+	Style           string
+	HideCoordinates bool
+	Inverted        bool
+}
+
+// TextRenderer renders a board as lines of text, and describes it as a list of pieces for screen readers
+type TextRenderer struct {
+	boardState
+}
+
+// NewTextRendererFromFEN prepares a text renderer for use with given FEN string
+func NewTextRendererFromFEN(fen string) (*TextRenderer, error) {
+	state, err := newBoardState(fen)
+	if err != nil {
+		return nil, err
+	}
+	return &TextRenderer{boardState: state}, nil
+}
+
+// NewTextRendererFromGame prepares a text renderer of the current game state, including its last move and check
+func NewTextRendererFromGame(gm *game.Game) (*TextRenderer, error) {
+	renderer, err := NewTextRendererFromFEN(gm.FEN())
+	if err != nil {
+		return nil, err
+	}
+	if lastMove := gm.LastMove(); lastMove != nil {
+		renderer.SetLastMove(lastMove.S1(), lastMove.S2())
+		if lastMove.HasTag(chess.Check) {
+			renderer.SetCheckTile(gm.CheckedKing())
+		}
+	}
+	return renderer, nil
+}
+
+// Render draws the board one rank per line, with the ranks and files along the edges unless hidden
+func (r *TextRenderer) Render(options TextOptions) string {
+	files, ranks := "abcdefgh", "87654321"
+	if options.Inverted {
+		files, ranks = "hgfedcba", "12345678"
+	}
+	squares := r.board.SquareMap()
+	var out strings.Builder
+	for _, rank := range ranks {
+		cells := []string{}
+		if !options.HideCoordinates {
+			cells = append(cells, string(rank))
+		}
+		for _, file := range files {
+			square, _ := SquareFromAN(string(file) + string(rank))
+			cells = append(cells, textPiece(squares[square], options.Style))
+		}
+		out.WriteString(strings.Join(cells, " ") + "\n")
+	}
+	if !options.HideCoordinates {
+		out.WriteString("  " + strings.Join(strings.Split(files, ""), " ") + "\n")
+	}
+	return out.String()
+}
+
+// textPiece is the symbol of a piece (or empty square) in the style
+func textPiece(piece chess.Piece, style string) string {
+	if piece == chess.NoPiece {
+		if style == ASCIITextStyle {
+			return "."
+		}
+		return "·"
+	}
+	if style == ASCIITextStyle {
+		return pieceSymbol(piece)
+	}
+	glyphs := unicodePieces[piece.Type()]
+	if piece.Color() == chess.White {
+		return glyphs[1]
+	}
+	return glyphs[0]
+}
+
+// Describe lists the side to move, the last move, any check and the pieces of each side in spoken style,
+// such as "White: Ke1, Qd1, e4" where pawns are only named by their square.
+func (r *TextRenderer) Describe() string {
+	lines := []string{fmt.Sprintf("%v to move.", r.turn.Name())}
+	if len(r.lastMove) == 2 {
+		lines[0] += fmt.Sprintf(" Last move %v to %v.", r.lastMove[0], r.lastMove[1])
+	}
+	if r.checkTile != chess.NoSquare {
+		lines[0] += fmt.Sprintf(" %v is in check.", r.turn.Name())
+	}
+	squares := r.board.SquareMap()
+	for _, side := range []chess.Color{chess.White, chess.Black} {
+		pieces := []string{}
+		for _, pieceType := range describedPieceOrder {
+			for square := chess.A1; square <= chess.H8; square++ {
+				piece, ok := squares[square]
+				if !ok || piece.Color() != side || piece.Type() != pieceType {
+					continue
+				}
+				name := square.String()
+				if pieceType != chess.Pawn {
+					name = strings.ToUpper(pieceSymbol(piece)) + name
+				}
+				pieces = append(pieces, name)
+			}
+		}
+		lines = append(lines, fmt.Sprintf("%v: %v", side.Name(), strings.Join(pieces, ", ")))
+	}
+	return strings.Join(lines, "\n")
+}
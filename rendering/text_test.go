@@ -0,0 +1,55 @@
+package rendering_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cjsaylor/chessbot/game"
+	"github.com/cjsaylor/chessbot/rendering"
+)
+
+func TestTextBoard(t *testing.T) {
+	gm := game.NewGame("1", game.Player{ID: "a"}, game.Player{ID: "b"})
+	gm.Move("e2e4")
+	board, err := rendering.NewTextRendererFromGame(gm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ascii := board.Render(rendering.TextOptions{Style: rendering.ASCIITextStyle})
+	expected := `8 r n b q k b n r
+7 p p p p p p p p
+6 . . . . . . . .
+5 . . . . . . . .
+4 . . . . P . . .
+3 . . . . . . . .
+2 P P P P . P P P
+1 R N B Q K B N R
+  a b c d e f g h
+`
+	if ascii != expected {
+		t.Errorf("expected\n%v\ngot\n%v", expected, ascii)
+	}
+	unicode := board.Render(rendering.TextOptions{HideCoordinates: true, Inverted: true})
+	lines := strings.Split(strings.TrimSpace(unicode), "\n")
+	if len(lines) != 8 || lines[0] != "♖ ♘ ♗ ♔ ♕ ♗ ♘ ♖" || lines[7] != "♜ ♞ ♝ ♚ ♛ ♝ ♞ ♜" {
+		t.Errorf("expected an inverted unicode board without coordinates, got\n%v", unicode)
+	}
+}
+
+func TestDescribeBoard(t *testing.T) {
+	gm := game.NewGame("1", game.Player{ID: "a"}, game.Player{ID: "b"})
+	for _, move := range []string{"e2e4", "f7f6", "d1h5"} {
+		gm.Move(move)
+	}
+	board, _ := rendering.NewTextRendererFromGame(gm)
+	description := board.Describe()
+	for _, expected := range []string{
+		"Black to move. Last move d1 to h5. Black is in check.",
+		"White: Ke1, Qh5, Ra1, Rh1, Bc1, Bf1, Nb1, Ng1, a2, b2, c2, d2, f2, g2, h2, e4\n",
+		"Black: Ke8, Qd8, Ra8, Rh8, Bc8, Bf8, Nb8, Ng8, f6, a7, b7, c7, d7, e7, g7, h7",
+	} {
+		if !strings.Contains(description, expected) {
+			t.Errorf("expected the description to include %q, got\n%v", expected, description)
+		}
+	}
+}
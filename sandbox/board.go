@@ -0,0 +1,198 @@
+// Package sandbox holds analysis boards forked from games, where anyone can try variations without changing the game
+package sandbox
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/cjsaylor/chessbot/game"
+	"github.com/notnil/chess"
+)
+
+// ErrAtStart is returned when taking back a move on a board at its starting position
+var ErrAtStart = errors.New("the board is at its starting position")
+
+// Node is a move of the variation tree, played after its parent node
+type Node struct {
+	Parent int
+	// Move is in long algebraic (UCI) notation, such as e2e4 or e7e8q
+	Move string
+}
+
+// Board is an analysis board forked from a game, played in its own thread.
+// Its moves form a tree: every move tried from a position is kept, and the first one is the main line.
+type Board struct {
+	TeamID    string
+	ChannelID string
+	ThreadID  string
+	GameID    string
+	WhiteID   string
+	BlackID   string
+	// StartingFEN is the position the game was started from
+	StartingFEN string
+	// Nodes are the moves of the tree, each after its parent. The root node (0) is the starting position and has no move.
+	Nodes []Node
+	// Current is the node of the position shown on the board
+	Current   int
+	CreatedAt time.Time
+}
+
+// NewBoard forks a game into an analysis board, with the moves of the game as the main line
+func NewBoard(gm *game.Game) *Board {
+	board := &Board{
+		GameID:      gm.ID,
+		WhiteID:     gm.Players[game.White].ID,
+		BlackID:     gm.Players[game.Black].ID,
+		StartingFEN: gm.StartingFEN(),
+		Nodes:       []Node{{Parent: -1}},
+	}
+	for _, ply := range gm.Plies() {
+		board.Nodes = append(board.Nodes, Node{
+			Parent: len(board.Nodes) - 1,
+			Move:   ply.Move.String(),
+		})
+	}
+	board.Current = len(board.Nodes) - 1
+	return board
+}
+
+// Children lists the nodes played from a node, the main line first
+func (b *Board) Children(node int) []int {
+	children := []int{}
+	for i, n := range b.Nodes {
+		if i > 0 && n.Parent == node {
+			children = append(children, i)
+		}
+	}
+	return children
+}
+
+// Line lists the nodes from the starting position to a node, the root excluded
+func (b *Board) Line(node int) []int {
+	line := []int{}
+	for ; node > 0; node = b.Nodes[node].Parent {
+		line = append([]int{node}, line...)
+	}
+	return line
+}
+
+// PositionAt replays the moves leading to a node
+func (b *Board) PositionAt(node int) (*chess.Position, error) {
+	option, err := chess.FEN(b.StartingFEN)
+	if err != nil {
+		return nil, err
+	}
+	position := chess.NewGame(option).Position()
+	for _, n := range b.Line(node) {
+		move, err := game.DecodeMove(position, b.Nodes[n].Move)
+		if err != nil {
+			return nil, err
+		}
+		position = position.Update(move)
+	}
+	return position, nil
+}
+
+// Position is the position shown on the board
+func (b *Board) Position() (*chess.Position, error) {
+	return b.PositionAt(b.Current)
+}
+
+// Play a move on the board in algebraic (Nf3) or long algebraic (g1f3) notation.
+// A move already tried from the position is followed, and any other starts a new variation.
+func (b *Board) Play(text string) (*chess.Move, error) {
+	position, err := b.Position()
+	if err != nil {
+		return nil, err
+	}
+	move, err := game.DecodeMove(position, text)
+	if err != nil {
+		return nil, err
+	}
+	for _, child := range b.Children(b.Current) {
+		if b.Nodes[child].Move == move.String() {
+			b.Current = child
+			return move, nil
+		}
+	}
+	b.Nodes = append(b.Nodes, Node{Parent: b.Current, Move: move.String()})
+	b.Current = len(b.Nodes) - 1
+	return move, nil
+}
+
+// Back takes back the last move shown on the board, keeping it in the tree
+func (b *Board) Back() error {
+	if b.Current == 0 {
+		return ErrAtStart
+	}
+	b.Current = b.Nodes[b.Current].Parent
+	return nil
+}
+
+// LastMove is the move leading to the position shown on the board, or nil at the starting position
+func (b *Board) LastMove() (*chess.Move, error) {
+	if b.Current == 0 {
+		return nil, nil
+	}
+	position, err := b.PositionAt(b.Nodes[b.Current].Parent)
+	if err != nil {
+		return nil, err
+	}
+	return game.DecodeMove(position, b.Nodes[b.Current].Move)
+}
+
+// Alternatives lists the other moves tried from the position before the last move, in algebraic notation
+func (b *Board) Alternatives() ([]string, error) {
+	if b.Current == 0 {
+		return []string{}, nil
+	}
+	parent := b.Nodes[b.Current].Parent
+	position, err := b.PositionAt(parent)
+	if err != nil {
+		return nil, err
+	}
+	alternatives := []string{}
+	for _, sibling := range b.Children(parent) {
+		if sibling == b.Current {
+			continue
+		}
+		move, err := game.DecodeMove(position, b.Nodes[sibling].Move)
+		if err != nil {
+			return nil, err
+		}
+		alternatives = append(alternatives, chess.AlgebraicNotation{}.Encode(position, move))
+	}
+	return alternatives, nil
+}
+
+// MoveText describes the line to the position shown on the board, such as "1. e4 e5 2. Nf3"
+func (b *Board) MoveText() (string, error) {
+	position, err := b.PositionAt(0)
+	if err != nil {
+		return "", err
+	}
+	text := []string{}
+	for i, n := range b.Line(b.Current) {
+		move, err := game.DecodeMove(position, b.Nodes[n].Move)
+		if err != nil {
+			return "", err
+		}
+		text = append(text, moveNumber(position, i == 0)+chess.AlgebraicNotation{}.Encode(position, move))
+		position = position.Update(move)
+	}
+	return strings.Join(text, " "), nil
+}
+
+// moveNumber numbers a move, such as "12. " for White and "12... " for Black when a line starts with Black's move
+func moveNumber(position *chess.Position, force bool) string {
+	fields := strings.Fields(position.String())
+	number := fields[len(fields)-1]
+	if position.Turn() == chess.White {
+		return number + ". "
+	}
+	if force {
+		return number + "... "
+	}
+	return ""
+}
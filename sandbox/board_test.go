@@ -0,0 +1,115 @@
+package sandbox_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cjsaylor/chessbot/game"
+	"github.com/cjsaylor/chessbot/sandbox"
+)
+
+func playGame(t *testing.T, moves ...string) *game.Game {
+	gm := game.NewGame("1234", game.Player{ID: "white"}, game.Player{ID: "black"})
+	gm.SetPlayers(game.Player{ID: "white"}, game.Player{ID: "black"})
+	for _, move := range moves {
+		if _, err := gm.Move(move); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return gm
+}
+
+func play(t *testing.T, board *sandbox.Board, moves ...string) {
+	for _, move := range moves {
+		if _, err := board.Play(move); err != nil {
+			t.Fatalf("%v: %v", move, err)
+		}
+	}
+}
+
+func back(t *testing.T, board *sandbox.Board, times int) {
+	for i := 0; i < times; i++ {
+		if err := board.Back(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestBoardVariations(t *testing.T) {
+	gm := playGame(t, "e2e4", "e7e5", "g1f3")
+	pgn := gm.PGN()
+	board := sandbox.NewBoard(gm)
+	back(t, board, 2)
+	play(t, board, "c5", "g1f3")
+	alternatives, err := board.Alternatives()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(alternatives) != 0 {
+		t.Errorf("expected Nf3 to be the only move tried after c5, got %v", alternatives)
+	}
+	back(t, board, 2)
+	play(t, board, "e5", "Nc3")
+	alternatives, err = board.Alternatives()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(alternatives, " ") != "Nf3" {
+		t.Errorf("expected Nf3 to be the alternative to Nc3, got %v", alternatives)
+	}
+	moveText, err := board.MoveText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if moveText != "1. e4 e5 2. Nc3" {
+		t.Errorf("expected the line 1. e4 e5 2. Nc3, got %v", moveText)
+	}
+	if len(board.Nodes) != 7 {
+		t.Errorf("expected following a move already tried not to add it again, got %v nodes", len(board.Nodes))
+	}
+	exported, err := board.PGN()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "1. e4 e5 (1... c5 2. Nf3) 2. Nf3 (2. Nc3) *"
+	if !strings.HasSuffix(exported, "\n\n"+expected) {
+		t.Errorf("expected the variations %v, got %v", expected, exported)
+	}
+	if !strings.Contains(exported, `[White "white"]`) || strings.Contains(exported, "[FEN") {
+		t.Errorf("expected the players tagged without a starting position, got %v", exported)
+	}
+	if gm.PGN() != pgn {
+		t.Errorf("expected the game to be unchanged, got %v", gm.PGN())
+	}
+}
+
+func TestBoardBack(t *testing.T) {
+	board := sandbox.NewBoard(playGame(t, "d2d4"))
+	back(t, board, 1)
+	if err := board.Back(); err != sandbox.ErrAtStart {
+		t.Errorf("expected ErrAtStart, got %v", err)
+	}
+	if _, err := board.Play("e5"); err == nil {
+		t.Error("expected an illegal move to be rejected")
+	}
+	move, err := board.LastMove()
+	if err != nil || move != nil {
+		t.Errorf("expected no last move at the start, got %v", move)
+	}
+}
+
+func TestBoardFromPosition(t *testing.T) {
+	gm, err := game.NewGameFromFEN("1234", "4k3/8/8/8/8/8/4P3/4K3 b - - 0 40", game.Player{ID: "a"}, game.Player{ID: "b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	board := sandbox.NewBoard(gm)
+	play(t, board, "Kd7", "e4")
+	exported, err := board.PGN()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(exported, `[FEN "4k3/8/8/8/8/8/4P3/4K3 b - - 0 40"]`) || !strings.HasSuffix(exported, "40... Kd7 41. e4 *") {
+		t.Errorf("expected the export to start from the position, got %v", exported)
+	}
+}
@@ -0,0 +1,57 @@
+package sandbox
+
+import (
+	"encoding/json"
+	"fmt"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var boardBucket = []byte("analysis_boards")
+
+// BoltStore is an implementation of the BoardStorage interface that persists using an embedded bbolt database
+type BoltStore struct {
+	db *bolt.DB
+}
+
+// NewBoltStore creates (if not exists) the bucket used for analysis boards within an open bbolt database
+// It implements the BoardStorage interface and is intended as a suitable perminent storage of analysis boards
+func NewBoltStore(db *bolt.DB) (*BoltStore, error) {
+	err := db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(boardBucket)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &BoltStore{db: db}, nil
+}
+
+// StoreBoard stores an analysis board by the thread it is played in
+// Note: This will overwrite a previous board played in the same thread
+func (b *BoltStore) StoreBoard(board *Board) error {
+	data, err := json.Marshal(board)
+	if err != nil {
+		return err
+	}
+	return b.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(boardBucket).Put([]byte(board.TeamID+"\x00"+board.ThreadID), data)
+	})
+}
+
+// RetrieveBoard finds the analysis board played in a thread
+func (b *BoltStore) RetrieveBoard(teamID string, threadID string) (*Board, error) {
+	var board *Board
+	err := b.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(boardBucket).Get([]byte(teamID + "\x00" + threadID))
+		if data == nil {
+			return fmt.Errorf("Analysis board not found in thread %v", threadID)
+		}
+		board = &Board{}
+		return json.Unmarshal(data, board)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return board, nil
+}
@@ -0,0 +1,46 @@
+package sandbox
+
+import (
+	"fmt"
+	"sync"
+)
+
+// MemoryStore implements the BoardStorage interface and holds all state in memory
+// Once the MemoryStore instance is released, all data in that storage is lost
+type MemoryStore struct {
+	mu     sync.RWMutex
+	boards map[string]*Board
+}
+
+// NewMemoryStore returns a MemoryStore pointer
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		boards: make(map[string]*Board, 10),
+	}
+}
+
+// StoreBoard stores an analysis board by the thread it is played in
+// Note: This will overwrite a previous board played in the same thread
+func (m *MemoryStore) StoreBoard(board *Board) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.boards[board.TeamID+"\x00"+board.ThreadID] = copyBoard(board)
+	return nil
+}
+
+// RetrieveBoard finds the analysis board played in a thread
+func (m *MemoryStore) RetrieveBoard(teamID string, threadID string) (*Board, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	board, ok := m.boards[teamID+"\x00"+threadID]
+	if !ok {
+		return nil, fmt.Errorf("Analysis board not found in thread %v", threadID)
+	}
+	return copyBoard(board), nil
+}
+
+func copyBoard(board *Board) *Board {
+	copied := *board
+	copied.Nodes = append([]Node(nil), board.Nodes...)
+	return &copied
+}
@@ -0,0 +1,68 @@
+package sandbox
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cjsaylor/chessbot/game"
+	"github.com/notnil/chess"
+)
+
+// startingFEN is the standard starting position, which isn't tagged in exports
+const startingFEN = "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1"
+
+// PGN exports the board with every variation tried, the main line first and sidelines in parentheses (RAV)
+func (b *Board) PGN() (string, error) {
+	position, err := b.PositionAt(0)
+	if err != nil {
+		return "", err
+	}
+	tags := []string{
+		`[Event "Analysis board"]`,
+		`[Site "Slack ChessBot analysis"]`,
+		fmt.Sprintf(`[White "%v"]`, b.WhiteID),
+		fmt.Sprintf(`[Black "%v"]`, b.BlackID),
+		`[Result "*"]`,
+	}
+	if b.StartingFEN != startingFEN {
+		tags = append(tags, `[SetUp "1"]`, fmt.Sprintf(`[FEN "%v"]`, b.StartingFEN))
+	}
+	moves, err := b.variation(0, position, true)
+	if err != nil {
+		return "", err
+	}
+	moves = append(moves, "*")
+	return strings.Join(tags, "\n") + "\n\n" + strings.Join(moves, " "), nil
+}
+
+// variation writes the line following the main line from a node, with the sidelines of every move in parentheses
+func (b *Board) variation(node int, position *chess.Position, numbered bool) ([]string, error) {
+	tokens := []string{}
+	for {
+		children := b.Children(node)
+		if len(children) == 0 {
+			return tokens, nil
+		}
+		main, err := game.DecodeMove(position, b.Nodes[children[0]].Move)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, moveNumber(position, numbered)+chess.AlgebraicNotation{}.Encode(position, main))
+		for _, sideline := range children[1:] {
+			move, err := game.DecodeMove(position, b.Nodes[sideline].Move)
+			if err != nil {
+				return nil, err
+			}
+			continuation, err := b.variation(sideline, position.Update(move), false)
+			if err != nil {
+				return nil, err
+			}
+			line := append([]string{moveNumber(position, true) + chess.AlgebraicNotation{}.Encode(position, move)}, continuation...)
+			tokens = append(tokens, "("+strings.Join(line, " ")+")")
+		}
+		// Black's move is numbered again after a sideline interrupts the main line
+		numbered = len(children) > 1
+		node = children[0]
+		position = position.Update(main)
+	}
+}
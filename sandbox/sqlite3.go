@@ -0,0 +1,109 @@
+package sandbox
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	// import sqlite package for use with the sql interface
+	_ "github.com/mattn/go-sqlite3"
+)
+
+const boardTableCreation = `
+	CREATE TABLE IF NOT EXISTS analysis_boards (
+		team_id text NOT NULL,
+		thread_id text NOT NULL,
+		channel_id text NOT NULL,
+		game_id text NOT NULL,
+		white_id text NOT NULL,
+		black_id text NOT NULL,
+		starting_fen text NOT NULL,
+		nodes text NOT NULL,
+		current integer NOT NULL,
+		created_at datetime NOT NULL,
+		PRIMARY KEY (team_id, thread_id)
+	);
+`
+
+// SqliteStore is an implementation of the BoardStorage interface that persists using sqlite3
+type SqliteStore struct {
+	path string
+	db   *sql.DB
+}
+
+// NewSqliteStore creates (if not exists) the DB file and structure at the path specified
+// It implements the BoardStorage interface and is intended as a suitable
+// perminent storage of analysis boards
+func NewSqliteStore(path string) (*SqliteStore, error) {
+	store := SqliteStore{
+		path: path,
+	}
+	db, err := sql.Open("sqlite3", fmt.Sprintf("%v?parseTime=1", path))
+	if err != nil {
+		return nil, err
+	}
+	// sqlite only supports a single writer, serialize access to avoid locking errors
+	db.SetMaxOpenConns(1)
+	if _, err = db.Exec(boardTableCreation); err != nil {
+		return nil, err
+	}
+	store.db = db
+	return &store, nil
+}
+
+// StoreBoard stores an analysis board by the thread it is played in
+// Note: This will overwrite a previous board played in the same thread
+func (s *SqliteStore) StoreBoard(board *Board) error {
+	nodes, err := json.Marshal(board.Nodes)
+	if err != nil {
+		return err
+	}
+	stmt, _ := s.db.Prepare(`
+	insert or replace into analysis_boards
+		(team_id, thread_id, channel_id, game_id, white_id, black_id, starting_fen, nodes, current, created_at)
+		values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`)
+	defer stmt.Close()
+	_, err = stmt.Exec(
+		board.TeamID,
+		board.ThreadID,
+		board.ChannelID,
+		board.GameID,
+		board.WhiteID,
+		board.BlackID,
+		board.StartingFEN,
+		string(nodes),
+		board.Current,
+		board.CreatedAt.UTC(),
+	)
+	return err
+}
+
+// RetrieveBoard finds the analysis board played in a thread
+func (s *SqliteStore) RetrieveBoard(teamID string, threadID string) (*Board, error) {
+	var board Board
+	var nodes string
+	err := s.db.QueryRow(`
+	select team_id, thread_id, channel_id, game_id, white_id, black_id, starting_fen, nodes, current, created_at
+		from analysis_boards
+		where team_id = ? and thread_id = ?
+	`, teamID, threadID).Scan(
+		&board.TeamID,
+		&board.ThreadID,
+		&board.ChannelID,
+		&board.GameID,
+		&board.WhiteID,
+		&board.BlackID,
+		&board.StartingFEN,
+		&nodes,
+		&board.Current,
+		&board.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(nodes), &board.Nodes); err != nil {
+		return nil, err
+	}
+	return &board, nil
+}
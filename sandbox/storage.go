@@ -0,0 +1,7 @@
+package sandbox
+
+// BoardStorage is an interface to be implemented for persisting analysis boards by the thread they are played in
+type BoardStorage interface {
+	StoreBoard(board *Board) error
+	RetrieveBoard(teamID string, threadID string) (*Board, error)
+}
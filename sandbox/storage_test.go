@@ -0,0 +1,25 @@
+package sandbox_test
+
+import (
+	"testing"
+
+	"github.com/cjsaylor/chessbot/sandbox"
+	"github.com/cjsaylor/chessbot/storagetest"
+	"github.com/cjsaylor/chessbot/storagetest/sandboxtest"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestStoreConformance(t *testing.T) {
+	storagetest.RunStores(t, storagetest.Stores{
+		Memory: func() interface{} { return sandbox.NewMemoryStore() },
+		Sqlite: func(path string) (interface{}, error) { return sandbox.NewSqliteStore(path) },
+		Bolt:   func(db *bolt.DB) (interface{}, error) { return sandbox.NewBoltStore(db) },
+	}, func(t *testing.T, open storagetest.Opener) {
+		sandboxtest.Run(t, func(t *testing.T) sandboxtest.Backend {
+			store := open(t)
+			return sandboxtest.Backend{
+				BoardStorage: store.(sandbox.BoardStorage),
+			}
+		})
+	})
+}
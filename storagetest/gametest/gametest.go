@@ -0,0 +1,718 @@
+// Package gametest is the conformance suite of the game, challenge, takeback, archive and player alias storage backends.
+package gametest
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/cjsaylor/chessbot/game"
+	"github.com/cjsaylor/chessbot/storagetest"
+)
+
+// Backend groups the storage implementations under test.
+// Storage left nil is skipped by the suite.
+type Backend struct {
+	GameStorage      game.GameStorage
+	ChallengeStorage game.ChallengeStorage
+	TakebackStorage  game.TakebackStorage
+	ArchiveStorage   game.ArchiveStorage
+	AliasStorage     game.PlayerAliasStorage
+}
+
+// Factory returns a fresh and empty backend. It is called once per test case.
+type Factory func(t *testing.T) Backend
+
+// Run exercises every method of the storage interfaces provided by the factory's backend.
+func Run(t *testing.T, factory Factory) {
+	t.Run("GameStorage", func(t *testing.T) {
+		RunGameStorage(t, factory)
+	})
+	t.Run("ChallengeStorage", func(t *testing.T) {
+		RunChallengeStorage(t, factory)
+	})
+	t.Run("TakebackStorage", func(t *testing.T) {
+		RunTakebackStorage(t, factory)
+	})
+	t.Run("ArchiveStorage", func(t *testing.T) {
+		RunArchiveStorage(t, factory)
+	})
+	t.Run("PlayerAliasStorage", func(t *testing.T) {
+		RunPlayerAliasStorage(t, factory)
+	})
+}
+
+func newGame(ID string) *game.Game {
+	return game.NewGame(ID, game.Player{ID: "player1"}, game.Player{ID: "player2"})
+}
+
+// RunGameStorage exercises the game.GameStorage implementation of the backend.
+func RunGameStorage(t *testing.T, factory Factory) {
+	store := func(t *testing.T) game.GameStorage {
+		storage := factory(t).GameStorage
+		if storage == nil {
+			t.Skip("backend does not implement game storage")
+		}
+		return storage
+	}
+
+	t.Run("StoreAndRetrieve", func(t *testing.T) {
+		db := store(t)
+		gm := newGame("1234")
+		gm.Move("d2d4")
+		if err := db.StoreGame(gm.ID, gm); err != nil {
+			t.Fatal(err)
+		}
+		retrieved, err := db.RetrieveGame(gm.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if retrieved.ID != gm.ID {
+			t.Errorf("expected game ID %v, got %v", gm.ID, retrieved.ID)
+		}
+		if retrieved.FEN() != gm.FEN() {
+			t.Errorf("expected FEN %v, got %v", gm.FEN(), retrieved.FEN())
+		}
+		for _, color := range []game.Color{game.White, game.Black} {
+			if retrieved.Players[color].ID != gm.Players[color].ID {
+				t.Errorf("expected %v player %v, got %v", color, gm.Players[color].ID, retrieved.Players[color].ID)
+			}
+		}
+		if !retrieved.LastMoved().Equal(gm.LastMoved()) {
+			t.Errorf("expected last moved %v, got %v", gm.LastMoved(), retrieved.LastMoved())
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		db := store(t)
+		if _, err := db.RetrieveGame("NOEXISTID"); err == nil {
+			t.Error("expected an error retrieving a game that does not exist")
+		}
+	})
+
+	t.Run("Overwrite", func(t *testing.T) {
+		db := store(t)
+		gm := newGame("1234")
+		if err := db.StoreGame(gm.ID, gm); err != nil {
+			t.Fatal(err)
+		}
+		gm.Move("d2d4")
+		gm.Move("d7d5")
+		if err := db.StoreGame(gm.ID, gm); err != nil {
+			t.Fatal(err)
+		}
+		retrieved, err := db.RetrieveGame(gm.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if retrieved.FEN() != gm.FEN() {
+			t.Errorf("expected overwritten FEN %v, got %v", gm.FEN(), retrieved.FEN())
+		}
+		if retrieved.Players[game.White].ID != gm.Players[game.White].ID {
+			t.Errorf("expected players to be retained after an overwrite")
+		}
+		if retrieved.LastMoved().IsZero() {
+			t.Error("expected the last moved time to be updated")
+		}
+	})
+
+	t.Run("Annotations", func(t *testing.T) {
+		db := store(t)
+		gm := newGame("1234")
+		gm.Move("e2e4")
+		if err := db.StoreGame(gm.ID, gm); err != nil {
+			t.Fatal(err)
+		}
+		gm.Move("e7e5")
+		gm.AnnotateMove(1, game.MoveAnnotation{NAG: 5, Comment: "the king's pawn"})
+		gm.AnnotateMove(2, game.MoveAnnotation{NAG: 14})
+		if err := db.StoreGame(gm.ID, gm); err != nil {
+			t.Fatal(err)
+		}
+		retrieved, err := db.RetrieveGame(gm.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(retrieved.MoveAnnotations(), gm.MoveAnnotations()) {
+			t.Errorf("expected annotations %v, got %v", gm.MoveAnnotations(), retrieved.MoveAnnotations())
+		}
+	})
+
+	t.Run("Adjudication", func(t *testing.T) {
+		db := store(t)
+		gm := newGame("1234")
+		gm.AgreeToAdjudicate(gm.Players[game.White].ID)
+		if err := db.StoreGame(gm.ID, gm); err != nil {
+			t.Fatal(err)
+		}
+		retrieved, err := db.RetrieveGame(gm.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if agreed := retrieved.AgreeToAdjudicate(gm.Players[game.Black].ID); agreed != 2 {
+			t.Errorf("expected the stored agreement to be retained, got %v agreements", agreed)
+		}
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		db := store(t)
+		errs := storagetest.Parallel(func(i int) error {
+			gm := newGame(fmt.Sprintf("game%v", i))
+			if err := db.StoreGame(gm.ID, gm); err != nil {
+				return err
+			}
+			gm.Move("e2e4")
+			if err := db.StoreGame(gm.ID, gm); err != nil {
+				return err
+			}
+			retrieved, err := db.RetrieveGame(gm.ID)
+			if err != nil {
+				return err
+			}
+			if retrieved.FEN() != gm.FEN() {
+				return fmt.Errorf("expected FEN %v for game %v, got %v", gm.FEN(), gm.ID, retrieved.FEN())
+			}
+			return nil
+		})
+		for _, err := range errs {
+			t.Error(err)
+		}
+	})
+}
+
+// RunChallengeStorage exercises the game.ChallengeStorage implementation of the backend.
+func RunChallengeStorage(t *testing.T, factory Factory) {
+	store := func(t *testing.T) game.ChallengeStorage {
+		storage := factory(t).ChallengeStorage
+		if storage == nil {
+			t.Skip("backend does not implement challenge storage")
+		}
+		return storage
+	}
+
+	t.Run("StoreAndRetrieve", func(t *testing.T) {
+		db := store(t)
+		challenge := &game.Challenge{
+			ChallengerID: "challenger",
+			ChallengedID: "challenged",
+			GameID:       "1234",
+			ChannelID:    "channel",
+			Coach:        true,
+		}
+		if err := db.StoreChallenge(challenge); err != nil {
+			t.Fatal(err)
+		}
+		retrieved, err := db.RetrieveChallenge("challenger", "challenged")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if *retrieved != *challenge {
+			t.Errorf("expected challenge %v, got %v", *challenge, *retrieved)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		db := store(t)
+		if _, err := db.RetrieveChallenge("challenger", "challenged"); err == nil {
+			t.Error("expected an error retrieving a challenge that does not exist")
+		}
+		db.StoreChallenge(&game.Challenge{
+			ChallengerID: "challenger",
+			ChallengedID: "challenged",
+			GameID:       "1234",
+			ChannelID:    "channel",
+		})
+		if _, err := db.RetrieveChallenge("challenged", "challenger"); err == nil {
+			t.Error("expected an error retrieving a challenge with the players reversed")
+		}
+	})
+
+	t.Run("NoOverwrite", func(t *testing.T) {
+		db := store(t)
+		original := &game.Challenge{
+			ChallengerID: "challenger",
+			ChallengedID: "challenged",
+			GameID:       "1234",
+			ChannelID:    "channel",
+		}
+		if err := db.StoreChallenge(original); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.StoreChallenge(&game.Challenge{
+			ChallengerID: "challenger",
+			ChallengedID: "challenged",
+			GameID:       "5678",
+			ChannelID:    "other",
+		}); err == nil {
+			t.Error("expected an error storing a challenge between players with an open challenge")
+		}
+		retrieved, err := db.RetrieveChallenge("challenger", "challenged")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if *retrieved != *original {
+			t.Errorf("expected the original challenge %v to be retained, got %v", *original, *retrieved)
+		}
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		db := store(t)
+		db.StoreChallenge(&game.Challenge{
+			ChallengerID: "challenger",
+			ChallengedID: "challenged",
+			GameID:       "1234",
+			ChannelID:    "channel",
+		})
+		if err := db.RemoveChallenge("challenger", "challenged"); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := db.RetrieveChallenge("challenger", "challenged"); err == nil {
+			t.Error("expected an error retrieving a removed challenge")
+		}
+		if err := db.RemoveChallenge("challenger", "challenged"); err != nil {
+			t.Errorf("expected removing a challenge that does not exist to succeed, got %v", err)
+		}
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		db := store(t)
+		errs := storagetest.Parallel(func(i int) error {
+			challenge := &game.Challenge{
+				ChallengerID: fmt.Sprintf("challenger%v", i),
+				ChallengedID: "challenged",
+				GameID:       fmt.Sprintf("game%v", i),
+				ChannelID:    "channel",
+			}
+			if err := db.StoreChallenge(challenge); err != nil {
+				return err
+			}
+			retrieved, err := db.RetrieveChallenge(challenge.ChallengerID, challenge.ChallengedID)
+			if err != nil {
+				return err
+			}
+			if *retrieved != *challenge {
+				return fmt.Errorf("expected challenge %v, got %v", *challenge, *retrieved)
+			}
+			return db.RemoveChallenge(challenge.ChallengerID, challenge.ChallengedID)
+		})
+		for _, err := range errs {
+			t.Error(err)
+		}
+	})
+}
+
+// RunTakebackStorage exercises the game.TakebackStorage implementation of the backend.
+// Takebacks are attached to stored games, so the backend must also provide game storage.
+func RunTakebackStorage(t *testing.T, factory Factory) {
+	store := func(t *testing.T) (game.GameStorage, game.TakebackStorage) {
+		backend := factory(t)
+		if backend.TakebackStorage == nil {
+			t.Skip("backend does not implement takeback storage")
+		}
+		if backend.GameStorage == nil {
+			t.Fatal("takeback storage requires the backend to provide game storage")
+		}
+		return backend.GameStorage, backend.TakebackStorage
+	}
+
+	t.Run("StoreAndRetrieve", func(t *testing.T) {
+		games, db := store(t)
+		gm := newGame("1234")
+		gm.Move("d2d4")
+		games.StoreGame(gm.ID, gm)
+		if err := db.StoreTakeback(game.NewTakeback(gm)); err != nil {
+			t.Fatal(err)
+		}
+		retrieved, err := db.RetrieveTakeback(gm.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if retrieved.CurrentGame.ID != gm.ID {
+			t.Errorf("expected takeback for game %v, got %v", gm.ID, retrieved.CurrentGame.ID)
+		}
+		if retrieved.FENSnapshot != gm.FEN() {
+			t.Errorf("expected FEN snapshot %v, got %v", gm.FEN(), retrieved.FENSnapshot)
+		}
+		if !retrieved.IsValidTakeback() {
+			t.Error("expected the retrieved takeback to be valid")
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		games, db := store(t)
+		if _, err := db.RetrieveTakeback("NOEXISTID"); err == nil {
+			t.Error("expected an error retrieving a takeback for a game that does not exist")
+		}
+		gm := newGame("1234")
+		games.StoreGame(gm.ID, gm)
+		if _, err := db.RetrieveTakeback(gm.ID); err == nil {
+			t.Error("expected an error retrieving a takeback that was never requested")
+		}
+	})
+
+	t.Run("Overwrite", func(t *testing.T) {
+		games, db := store(t)
+		gm := newGame("1234")
+		gm.Move("d2d4")
+		games.StoreGame(gm.ID, gm)
+		db.StoreTakeback(game.NewTakeback(gm))
+		gm.Move("d7d5")
+		games.StoreGame(gm.ID, gm)
+		if err := db.StoreTakeback(game.NewTakeback(gm)); err != nil {
+			t.Fatal(err)
+		}
+		retrieved, err := db.RetrieveTakeback(gm.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if retrieved.FENSnapshot != gm.FEN() {
+			t.Errorf("expected overwritten FEN snapshot %v, got %v", gm.FEN(), retrieved.FENSnapshot)
+		}
+	})
+
+	t.Run("TracksCurrentGame", func(t *testing.T) {
+		games, db := store(t)
+		gm := newGame("1234")
+		gm.Move("d2d4")
+		games.StoreGame(gm.ID, gm)
+		db.StoreTakeback(game.NewTakeback(gm))
+		gm.Move("d7d5")
+		games.StoreGame(gm.ID, gm)
+		retrieved, err := db.RetrieveTakeback(gm.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if retrieved.CurrentGame.FEN() != gm.FEN() {
+			t.Errorf("expected the takeback to reference the stored game state %v, got %v", gm.FEN(), retrieved.CurrentGame.FEN())
+		}
+		if retrieved.IsValidTakeback() {
+			t.Error("expected the takeback to be invalid after another move was stored")
+		}
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		games, db := store(t)
+		gm := newGame("1234")
+		gm.Move("d2d4")
+		games.StoreGame(gm.ID, gm)
+		takeback := game.NewTakeback(gm)
+		db.StoreTakeback(takeback)
+		if err := db.RemoveTakeback(takeback); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := db.RetrieveTakeback(gm.ID); err == nil {
+			t.Error("expected an error retrieving a removed takeback")
+		}
+		if err := db.RemoveTakeback(takeback); err != nil {
+			t.Errorf("expected removing a takeback that does not exist to succeed, got %v", err)
+		}
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		games, db := store(t)
+		errs := storagetest.Parallel(func(i int) error {
+			gm := newGame(fmt.Sprintf("game%v", i))
+			gm.Move("e2e4")
+			if err := games.StoreGame(gm.ID, gm); err != nil {
+				return err
+			}
+			if err := db.StoreTakeback(game.NewTakeback(gm)); err != nil {
+				return err
+			}
+			retrieved, err := db.RetrieveTakeback(gm.ID)
+			if err != nil {
+				return err
+			}
+			if retrieved.FENSnapshot != gm.FEN() {
+				return fmt.Errorf("expected FEN snapshot %v for game %v, got %v", gm.FEN(), gm.ID, retrieved.FENSnapshot)
+			}
+			return db.RemoveTakeback(retrieved)
+		})
+		for _, err := range errs {
+			t.Error(err)
+		}
+	})
+}
+
+func newArchivedGame(ID string, teamID string, endedAt time.Time) *game.ArchivedGame {
+	gm := game.NewGame(ID, game.Player{ID: "player1"}, game.Player{ID: "player2"})
+	gm.SetTimeProvider(func() time.Time {
+		return endedAt
+	})
+	gm.Move("f2f3")
+	gm.Move("e7e5")
+	gm.Move("g2g4")
+	gm.Move("d8h4")
+	archived, _ := gm.Archive(teamID)
+	return archived
+}
+
+// collectArchivedGames retrieves all archived games matching a filter as a list of game IDs
+func collectArchivedGames(db game.ArchiveStorage, filter game.ArchiveFilter) ([]string, error) {
+	IDs := []string{}
+	err := db.RetrieveArchivedGames(filter, func(archived *game.ArchivedGame) error {
+		IDs = append(IDs, archived.GameID)
+		return nil
+	})
+	return IDs, err
+}
+
+// RunArchiveStorage exercises the game.ArchiveStorage implementation of the backend.
+func RunArchiveStorage(t *testing.T, factory Factory) {
+	store := func(t *testing.T) game.ArchiveStorage {
+		storage := factory(t).ArchiveStorage
+		if storage == nil {
+			t.Skip("backend does not implement archive storage")
+		}
+		return storage
+	}
+	start := time.Date(2019, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("StoreAndRetrieve", func(t *testing.T) {
+		db := store(t)
+		archived := newArchivedGame("1234", "team", start)
+		if err := db.StoreArchivedGame(archived); err != nil {
+			t.Fatal(err)
+		}
+		retrieved := []*game.ArchivedGame{}
+		err := db.RetrieveArchivedGames(game.ArchiveFilter{}, func(archived *game.ArchivedGame) error {
+			retrieved = append(retrieved, archived)
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(retrieved) != 1 {
+			t.Fatalf("expected 1 archived game, got %v", len(retrieved))
+		}
+		if !retrieved[0].EndedAt.Equal(archived.EndedAt) {
+			t.Errorf("expected ended at %v, got %v", archived.EndedAt, retrieved[0].EndedAt)
+		}
+		retrieved[0].EndedAt = archived.EndedAt
+		if *retrieved[0] != *archived {
+			t.Errorf("expected archived game %v, got %v", *archived, *retrieved[0])
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		db := store(t)
+		IDs, err := collectArchivedGames(db, game.ArchiveFilter{TeamID: "team"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(IDs) != 0 {
+			t.Errorf("expected no archived games, got %v", IDs)
+		}
+	})
+
+	t.Run("RetrieveByID", func(t *testing.T) {
+		db := store(t)
+		archived := newArchivedGame("1234", "team", start)
+		db.StoreArchivedGame(archived)
+		db.StoreArchivedGame(newArchivedGame("5678", "team", start.Add(time.Hour)))
+		retrieved, err := db.RetrieveArchivedGame("1234")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if retrieved.GameID != archived.GameID || retrieved.PGN != archived.PGN || !retrieved.EndedAt.Equal(archived.EndedAt) {
+			t.Errorf("expected archived game %v, got %v", *archived, *retrieved)
+		}
+		if _, err := db.RetrieveArchivedGame("4321"); err == nil {
+			t.Error("expected an error retrieving a game that was not archived")
+		}
+	})
+
+	t.Run("Overwrite", func(t *testing.T) {
+		db := store(t)
+		db.StoreArchivedGame(newArchivedGame("1234", "team", start))
+		if err := db.StoreArchivedGame(newArchivedGame("1234", "team", start.Add(time.Hour))); err != nil {
+			t.Fatal(err)
+		}
+		IDs, err := collectArchivedGames(db, game.ArchiveFilter{From: start.Add(time.Minute)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(IDs, []string{"1234"}) {
+			t.Errorf("expected the archived game to be replaced, got %v", IDs)
+		}
+	})
+
+	t.Run("Filter", func(t *testing.T) {
+		db := store(t)
+		first := newArchivedGame("first", "team1", start)
+		second := newArchivedGame("second", "team1", start.Add(24*time.Hour))
+		second.WhiteID = "other"
+		second.BlackID = "player3"
+		third := newArchivedGame("third", "team2", start.Add(48*time.Hour))
+		for _, archived := range []*game.ArchivedGame{third, first, second} {
+			if err := db.StoreArchivedGame(archived); err != nil {
+				t.Fatal(err)
+			}
+		}
+		for _, tt := range []struct {
+			name     string
+			filter   game.ArchiveFilter
+			expected []string
+		}{
+			{"all", game.ArchiveFilter{}, []string{"first", "second", "third"}},
+			{"team", game.ArchiveFilter{TeamID: "team1"}, []string{"first", "second"}},
+			{"player", game.ArchiveFilter{PlayerID: "player1"}, []string{"first", "third"}},
+			{"black player", game.ArchiveFilter{PlayerID: "player3"}, []string{"second"}},
+			{"from", game.ArchiveFilter{From: start.Add(24 * time.Hour)}, []string{"second", "third"}},
+			{"to", game.ArchiveFilter{To: start.Add(24 * time.Hour)}, []string{"first"}},
+			{"combined", game.ArchiveFilter{TeamID: "team1", PlayerID: "player1", To: start.Add(72 * time.Hour)}, []string{"first"}},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				IDs, err := collectArchivedGames(db, tt.filter)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !reflect.DeepEqual(IDs, tt.expected) {
+					t.Errorf("expected archived games %v, got %v", tt.expected, IDs)
+				}
+			})
+		}
+	})
+
+	t.Run("CallbackError", func(t *testing.T) {
+		db := store(t)
+		db.StoreArchivedGame(newArchivedGame("first", "team", start))
+		db.StoreArchivedGame(newArchivedGame("second", "team", start.Add(time.Hour)))
+		stop := fmt.Errorf("stop")
+		calls := 0
+		err := db.RetrieveArchivedGames(game.ArchiveFilter{}, func(archived *game.ArchivedGame) error {
+			calls++
+			return stop
+		})
+		if err != stop {
+			t.Errorf("expected the callback error to be returned, got %v", err)
+		}
+		if calls != 1 {
+			t.Errorf("expected retrieval to stop after the callback error, called %v times", calls)
+		}
+	})
+
+	t.Run("CallbackUsesStore", func(t *testing.T) {
+		db := store(t)
+		expected := []string{}
+		for i := 0; i < 250; i++ {
+			ID := fmt.Sprintf("game%03d", i)
+			// several games end at the same time so pages split between them
+			if err := db.StoreArchivedGame(newArchivedGame(ID, "team", start.Add(time.Duration(i/3)*time.Minute))); err != nil {
+				t.Fatal(err)
+			}
+			expected = append(expected, ID)
+		}
+		IDs := []string{}
+		err := db.RetrieveArchivedGames(game.ArchiveFilter{TeamID: "team"}, func(archived *game.ArchivedGame) error {
+			IDs = append(IDs, archived.GameID)
+			// a slow consumer, such as an export to a client, may use the store while reading
+			copied := *archived
+			copied.GameID = "copy-" + archived.GameID
+			copied.TeamID = "copy"
+			return db.StoreArchivedGame(&copied)
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		sort.Strings(IDs)
+		if !reflect.DeepEqual(IDs, expected) {
+			t.Errorf("expected all %v archived games once, got %v", len(expected), len(IDs))
+		}
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		db := store(t)
+		errs := storagetest.Parallel(func(i int) error {
+			teamID := fmt.Sprintf("team%v", i)
+			if err := db.StoreArchivedGame(newArchivedGame(fmt.Sprintf("game%v", i), teamID, start)); err != nil {
+				return err
+			}
+			IDs, err := collectArchivedGames(db, game.ArchiveFilter{TeamID: teamID})
+			if err != nil {
+				return err
+			}
+			if !reflect.DeepEqual(IDs, []string{fmt.Sprintf("game%v", i)}) {
+				return fmt.Errorf("expected only game%v for %v, got %v", i, teamID, IDs)
+			}
+			return nil
+		})
+		for _, err := range errs {
+			t.Error(err)
+		}
+	})
+}
+
+// RunPlayerAliasStorage exercises the game.PlayerAliasStorage implementation of the backend.
+func RunPlayerAliasStorage(t *testing.T, factory Factory) {
+	store := func(t *testing.T) game.PlayerAliasStorage {
+		storage := factory(t).AliasStorage
+		if storage == nil {
+			t.Skip("backend does not implement player alias storage")
+		}
+		return storage
+	}
+
+	t.Run("StoreAndRetrieve", func(t *testing.T) {
+		db := store(t)
+		if err := db.StorePlayerAlias("team", "magnus carlsen", "U123"); err != nil {
+			t.Fatal(err)
+		}
+		playerID, err := db.RetrievePlayerAlias("team", "magnus carlsen")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if playerID != "U123" {
+			t.Errorf("expected player ID U123, got %v", playerID)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		db := store(t)
+		if _, err := db.RetrievePlayerAlias("team", "magnus carlsen"); err == nil {
+			t.Error("expected an error retrieving an unknown alias")
+		}
+		db.StorePlayerAlias("team", "magnus carlsen", "U123")
+		if _, err := db.RetrievePlayerAlias("other", "magnus carlsen"); err == nil {
+			t.Error("expected aliases to be scoped to a team")
+		}
+	})
+
+	t.Run("Overwrite", func(t *testing.T) {
+		db := store(t)
+		db.StorePlayerAlias("team", "magnus carlsen", "U123")
+		if err := db.StorePlayerAlias("team", "magnus carlsen", "U456"); err != nil {
+			t.Fatal(err)
+		}
+		playerID, err := db.RetrievePlayerAlias("team", "magnus carlsen")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if playerID != "U456" {
+			t.Errorf("expected the alias to be remapped to U456, got %v", playerID)
+		}
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		db := store(t)
+		errs := storagetest.Parallel(func(i int) error {
+			name := fmt.Sprintf("player %v", i)
+			if err := db.StorePlayerAlias("team", name, fmt.Sprintf("U%v", i)); err != nil {
+				return err
+			}
+			playerID, err := db.RetrievePlayerAlias("team", name)
+			if err != nil {
+				return err
+			}
+			if playerID != fmt.Sprintf("U%v", i) {
+				return fmt.Errorf("expected player ID U%v, got %v", i, playerID)
+			}
+			return nil
+		})
+		for _, err := range errs {
+			t.Error(err)
+		}
+	})
+}
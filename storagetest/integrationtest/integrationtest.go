@@ -0,0 +1,197 @@
+// Package integrationtest is the conformance suite of the auth and preference storage backends.
+package integrationtest
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/cjsaylor/chessbot/integration"
+	"github.com/cjsaylor/chessbot/storagetest"
+)
+
+// Backend groups the storage implementations under test.
+// Storage left nil is skipped by the suite.
+type Backend struct {
+	AuthStorage       integration.AuthStorage
+	PreferenceStorage integration.PreferenceStorage
+}
+
+// Factory returns a fresh and empty backend. It is called once per test case.
+type Factory func(t *testing.T) Backend
+
+// Run exercises every method of the storage interfaces provided by the factory's backend.
+func Run(t *testing.T, factory Factory) {
+	t.Run("AuthStorage", func(t *testing.T) {
+		RunAuthStorage(t, factory)
+	})
+	t.Run("PreferenceStorage", func(t *testing.T) {
+		RunPreferenceStorage(t, factory)
+	})
+}
+
+// RunAuthStorage exercises the integration.AuthStorage implementation of the backend.
+func RunAuthStorage(t *testing.T, factory Factory) {
+	store := func(t *testing.T) integration.AuthStorage {
+		storage := factory(t).AuthStorage
+		if storage == nil {
+			t.Skip("backend does not implement auth storage")
+		}
+		return storage
+	}
+
+	t.Run("StoreAndRetrieve", func(t *testing.T) {
+		db := store(t)
+		if err := db.StoreAuthToken("team", "xoxb-token"); err != nil {
+			t.Fatal(err)
+		}
+		token, err := db.GetAuthToken("team")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if token != "xoxb-token" {
+			t.Errorf("expected token %v, got %v", "xoxb-token", token)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		db := store(t)
+		if _, err := db.GetAuthToken("team"); err == nil {
+			t.Error("expected an error retrieving a token for an unknown team")
+		}
+	})
+
+	t.Run("Overwrite", func(t *testing.T) {
+		db := store(t)
+		db.StoreAuthToken("team", "xoxb-old")
+		if err := db.StoreAuthToken("team", "xoxb-new"); err != nil {
+			t.Fatal(err)
+		}
+		token, err := db.GetAuthToken("team")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if token != "xoxb-new" {
+			t.Errorf("expected the token to be replaced with %v, got %v", "xoxb-new", token)
+		}
+	})
+
+	t.Run("TeamIDs", func(t *testing.T) {
+		lister, ok := store(t).(integration.AuthTeamLister)
+		if !ok {
+			t.Skip("backend does not implement listing teams")
+		}
+		db := lister.(integration.AuthStorage)
+		db.StoreAuthToken("team1", "xoxb-1")
+		db.StoreAuthToken("team2", "xoxb-2")
+		db.StoreAuthToken("team2", "xoxb-3")
+		teamIDs, err := lister.AuthTeamIDs()
+		if err != nil {
+			t.Fatal(err)
+		}
+		sort.Strings(teamIDs)
+		if !reflect.DeepEqual(teamIDs, []string{"team1", "team2"}) {
+			t.Errorf("expected team IDs %v, got %v", []string{"team1", "team2"}, teamIDs)
+		}
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		db := store(t)
+		errs := storagetest.Parallel(func(i int) error {
+			teamID := fmt.Sprintf("team%v", i)
+			if err := db.StoreAuthToken(teamID, "xoxb-"+teamID); err != nil {
+				return err
+			}
+			token, err := db.GetAuthToken(teamID)
+			if err != nil {
+				return err
+			}
+			if token != "xoxb-"+teamID {
+				return fmt.Errorf("expected token %v, got %v", "xoxb-"+teamID, token)
+			}
+			return nil
+		})
+		for _, err := range errs {
+			t.Error(err)
+		}
+	})
+}
+
+// RunPreferenceStorage exercises the integration.PreferenceStorage implementation of the backend.
+func RunPreferenceStorage(t *testing.T, factory Factory) {
+	store := func(t *testing.T) integration.PreferenceStorage {
+		storage := factory(t).PreferenceStorage
+		if storage == nil {
+			t.Skip("backend does not implement preference storage")
+		}
+		return storage
+	}
+
+	t.Run("StoreAndRetrieve", func(t *testing.T) {
+		db := store(t)
+		preferences := integration.DisplayPreferences{
+			Theme:           "green",
+			PieceSet:        "unicode",
+			BoardSize:       768,
+			HideCoordinates: true,
+		}
+		if err := db.StorePreferences("team", "U123", preferences); err != nil {
+			t.Fatal(err)
+		}
+		retrieved, err := db.RetrievePreferences("team", "U123")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if retrieved != preferences {
+			t.Errorf("expected preferences %v, got %v", preferences, retrieved)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		db := store(t)
+		if _, err := db.RetrievePreferences("team", "U123"); err == nil {
+			t.Error("expected an error retrieving preferences of an unknown user")
+		}
+		db.StorePreferences("team", "U123", integration.DisplayPreferences{Theme: "green"})
+		if _, err := db.RetrievePreferences("other", "U123"); err == nil {
+			t.Error("expected preferences to be scoped to a team")
+		}
+	})
+
+	t.Run("Overwrite", func(t *testing.T) {
+		db := store(t)
+		db.StorePreferences("team", "U123", integration.DisplayPreferences{Theme: "green"})
+		if err := db.StorePreferences("team", "U123", integration.DisplayPreferences{Theme: "blue"}); err != nil {
+			t.Fatal(err)
+		}
+		preferences, err := db.RetrievePreferences("team", "U123")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if preferences.Theme != "blue" {
+			t.Errorf("expected the theme to be replaced with blue, got %v", preferences.Theme)
+		}
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		db := store(t)
+		errs := storagetest.Parallel(func(i int) error {
+			userID := fmt.Sprintf("U%v", i)
+			if err := db.StorePreferences("team", userID, integration.DisplayPreferences{BoardSize: 128 + i}); err != nil {
+				return err
+			}
+			preferences, err := db.RetrievePreferences("team", userID)
+			if err != nil {
+				return err
+			}
+			if preferences.BoardSize != 128+i {
+				return fmt.Errorf("expected board size %v, got %v", 128+i, preferences.BoardSize)
+			}
+			return nil
+		})
+		for _, err := range errs {
+			t.Error(err)
+		}
+	})
+}
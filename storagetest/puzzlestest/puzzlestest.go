@@ -0,0 +1,343 @@
+// Package puzzlestest is the conformance suite of the puzzle storage backends.
+package puzzlestest
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cjsaylor/chessbot/puzzles"
+	"github.com/cjsaylor/chessbot/storagetest"
+)
+
+// Backend groups the storage implementations under test.
+// Storage left nil is skipped by the suite.
+type Backend struct {
+	PuzzleStorage puzzles.PuzzleStorage
+}
+
+// Factory returns a fresh and empty backend. It is called once per test case.
+type Factory func(t *testing.T) Backend
+
+// Run exercises every method of the storage interfaces provided by the factory's backend.
+func Run(t *testing.T, factory Factory) {
+	t.Run("PuzzleStorage", func(t *testing.T) {
+		RunPuzzleStorage(t, factory)
+	})
+}
+
+func newPuzzle(ID string, teamID string, createdAt time.Time) *puzzles.Puzzle {
+	return &puzzles.Puzzle{
+		ID:        ID,
+		TeamID:    teamID,
+		GameID:    "game" + ID,
+		Ply:       12,
+		FEN:       "6k1/5ppp/8/8/8/8/5PPP/3R2K1 w - - 0 1",
+		Solution:  []string{"d1d8"},
+		Rating:    puzzles.DefaultRating,
+		CreatedAt: createdAt,
+	}
+}
+
+// RunPuzzleStorage exercises the puzzles.PuzzleStorage implementation of the backend.
+func RunPuzzleStorage(t *testing.T, factory Factory) {
+	store := func(t *testing.T) puzzles.PuzzleStorage {
+		storage := factory(t).PuzzleStorage
+		if storage == nil {
+			t.Skip("backend does not implement puzzle storage")
+		}
+		return storage
+	}
+	createdAt := time.Date(2019, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("StoreAndRetrieve", func(t *testing.T) {
+		db := store(t)
+		puzzle := newPuzzle("1", "team", createdAt)
+		puzzle.Solution = []string{"d1d8", "f8d8", "e1e8"}
+		if err := db.StorePuzzle(puzzle); err != nil {
+			t.Fatal(err)
+		}
+		retrieved, err := db.RetrievePuzzle("1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(retrieved.Solution, puzzle.Solution) {
+			t.Errorf("expected solution %v, got %v", puzzle.Solution, retrieved.Solution)
+		}
+		if retrieved.FEN != puzzle.FEN || retrieved.GameID != puzzle.GameID || retrieved.Ply != puzzle.Ply || retrieved.TeamID != puzzle.TeamID || retrieved.Rating != puzzle.Rating {
+			t.Errorf("expected puzzle %v, got %v", puzzle, retrieved)
+		}
+		if !retrieved.CreatedAt.Equal(createdAt) || !retrieved.PostedAt.IsZero() {
+			t.Errorf("expected the puzzle to be created at %v and not posted, got %v and %v", createdAt, retrieved.CreatedAt, retrieved.PostedAt)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		db := store(t)
+		if _, err := db.RetrievePuzzle("NOEXISTID"); err == nil {
+			t.Error("expected an error retrieving a puzzle that does not exist")
+		}
+	})
+
+	t.Run("Overwrite", func(t *testing.T) {
+		db := store(t)
+		puzzle := newPuzzle("1", "team", createdAt)
+		db.StorePuzzle(puzzle)
+		postedAt := createdAt.Add(24 * time.Hour)
+		puzzle.PostedAt = postedAt
+		if err := db.StorePuzzle(puzzle); err != nil {
+			t.Fatal(err)
+		}
+		retrieved, err := db.RetrievePuzzle("1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !retrieved.PostedAt.Equal(postedAt) {
+			t.Errorf("expected the puzzle to be posted at %v, got %v", postedAt, retrieved.PostedAt)
+		}
+	})
+
+	t.Run("ByTeam", func(t *testing.T) {
+		db := store(t)
+		db.StorePuzzle(newPuzzle("3", "team", createdAt.Add(2*time.Hour)))
+		db.StorePuzzle(newPuzzle("1", "team", createdAt))
+		db.StorePuzzle(newPuzzle("2", "other", createdAt.Add(time.Hour)))
+		db.StorePuzzle(newPuzzle("4", "team", createdAt.Add(time.Hour)))
+		IDs := []string{}
+		err := db.RetrievePuzzles("team", func(puzzle *puzzles.Puzzle) error {
+			IDs = append(IDs, puzzle.ID)
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if expected := []string{"1", "4", "3"}; !reflect.DeepEqual(IDs, expected) {
+			t.Errorf("expected puzzles %v in the order they were created, got %v", expected, IDs)
+		}
+		stop := fmt.Errorf("stop")
+		if err := db.RetrievePuzzles("team", func(*puzzles.Puzzle) error { return stop }); err != stop {
+			t.Errorf("expected the callback error to be returned, got %v", err)
+		}
+	})
+
+	t.Run("NextPuzzle", func(t *testing.T) {
+		db := store(t)
+		if next, err := db.RetrieveNextPuzzle("team"); err != nil || next != nil {
+			t.Errorf("expected no next puzzle of a team without puzzles, got %v (%v)", next, err)
+		}
+		posted := newPuzzle("1", "team", createdAt)
+		posted.PostedAt = createdAt.Add(24 * time.Hour)
+		db.StorePuzzle(posted)
+		db.StorePuzzle(newPuzzle("3", "team", createdAt.Add(time.Hour)))
+		db.StorePuzzle(newPuzzle("2", "team", createdAt.Add(time.Hour)))
+		db.StorePuzzle(newPuzzle("4", "other", createdAt))
+		next, err := db.RetrieveNextPuzzle("team")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if next == nil || next.ID != "2" {
+			t.Fatalf("expected the oldest unposted puzzle 2, got %v", next)
+		}
+		next.PostedAt = createdAt.Add(48 * time.Hour)
+		db.StorePuzzle(next)
+		if next, err = db.RetrieveNextPuzzle("team"); err != nil || next == nil || next.ID != "3" {
+			t.Errorf("expected puzzle 3 once 2 was posted, got %v (%v)", next, err)
+		}
+	})
+
+	t.Run("DailyPuzzle", func(t *testing.T) {
+		db := store(t)
+		postedAt := createdAt.Add(24 * time.Hour)
+		daily := &puzzles.DailyPuzzle{
+			TeamID:    "team",
+			ChannelID: "C123",
+			ThreadID:  "1551234567.000100",
+			PuzzleID:  "1",
+			PostedAt:  postedAt,
+		}
+		if err := db.StoreDailyPuzzle(daily); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := db.RetrieveDailyPuzzle("other", daily.ThreadID); err == nil {
+			t.Error("expected puzzles of the day to be scoped to a team")
+		}
+		daily.Answer("U123")
+		if err := db.StoreDailyPuzzle(daily); err != nil {
+			t.Fatal(err)
+		}
+		retrieved, err := db.RetrieveDailyPuzzle("team", daily.ThreadID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if retrieved.ChannelID != "C123" || retrieved.PuzzleID != "1" || !retrieved.PostedAt.Equal(postedAt) {
+			t.Errorf("expected puzzle of the day %v, got %v", daily, retrieved)
+		}
+		if retrieved.Answer("U123") {
+			t.Error("expected the answer of U123 to be retained")
+		}
+	})
+
+	t.Run("Streak", func(t *testing.T) {
+		db := store(t)
+		if _, err := db.RetrieveStreak("team", "U123"); err == nil {
+			t.Error("expected an error retrieving the streak of a user that never solved a puzzle")
+		}
+		streak := puzzles.Streak{TeamID: "team", UserID: "U123"}.Record(createdAt)
+		if err := db.StoreStreak(streak); err != nil {
+			t.Fatal(err)
+		}
+		streak = streak.Record(createdAt.Add(24 * time.Hour))
+		if err := db.StoreStreak(streak); err != nil {
+			t.Fatal(err)
+		}
+		retrieved, err := db.RetrieveStreak("team", "U123")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if retrieved.Current != 2 || retrieved.Best != 2 || !retrieved.LastSolved.Equal(streak.LastSolved) {
+			t.Errorf("expected streak %v, got %v", streak, retrieved)
+		}
+		if _, err := db.RetrieveStreak("other", "U123"); err == nil {
+			t.Error("expected streaks to be scoped to a team")
+		}
+	})
+
+	t.Run("Attempt", func(t *testing.T) {
+		db := store(t)
+		attempt := &puzzles.Attempt{
+			TeamID:    "team",
+			ChannelID: "C123",
+			ThreadID:  "1551234567.000100",
+			UserID:    "U123",
+			PuzzleID:  "1",
+			StartedAt: createdAt,
+		}
+		if err := db.StoreAttempt(attempt); err != nil {
+			t.Fatal(err)
+		}
+		attempt.Moves = []string{"d1d8", "f8d8"}
+		attempt.Failed = true
+		if err := db.StoreAttempt(attempt); err != nil {
+			t.Fatal(err)
+		}
+		retrieved, err := db.RetrieveAttempt("team", attempt.ThreadID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(retrieved.Moves, attempt.Moves) || !retrieved.Failed || retrieved.Solved {
+			t.Errorf("expected attempt %v, got %v", attempt, retrieved)
+		}
+		if retrieved.UserID != "U123" || retrieved.PuzzleID != "1" || retrieved.ChannelID != "C123" || !retrieved.StartedAt.Equal(createdAt) {
+			t.Errorf("expected attempt %v, got %v", attempt, retrieved)
+		}
+		if _, err := db.RetrieveAttempt("other", attempt.ThreadID); err == nil {
+			t.Error("expected attempts to be scoped to a team")
+		}
+	})
+
+	t.Run("AttemptsByUser", func(t *testing.T) {
+		db := store(t)
+		for i, userID := range []string{"U123", "U456", "U123"} {
+			db.StoreAttempt(&puzzles.Attempt{
+				TeamID:    "team",
+				ThreadID:  fmt.Sprintf("thread%v", i),
+				UserID:    userID,
+				PuzzleID:  fmt.Sprintf("puzzle%v", i),
+				StartedAt: createdAt.Add(-time.Duration(i) * time.Hour),
+			})
+		}
+		db.StoreAttempt(&puzzles.Attempt{TeamID: "other", ThreadID: "thread3", UserID: "U123", PuzzleID: "puzzle3"})
+		IDs := []string{}
+		err := db.RetrieveAttempts("team", "U123", func(attempt *puzzles.Attempt) error {
+			IDs = append(IDs, attempt.PuzzleID)
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if expected := []string{"puzzle2", "puzzle0"}; !reflect.DeepEqual(IDs, expected) {
+			t.Errorf("expected attempts %v in the order they started, got %v", expected, IDs)
+		}
+	})
+
+	t.Run("PlayerRating", func(t *testing.T) {
+		db := store(t)
+		if _, err := db.RetrievePlayerRating("team", "U123"); err == nil {
+			t.Error("expected an error retrieving the rating of a user that never played a puzzle")
+		}
+		rating := puzzles.PlayerRating{TeamID: "team", UserID: "U123", Rating: 1532, Solved: 3, Failed: 1}
+		if err := db.StorePlayerRating(rating); err != nil {
+			t.Fatal(err)
+		}
+		rating.Rating = 1548
+		rating.Solved++
+		if err := db.StorePlayerRating(rating); err != nil {
+			t.Fatal(err)
+		}
+		retrieved, err := db.RetrievePlayerRating("team", "U123")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if retrieved != rating {
+			t.Errorf("expected rating %v, got %v", rating, retrieved)
+		}
+		if _, err := db.RetrievePlayerRating("other", "U123"); err == nil {
+			t.Error("expected ratings to be scoped to a team")
+		}
+	})
+
+	t.Run("PuzzleChannel", func(t *testing.T) {
+		db := store(t)
+		if _, err := db.RetrievePuzzleChannel("team"); err == nil {
+			t.Error("expected an error retrieving the puzzle channel of a team that never set one")
+		}
+		channel := puzzles.PuzzleChannel{TeamID: "team", ChannelID: "C123"}
+		if err := db.StorePuzzleChannel(channel); err != nil {
+			t.Fatal(err)
+		}
+		channel = channel.Posted(time.Date(2020, 1, 1, 9, 0, 0, 0, time.UTC))
+		channel = channel.Failed(time.Date(2020, 1, 2, 9, 0, 0, 0, time.UTC))
+		if err := db.StorePuzzleChannel(channel); err != nil {
+			t.Fatal(err)
+		}
+		retrieved, err := db.RetrievePuzzleChannel("team")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if retrieved.ChannelID != "C123" || retrieved.Failures != 1 || !retrieved.RetryAt.Equal(channel.RetryAt) || !retrieved.PostedAt.Equal(channel.PostedAt) {
+			t.Errorf("expected channel %v, got %v", channel, retrieved)
+		}
+		if _, err := db.RetrievePuzzleChannel("other"); err == nil {
+			t.Error("expected puzzle channels to be scoped to a team")
+		}
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		db := store(t)
+		errs := storagetest.Parallel(func(i int) error {
+			ID := fmt.Sprintf("puzzle%v", i)
+			if err := db.StorePuzzle(newPuzzle(ID, "team", createdAt)); err != nil {
+				return err
+			}
+			if _, err := db.RetrievePuzzle(ID); err != nil {
+				return err
+			}
+			userID := fmt.Sprintf("U%v", i)
+			if err := db.StoreStreak(puzzles.Streak{TeamID: "team", UserID: userID, Current: i}); err != nil {
+				return err
+			}
+			streak, err := db.RetrieveStreak("team", userID)
+			if err != nil {
+				return err
+			}
+			if streak.Current != i {
+				return fmt.Errorf("expected a streak of %v, got %v", i, streak.Current)
+			}
+			return nil
+		})
+		for _, err := range errs {
+			t.Error(err)
+		}
+	})
+}
@@ -0,0 +1,123 @@
+// Package sandboxtest is the conformance suite of the analysis board storage backends.
+package sandboxtest
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cjsaylor/chessbot/game"
+	"github.com/cjsaylor/chessbot/sandbox"
+	"github.com/cjsaylor/chessbot/storagetest"
+)
+
+// Backend groups the storage implementations under test.
+// Storage left nil is skipped by the suite.
+type Backend struct {
+	BoardStorage sandbox.BoardStorage
+}
+
+// Factory returns a fresh and empty backend. It is called once per test case.
+type Factory func(t *testing.T) Backend
+
+// Run exercises every method of the storage interfaces provided by the factory's backend.
+func Run(t *testing.T, factory Factory) {
+	t.Run("BoardStorage", func(t *testing.T) {
+		RunBoardStorage(t, factory)
+	})
+}
+
+func newGame(ID string) *game.Game {
+	return game.NewGame(ID, game.Player{ID: "player1"}, game.Player{ID: "player2"})
+}
+
+// RunBoardStorage exercises the sandbox.BoardStorage implementation of the backend.
+func RunBoardStorage(t *testing.T, factory Factory) {
+	store := func(t *testing.T) sandbox.BoardStorage {
+		storage := factory(t).BoardStorage
+		if storage == nil {
+			t.Skip("backend does not implement analysis board storage")
+		}
+		return storage
+	}
+	createdAt := time.Date(2019, time.March, 1, 12, 0, 0, 0, time.UTC)
+	newBoard := func(teamID string, threadID string) *sandbox.Board {
+		gm := newGame("1234")
+		gm.Move("e2e4")
+		board := sandbox.NewBoard(gm)
+		board.TeamID = teamID
+		board.ChannelID = "C123"
+		board.ThreadID = threadID
+		board.CreatedAt = createdAt
+		return board
+	}
+
+	t.Run("StoreAndRetrieve", func(t *testing.T) {
+		db := store(t)
+		board := newBoard("team", "1551234567.000100")
+		if _, err := board.Play("c5"); err != nil {
+			t.Fatal(err)
+		}
+		board.Back()
+		if err := db.StoreBoard(board); err != nil {
+			t.Fatal(err)
+		}
+		retrieved, err := db.RetrieveBoard("team", board.ThreadID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !retrieved.CreatedAt.Equal(createdAt) {
+			t.Errorf("expected the board to be created at %v, got %v", createdAt, retrieved.CreatedAt)
+		}
+		retrieved.CreatedAt = board.CreatedAt
+		if !reflect.DeepEqual(retrieved, board) {
+			t.Errorf("expected board %+v, got %+v", board, retrieved)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		db := store(t)
+		if _, err := db.RetrieveBoard("team", "missing"); err == nil {
+			t.Error("expected an error retrieving a board that does not exist")
+		}
+		db.StoreBoard(newBoard("team", "thread"))
+		if _, err := db.RetrieveBoard("other", "thread"); err == nil {
+			t.Error("expected boards to be scoped to a team")
+		}
+	})
+
+	t.Run("Overwrite", func(t *testing.T) {
+		db := store(t)
+		board := newBoard("team", "thread")
+		db.StoreBoard(board)
+		if _, err := board.Play("e5"); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.StoreBoard(board); err != nil {
+			t.Fatal(err)
+		}
+		retrieved, err := db.RetrieveBoard("team", "thread")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(retrieved.Nodes) != 3 || retrieved.Current != 2 {
+			t.Errorf("expected the board with the added move, got %+v", retrieved)
+		}
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		db := store(t)
+		errs := storagetest.Parallel(func(i int) error {
+			threadID := fmt.Sprintf("thread%v", i)
+			if err := db.StoreBoard(newBoard("team", threadID)); err != nil {
+				return err
+			}
+			_, err := db.RetrieveBoard("team", threadID)
+			return err
+		})
+		for _, err := range errs {
+			t.Error(err)
+		}
+	})
+}
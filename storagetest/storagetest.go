@@ -0,0 +1,116 @@
+// Package storagetest holds the helpers shared by the conformance suites every storage backend is expected to pass.
+// The suites themselves live in a subpackage per domain (gametest, integrationtest, puzzlestest and sandboxtest).
+//
+// A package proves its memory, sqlite and bolt stores by running its domain's suite against each of them:
+//
+//	func TestStoreConformance(t *testing.T) {
+//		storagetest.RunStores(t, storagetest.Stores{
+//			Memory: func() interface{} { return NewMemoryStore() },
+//			Sqlite: func(path string) (interface{}, error) { return NewSqliteStore(path) },
+//			Bolt:   func(db *bolt.DB) (interface{}, error) { return NewBoltStore(db) },
+//		}, func(t *testing.T, open storagetest.Opener) {
+//			gametest.Run(t, func(t *testing.T) gametest.Backend {
+//				store := open(t)
+//				return gametest.Backend{GameStorage: store.(game.GameStorage)}
+//			})
+//		})
+//	}
+package storagetest
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// concurrency is the number of goroutines used when exercising concurrent access
+const concurrency = 20
+
+// Stores creates the stores of a package, one for each kind of backend.
+// Backends left nil are skipped.
+type Stores struct {
+	Memory func() interface{}
+	Sqlite func(path string) (interface{}, error)
+	Bolt   func(db *bolt.DB) (interface{}, error)
+}
+
+// Opener returns a fresh and empty store of the backend being run. It is called once per test case.
+type Opener func(t *testing.T) interface{}
+
+// RunStores runs a conformance suite once for each backend of the stores.
+// The sqlite and bolt stores are opened in temporary databases, which are removed once the suite is done.
+func RunStores(t *testing.T, stores Stores, run func(t *testing.T, open Opener)) {
+	if stores.Memory != nil {
+		t.Run("memory", func(t *testing.T) {
+			run(t, func(t *testing.T) interface{} {
+				return stores.Memory()
+			})
+		})
+	}
+	dir, err := ioutil.TempDir("", "chessbot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	databases := 0
+	if stores.Sqlite != nil {
+		t.Run("sqlite3", func(t *testing.T) {
+			run(t, func(t *testing.T) interface{} {
+				databases++
+				store, err := stores.Sqlite(filepath.Join(dir, fmt.Sprintf("%v.db", databases)))
+				if err != nil {
+					t.Fatal(err)
+				}
+				return store
+			})
+		})
+	}
+	if stores.Bolt != nil {
+		opened := []*bolt.DB{}
+		defer func() {
+			for _, db := range opened {
+				db.Close()
+			}
+		}()
+		t.Run("bolt", func(t *testing.T) {
+			run(t, func(t *testing.T) interface{} {
+				databases++
+				db, err := bolt.Open(filepath.Join(dir, fmt.Sprintf("%v.bolt", databases)), 0600, nil)
+				if err != nil {
+					t.Fatal(err)
+				}
+				opened = append(opened, db)
+				store, err := stores.Bolt(db)
+				if err != nil {
+					t.Fatal(err)
+				}
+				return store
+			})
+		})
+	}
+}
+
+// Parallel runs fn across several goroutines and collects any errors returned
+func Parallel(fn func(i int) error) []error {
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	errs := []error{}
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if err := fn(i); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		}(i)
+	}
+	wg.Wait()
+	return errs
+}
@@ -0,0 +1,202 @@
+// Package tablebase probes Syzygy endgame tablebases for the exact outcome of positions with few pieces left
+package tablebase
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/notnil/chess"
+)
+
+// MaxPieces is the most pieces (kings included) a position may have to be found in the tablebases
+const MaxPieces = 7
+
+// ErrTooManyPieces is returned when probing a position with more than MaxPieces pieces
+var ErrTooManyPieces = fmt.Errorf("tablebases only cover positions with %v pieces or fewer", MaxPieces)
+
+// WDL is the outcome of a position with perfect play from the point of view of the side to move
+type WDL int
+
+const (
+	// Loss is a position lost by the side to move
+	Loss WDL = iota - 2
+	// BlessedLoss is a loss that is a draw under the fifty move rule
+	BlessedLoss
+	// Draw is a drawn position
+	Draw
+	// CursedWin is a win that is a draw under the fifty move rule
+	CursedWin
+	// Win is a position won by the side to move
+	Win
+)
+
+var wdlNames = map[string]WDL{
+	"Loss":        Loss,
+	"BlessedLoss": BlessedLoss,
+	"Draw":        Draw,
+	"CursedWin":   CursedWin,
+	"Win":         Win,
+}
+
+func (w WDL) String() string {
+	for name, wdl := range wdlNames {
+		if wdl == w {
+			return name
+		}
+	}
+	return "Unknown"
+}
+
+// IsDecisive determines whether the position is won (or lost) even with the fifty move rule
+func (w WDL) IsDecisive() bool {
+	return w == Win || w == Loss
+}
+
+// Result is the tablebase verdict of a position
+type Result struct {
+	WDL WDL
+	// DTZ is the distance (in plies) to the next capture or pawn move that keeps the outcome, which resets the fifty move rule
+	DTZ int
+	// BestMove is the move keeping the best outcome in standard algebraic notation, empty when there are no legal moves
+	BestMove string
+}
+
+// Outcome is the result of the game with perfect play, where wins that the fifty move rule turns into draws are draws
+func (r Result) Outcome(turn chess.Color) chess.Outcome {
+	switch {
+	case r.WDL == Win && turn == chess.White, r.WDL == Loss && turn == chess.Black:
+		return chess.WhiteWon
+	case r.WDL == Win, r.WDL == Loss:
+		return chess.BlackWon
+	}
+	return chess.Draw
+}
+
+// Describe the verdict for the side to move, such as "White wins (DTZ 19)"
+func (r Result) Describe(turn chess.Color) string {
+	winner := turn
+	if r.WDL < Draw {
+		winner = turn.Other()
+	}
+	switch r.WDL {
+	case Win, Loss:
+		return fmt.Sprintf("%v wins (DTZ %v)", colorName(winner), abs(r.DTZ))
+	case CursedWin, BlessedLoss:
+		return fmt.Sprintf("Draw by the fifty move rule, %v cannot force a win in time (DTZ %v)", colorName(winner), abs(r.DTZ))
+	}
+	return "Draw, neither side can force a win"
+}
+
+func colorName(color chess.Color) string {
+	if color == chess.White {
+		return "White"
+	}
+	return "Black"
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// Prober finds the outcome of a position
+type Prober interface {
+	// Probe returns the tablebase verdict of a position given as FEN
+	Probe(fen string) (Result, error)
+}
+
+// Pieces counts the pieces (kings included) of a position given as FEN
+func Pieces(fen string) int {
+	board := strings.Fields(fen)
+	if len(board) == 0 {
+		return 0
+	}
+	count := 0
+	for _, r := range board[0] {
+		if strings.ContainsRune("pnbrqkPNBRQK", r) {
+			count++
+		}
+	}
+	return count
+}
+
+// Fathom probes the Syzygy tablebase files in a directory with the fathom command line tool
+type Fathom struct {
+	dir  string
+	path string
+	args []string
+}
+
+// NewFathom returns a prober running the fathom executable at the path (with any arguments) on the tablebase files in dir
+func NewFathom(dir string, path string, args ...string) *Fathom {
+	return &Fathom{
+		dir:  dir,
+		path: path,
+		args: args,
+	}
+}
+
+var (
+	tagPattern        = regexp.MustCompile(`^\[(\w+) "(.*)"\]$`)
+	moveNumberPattern = regexp.MustCompile(`^\d+\.+$`)
+)
+
+// Probe runs fathom on a position and reads the verdict from the PGN it prints
+func (f Fathom) Probe(fen string) (Result, error) {
+	if Pieces(fen) > MaxPieces {
+		return Result{}, ErrTooManyPieces
+	}
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(f.path, append(f.args, "--path="+f.dir, fen)...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return Result{}, errors.New(message)
+		}
+		return Result{}, err
+	}
+	return parseFathom(stdout.String())
+}
+
+// parseFathom reads the WDL and DTZ tags of fathom's output, and the best move from its principal variation
+func parseFathom(output string) (Result, error) {
+	tags := map[string]string{}
+	movetext := []string{}
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if match := tagPattern.FindStringSubmatch(line); match != nil {
+			tags[match[1]] = match[2]
+		} else if line != "" {
+			movetext = append(movetext, strings.Fields(line)...)
+		}
+	}
+	wdl, ok := wdlNames[tags["WDL"]]
+	if !ok {
+		return Result{}, fmt.Errorf("unexpected WDL %q in the tablebase probe", tags["WDL"])
+	}
+	dtz, err := strconv.Atoi(tags["DTZ"])
+	if err != nil {
+		return Result{}, fmt.Errorf("unexpected DTZ %q in the tablebase probe", tags["DTZ"])
+	}
+	result := Result{WDL: wdl, DTZ: dtz}
+	for _, token := range movetext {
+		if moveNumberPattern.MatchString(token) {
+			continue
+		}
+		if token != "1-0" && token != "0-1" && token != "1/2-1/2" && token != "*" {
+			result.BestMove = token
+		}
+		break
+	}
+	return result, nil
+}
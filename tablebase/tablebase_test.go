@@ -0,0 +1,130 @@
+package tablebase_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/cjsaylor/chessbot/tablebase"
+	"github.com/notnil/chess"
+)
+
+const (
+	kqk = "8/8/8/8/8/2k5/8/KQ6 w - - 0 1"
+	krk = "8/8/8/8/8/8/k7/1R2K3 b - - 0 1"
+)
+
+// TestHelperProcess isn't a real test, it's a fake fathom executable started by the tests below
+func TestHelperProcess(t *testing.T) {
+	if os.Getenv("GO_WANT_HELPER_PROCESS") != "1" {
+		return
+	}
+	args := os.Args
+	for len(args) > 0 && args[0] != "--" {
+		args = args[1:]
+	}
+	if len(args) != 3 || args[1] != "--path=syzygy" {
+		fmt.Fprintln(os.Stderr, "fathom: unexpected arguments")
+		os.Exit(1)
+	}
+	switch args[2] {
+	case kqk:
+		fmt.Println(`[Event ""]`)
+		fmt.Println(`[Result "1-0"]`)
+		fmt.Println(`[FEN "` + kqk + `"]`)
+		fmt.Println(`[WDL "Win"]`)
+		fmt.Println(`[DTZ "9"]`)
+		fmt.Println(`[WinningMoves "Qb4+, Qb5"]`)
+		fmt.Println(`[DrawingMoves "Qb3+"]`)
+		fmt.Println(`[LosingMoves ""]`)
+		fmt.Println()
+		fmt.Println("1. Qb4+ Kd3 2. Kb2 Ke3 1-0")
+	case krk:
+		// The undefended rook is lost, so the game is a dead draw
+		fmt.Println(`[Event ""]`)
+		fmt.Println(`[Result "1/2-1/2"]`)
+		fmt.Println(`[FEN "` + krk + `"]`)
+		fmt.Println(`[WDL "Draw"]`)
+		fmt.Println(`[DTZ "0"]`)
+		fmt.Println(`[WinningMoves ""]`)
+		fmt.Println(`[DrawingMoves "Kxb1"]`)
+		fmt.Println(`[LosingMoves ""]`)
+		fmt.Println()
+		fmt.Println("1... Kxb1 1/2-1/2")
+	default:
+		fmt.Fprintln(os.Stderr, "fathom: probe failed")
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
+
+func newFathom() *tablebase.Fathom {
+	return tablebase.NewFathom("syzygy", os.Args[0], "-test.run=TestHelperProcess", "--")
+}
+
+func TestFathomProbe(t *testing.T) {
+	os.Setenv("GO_WANT_HELPER_PROCESS", "1")
+	defer os.Unsetenv("GO_WANT_HELPER_PROCESS")
+	result, err := newFathom().Probe(kqk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.WDL != tablebase.Win || result.DTZ != 9 || result.BestMove != "Qb4+" {
+		t.Errorf("expected a win in 9 plies with Qb4+, got %+v", result)
+	}
+	if outcome := result.Outcome(chess.White); outcome != chess.WhiteWon {
+		t.Errorf("expected White to win, got %v", outcome)
+	}
+	if description := result.Describe(chess.White); description != "White wins (DTZ 9)" {
+		t.Errorf("unexpected description %q", description)
+	}
+	result, err = newFathom().Probe(krk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.WDL != tablebase.Draw || result.BestMove != "Kxb1" {
+		t.Errorf("expected a draw after Kxb1, got %+v", result)
+	}
+	if outcome := result.Outcome(chess.Black); outcome != chess.Draw {
+		t.Errorf("expected a draw, got %v", outcome)
+	}
+}
+
+func TestFathomProbeErrors(t *testing.T) {
+	os.Setenv("GO_WANT_HELPER_PROCESS", "1")
+	defer os.Unsetenv("GO_WANT_HELPER_PROCESS")
+	if _, err := newFathom().Probe("rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1"); err != tablebase.ErrTooManyPieces {
+		t.Errorf("expected ErrTooManyPieces, got %v", err)
+	}
+	if _, err := newFathom().Probe("8/8/8/8/8/2k5/8/KN6 w - - 0 1"); err == nil || err.Error() != "fathom: probe failed" {
+		t.Errorf("expected the probe failure to be reported, got %v", err)
+	}
+}
+
+func TestResultOutcome(t *testing.T) {
+	cases := []struct {
+		result  tablebase.Result
+		turn    chess.Color
+		outcome chess.Outcome
+		text    string
+	}{
+		{tablebase.Result{WDL: tablebase.Win, DTZ: 3}, chess.Black, chess.BlackWon, "Black wins (DTZ 3)"},
+		{tablebase.Result{WDL: tablebase.Loss, DTZ: -4}, chess.Black, chess.WhiteWon, "White wins (DTZ 4)"},
+		{tablebase.Result{WDL: tablebase.CursedWin, DTZ: 101}, chess.White, chess.Draw, "Draw by the fifty move rule, White cannot force a win in time (DTZ 101)"},
+		{tablebase.Result{WDL: tablebase.BlessedLoss, DTZ: -101}, chess.White, chess.Draw, "Draw by the fifty move rule, Black cannot force a win in time (DTZ 101)"},
+	}
+	for _, c := range cases {
+		if outcome := c.result.Outcome(c.turn); outcome != c.outcome {
+			t.Errorf("%v: expected %v, got %v", c.result.WDL, c.outcome, outcome)
+		}
+		if text := c.result.Describe(c.turn); text != c.text {
+			t.Errorf("%v: expected %q, got %q", c.result.WDL, c.text, text)
+		}
+	}
+}
+
+func TestPieces(t *testing.T) {
+	if pieces := tablebase.Pieces(kqk); pieces != 3 {
+		t.Errorf("expected 3 pieces, got %v", pieces)
+	}
+}